@@ -0,0 +1,157 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/testgrid/pkg/alerter"
+	"github.com/GoogleCloudPlatform/testgrid/util"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/metrics/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+type options struct {
+	config            gcs.Path // gcs://path/to/config/proto
+	state             gcs.Path
+	creds             string
+	confirm           bool
+	dashboards        util.Strings
+	wait              time.Duration
+	summaryPathPrefix string
+
+	smtpServer       string
+	smtpFrom         string
+	smtpUsername     string
+	smtpPasswordFile string
+	mailFile         string
+
+	debug    bool
+	trace    bool
+	jsonLogs bool
+}
+
+func (o *options) validate() error {
+	if o.config.String() == "" {
+		return errors.New("empty --config")
+	}
+	if o.state.String() == "" {
+		return errors.New("empty --alert-state")
+	}
+	if (o.smtpServer == "") == (o.mailFile == "") {
+		return errors.New("set exactly one of --smtp-server or --mail-file")
+	}
+	if o.smtpServer != "" && o.smtpFrom == "" {
+		return errors.New("--smtp-server requires --smtp-from")
+	}
+	return nil
+}
+
+func gatherOptions() options {
+	var o options
+	flag.Var(&o.config, "config", "gs://path/to/config.pb")
+	flag.Var(&o.state, "alert-state", "Load and save which alerts have been emailed at gs://path/to/alerter-state.json")
+	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
+	flag.BoolVar(&o.confirm, "confirm", false, "Send emails and save state if set")
+	flag.Var(&o.dashboards, "dashboard", "Only alert for named dashboards if set (repeateable)")
+	flag.DurationVar(&o.wait, "wait", 0, "Ensure at least this much time has passed since the last loop (exit if zero).")
+	flag.StringVar(&o.summaryPathPrefix, "summary-path", "summary", "Read summaries under this GCS path.")
+
+	flag.StringVar(&o.smtpServer, "smtp-server", "", "Send mail through this host:port SMTP server")
+	flag.StringVar(&o.smtpFrom, "smtp-from", "", "Send mail from this address")
+	flag.StringVar(&o.smtpUsername, "smtp-username", "", "Authenticate to the SMTP server as this user if set")
+	flag.StringVar(&o.smtpPasswordFile, "smtp-password-file", "", "/path/to/smtp/password")
+	flag.StringVar(&o.mailFile, "mail-file", "", "Append mail to this /path/to/file instead of sending it (- for stdout)")
+
+	flag.BoolVar(&o.debug, "debug", false, "Log debug lines if set")
+	flag.BoolVar(&o.trace, "trace", false, "Log trace and debug lines if set")
+	flag.BoolVar(&o.jsonLogs, "json-logs", false, "Uses a json logrus formatter when set")
+
+	flag.Parse()
+	return o
+}
+
+func sender(opt options) (alerter.Sender, error) {
+	if opt.mailFile != "" {
+		return alerter.NewFileSender(opt.mailFile)
+	}
+	var password string
+	if opt.smtpPasswordFile != "" {
+		buf, err := ioutil.ReadFile(opt.smtpPasswordFile)
+		if err != nil {
+			return nil, err
+		}
+		password = strings.TrimSpace(string(buf))
+	}
+	return alerter.NewSMTPSender(opt.smtpServer, opt.smtpFrom, opt.smtpUsername, password)
+}
+
+func main() {
+
+	opt := gatherOptions()
+	if err := opt.validate(); err != nil {
+		logrus.Fatalf("Invalid flags: %v", err)
+	}
+	if !opt.confirm {
+		logrus.Warning("--confirm=false (DRY-RUN): will not send mail or write to gcs")
+	}
+
+	switch {
+	case opt.trace:
+		logrus.SetLevel(logrus.TraceLevel)
+	case opt.debug:
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+
+	if opt.jsonLogs {
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+	}
+	logrus.SetReportCaller(true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	storageClient, err := gcs.ClientWithCreds(ctx, opt.creds)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to read storage client")
+	}
+
+	client := gcs.NewClient(storageClient)
+	mets := alerter.CreateMetrics(prometheus.NewFactory())
+	s, err := sender(opt)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to configure mail sender")
+	}
+
+	opts := &alerter.UpdateOptions{
+		ConfigPath:        opt.config,
+		SummaryPathPrefix: opt.summaryPathPrefix,
+		StatePath:         opt.state,
+		AllowedDashboards: opt.dashboards.Strings(),
+		Confirm:           opt.confirm,
+		Freq:              opt.wait,
+	}
+
+	if err := alerter.Update(ctx, client, mets, s, opts); err != nil {
+		logrus.WithError(err).Error("Could not alert")
+	}
+}
@@ -20,8 +20,10 @@ package main
 import (
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -30,15 +32,16 @@ import (
 )
 
 type options struct {
-	httpPort string
-	grpcPort string
-	router   api.RouterOptions
+	httpPort        string
+	grpcPort        string
+	ingestTokenFile string
+	router          api.RouterOptions
 }
 
 func gatherOptions() (options, error) {
 	var o options
 	flag.StringVar(&o.router.HomeBucket, "scope", "", "Local or cloud TestGrid context to read from")
-	flag.StringVar(&o.router.GcsCredentials, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	flag.StringVar(&o.router.GcsCredentials, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
 	flag.StringVar(&o.httpPort, "port", "8080", "Alias for http-port")
 	flag.StringVar(&o.httpPort, "http-port", "8080", "Port to deploy REST server to")
 	flag.StringVar(&o.grpcPort, "grpc-port", "50051", "Port to deploy gRPC server to")
@@ -46,8 +49,23 @@ func gatherOptions() (options, error) {
 	flag.StringVar(&o.router.TabPathPrefix, "tab", "tabs", "Read tab states under this path")
 	flag.StringVar(&o.router.SummaryPathPrefix, "summary", "summary", "Read summaries under this path.")
 	flag.DurationVar(&o.router.Timeout, "timeout", 10*time.Minute, "Maximum time allocated to complete one request")
+	flag.StringVar(&o.ingestTokenFile, "ingest-token-file", "", "Accept results for the scope's test groups from callers presenting the token in this file (disabled if empty)")
 	flag.Parse()
 
+	if o.ingestTokenFile != "" {
+		buf, err := ioutil.ReadFile(o.ingestTokenFile)
+		if err != nil {
+			return o, fmt.Errorf("read ingest token: %w", err)
+		}
+		o.router.IngestToken = strings.TrimSpace(string(buf))
+		if o.router.IngestToken == "" {
+			return o, fmt.Errorf("empty ingest token in %s", o.ingestTokenFile)
+		}
+		if o.router.HomeBucket == "" {
+			return o, fmt.Errorf("--ingest-token-file requires --scope")
+		}
+	}
+
 	return o, nil
 }
 
@@ -0,0 +1,146 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/testgrid/pkg/autobugger"
+	"github.com/GoogleCloudPlatform/testgrid/util"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/metrics/prometheus"
+	"github.com/sirupsen/logrus"
+)
+
+type options struct {
+	config           gcs.Path // gcs://path/to/config/proto
+	creds            string
+	confirm          bool
+	groups           util.Strings
+	wait             time.Duration
+	tabStatePrefix   string
+	issueStatePrefix string
+
+	githubRepo      string
+	githubTokenFile string
+	githubURL       string
+	githubLabels    util.Strings
+
+	debug    bool
+	trace    bool
+	jsonLogs bool
+}
+
+func (o *options) validate() error {
+	if o.config.String() == "" {
+		return errors.New("empty --config")
+	}
+	if o.githubRepo == "" {
+		return errors.New("empty --github-repo")
+	}
+	return nil
+}
+
+func gatherOptions() options {
+	var o options
+	flag.Var(&o.config, "config", "gs://path/to/config.pb")
+	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
+	flag.BoolVar(&o.confirm, "confirm", false, "Change issues and save issue state if set")
+	flag.Var(&o.groups, "test-group", "Only file issues for named test groups if set (repeateable)")
+	flag.DurationVar(&o.wait, "wait", 0, "Ensure at least this much time has passed since the last loop (exit if zero).")
+	flag.StringVar(&o.tabStatePrefix, "tab-state-path", "tabs", "Read tab state under this GCS path.")
+	flag.StringVar(&o.issueStatePrefix, "issue-state-path", "issues", "Write issue state under this GCS path.")
+
+	flag.StringVar(&o.githubRepo, "github-repo", "", "File issues in this owner/repo")
+	flag.StringVar(&o.githubTokenFile, "github-token-file", "", "/path/to/github/token")
+	flag.StringVar(&o.githubURL, "github-api-url", autobugger.DefaultGitHubURL, "Base URL of the GitHub API")
+	flag.Var(&o.githubLabels, "github-label", "Add this label to every filed issue (repeateable)")
+
+	flag.BoolVar(&o.debug, "debug", false, "Log debug lines if set")
+	flag.BoolVar(&o.trace, "trace", false, "Log trace and debug lines if set")
+	flag.BoolVar(&o.jsonLogs, "json-logs", false, "Uses a json logrus formatter when set")
+
+	flag.Parse()
+	return o
+}
+
+func tracker(opt options) (autobugger.IssueTracker, error) {
+	var token string
+	if opt.githubTokenFile != "" {
+		buf, err := ioutil.ReadFile(opt.githubTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		token = strings.TrimSpace(string(buf))
+	}
+	return autobugger.NewGitHubTracker(opt.githubRepo, token, opt.githubURL, opt.githubLabels.Strings()...)
+}
+
+func main() {
+
+	opt := gatherOptions()
+	if err := opt.validate(); err != nil {
+		logrus.Fatalf("Invalid flags: %v", err)
+	}
+	if !opt.confirm {
+		logrus.Warning("--confirm=false (DRY-RUN): will not change issues or write to gcs")
+	}
+
+	switch {
+	case opt.trace:
+		logrus.SetLevel(logrus.TraceLevel)
+	case opt.debug:
+		logrus.SetLevel(logrus.DebugLevel)
+	}
+
+	if opt.jsonLogs {
+		logrus.SetFormatter(&logrus.JSONFormatter{})
+	}
+	logrus.SetReportCaller(true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	storageClient, err := gcs.ClientWithCreds(ctx, opt.creds)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to read storage client")
+	}
+
+	client := gcs.NewClient(storageClient)
+	mets := autobugger.CreateMetrics(prometheus.NewFactory())
+	t, err := tracker(opt)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to configure issue tracker")
+	}
+
+	opts := &autobugger.UpdateOptions{
+		ConfigPath:       opt.config,
+		TabsPathPrefix:   opt.tabStatePrefix,
+		IssueStatePrefix: opt.issueStatePrefix,
+		AllowedGroups:    opt.groups.Strings(),
+		Confirm:          opt.confirm,
+		Freq:             opt.wait,
+	}
+
+	if err := autobugger.Update(ctx, client, mets, t, opts); err != nil {
+		logrus.WithError(err).Error("Could not file issues")
+	}
+}
@@ -57,7 +57,7 @@ func gatherOptions() options {
 	var o options
 	flag.StringVar(&o.listPath, "config-list", "", "List of configurations to merge (at file)")
 	flag.StringVar(&o.listURL, "config-url", "", "List of configurations to merge (at web URL)")
-	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
 	flag.BoolVar(&o.confirm, "confirm", false, "Upload data if set")
 	flag.DurationVar(&o.wait, "wait", 0, "Ensure at least this much time ahs passed since the last loop. (Run only once if zero)")
 	flag.BoolVar(&o.skipValidate, "allow-invalid-configs", false, "Allows merging of configs that don't validate. Usually skips invalid configs")
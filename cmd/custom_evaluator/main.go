@@ -0,0 +1,264 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// A dry-run of custom evaluator rules, reporting which rule fires for each test
+// of a JUnit file or a stored grid.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/updater"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/prototext"
+
+	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	tspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+type options struct {
+	rules      string
+	config     gcs.Path
+	testGroup  string
+	junit      string
+	grid       gcs.Path
+	columns    int
+	creds      string
+	onlyFiring bool
+}
+
+// validate ensures reasonable options
+func (o *options) validate() error {
+	switch {
+	case o.rules == "" && o.config.String() == "":
+		return errors.New("set one of --rules or --config")
+	case o.rules != "" && o.config.String() != "":
+		return errors.New("set only one of --rules or --config")
+	case o.config.String() != "" && o.testGroup == "":
+		return errors.New("--config requires --test-group")
+	}
+	switch {
+	case o.junit == "" && o.grid.String() == "":
+		return errors.New("set one of --junit or --grid")
+	case o.junit != "" && o.grid.String() != "":
+		return errors.New("set only one of --junit or --grid")
+	}
+	if o.columns < 1 {
+		return fmt.Errorf("--columns must be positive: %d", o.columns)
+	}
+	return nil
+}
+
+// gatherFlagOptions reads options from flags
+func gatherFlagOptions(fs *flag.FlagSet, args ...string) options {
+	var o options
+	fs.StringVar(&o.rules, "rules", "", "Path to a RuleSet in text proto format")
+	fs.Var(&o.config, "config", "Path to configuration file (e.g. gs://path/to/config), instead of --rules")
+	fs.StringVar(&o.testGroup, "test-group", "", "Evaluate the custom_evaluator_rule_set of this test group in --config")
+	fs.StringVar(&o.junit, "junit", "", "Path to a JUnit XML file to evaluate")
+	fs.Var(&o.grid, "grid", "Path to a test group state to evaluate (e.g. gs://path/to/group)")
+	fs.IntVar(&o.columns, "columns", 1, "Evaluate this many of the most recent --grid columns")
+	fs.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
+	fs.BoolVar(&o.onlyFiring, "only-firing", false, "Only report tests where a rule fires")
+	fs.Parse(args)
+	return o
+}
+
+// gatherOptions reads options from flags
+func gatherOptions() options {
+	return gatherFlagOptions(flag.CommandLine, os.Args[1:]...)
+}
+
+// evaluation is the outcome of evaluating the rules against a test.
+type evaluation struct {
+	build  string
+	test   string
+	rule   int // Index of the rule which fired, or -1 if none did.
+	status tspb.TestStatus
+}
+
+func evaluate(rules []*evalpb.Rule, build string, tr updater.TestResult) evaluation {
+	e := evaluation{
+		build: build,
+		test:  tr.Name(),
+		rule:  updater.MatchingRule(rules, tr),
+	}
+	if e.rule >= 0 {
+		e.status = rules[e.rule].GetComputedStatus()
+	}
+	return e
+}
+
+// evaluateJUnit evaluates the rules against each test case of the suites.
+func evaluateJUnit(rules []*evalpb.Rule, suites *junit.Suites) []evaluation {
+	var out []evaluation
+	var walk func([]junit.Suite)
+	walk = func(suites []junit.Suite) {
+		for _, suite := range suites {
+			walk(suite.Suites)
+			for i := range suite.Results {
+				out = append(out, evaluate(rules, "", updater.JUnitTestResult(&suite.Results[i])))
+			}
+		}
+	}
+	walk(suites.Suites)
+	return out
+}
+
+// evaluateGrid evaluates the rules against each row of the most recent columns of the grid.
+func evaluateGrid(ctx context.Context, rules []*evalpb.Rule, grid *statepb.Grid, columns int) ([]evaluation, error) {
+	cols, _, err := updater.InflateGrid(ctx, grid, time.Time{}, time.Now().Add(24*time.Hour))
+	if err != nil {
+		return nil, fmt.Errorf("inflate: %w", err)
+	}
+	if len(cols) > columns {
+		cols = cols[:columns]
+	}
+	var out []evaluation
+	for _, col := range cols {
+		names := make([]string, 0, len(col.Cells))
+		for name, cell := range col.Cells {
+			if cell.Result == tspb.TestStatus_NO_RESULT {
+				continue
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			out = append(out, evaluate(rules, col.Column.Build, updater.CellTestResult(name, col.Cells[name])))
+		}
+	}
+	return out, nil
+}
+
+// report writes a table of the evaluations.
+func report(w io.Writer, evals []evaluation, onlyFiring bool) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "BUILD\tTEST\tRULE\tSTATUS")
+	for _, e := range evals {
+		rule, status := "-", "-"
+		if e.rule >= 0 {
+			rule, status = strconv.Itoa(e.rule), e.status.String()
+		} else if onlyFiring {
+			continue
+		}
+		build := e.build
+		if build == "" {
+			build = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", build, e.test, rule, status)
+	}
+	return tw.Flush()
+}
+
+// storageClient returns a GCS client when any of the paths refers to GCS, otherwise nil.
+func storageClient(ctx context.Context, creds string, paths ...gcs.Path) (*storage.Client, error) {
+	for _, p := range paths {
+		if p.URL().Scheme == "gs" {
+			return gcs.ClientWithCreds(ctx, creds)
+		}
+	}
+	return nil, nil
+}
+
+func readRules(ctx context.Context, client gcs.Opener, opt options) ([]*evalpb.Rule, error) {
+	if opt.rules != "" {
+		buf, err := ioutil.ReadFile(opt.rules)
+		if err != nil {
+			return nil, err
+		}
+		var ruleSet evalpb.RuleSet
+		if err := prototext.Unmarshal(buf, &ruleSet); err != nil {
+			return nil, fmt.Errorf("unmarshal: %w", err)
+		}
+		return ruleSet.Rules, nil
+	}
+	cfg, _, err := config.ReadGCS(ctx, client, opt.config)
+	if err != nil {
+		return nil, fmt.Errorf("read config: %w", err)
+	}
+	tg := config.FindTestGroup(opt.testGroup, cfg)
+	if tg == nil {
+		return nil, fmt.Errorf("test group %q not found", opt.testGroup)
+	}
+	return tg.GetCustomEvaluatorRuleSet().GetRules(), nil
+}
+
+func main() {
+	opt := gatherOptions()
+	if err := opt.validate(); err != nil {
+		logrus.Fatalf("Invalid options %v: %v", opt, err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	sc, err := storageClient(ctx, opt.creds, opt.config, opt.grid)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to create storage client")
+	}
+	if sc != nil {
+		defer sc.Close()
+	}
+	client := gcs.NewClient(sc) // Also reads local and s3:// paths.
+
+	rules, err := readRules(ctx, client, opt)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to read rules")
+	}
+	if len(rules) == 0 {
+		logrus.Warn("No rules to evaluate")
+	}
+
+	var evals []evaluation
+	if opt.junit != "" {
+		buf, err := ioutil.ReadFile(opt.junit)
+		if err != nil {
+			logrus.WithError(err).Fatal("Failed to read --junit")
+		}
+		suites, err := junit.Parse(buf)
+		if err != nil {
+			logrus.WithError(err).Fatal("Failed to parse --junit")
+		}
+		evals = evaluateJUnit(rules, suites)
+	} else {
+		grid, _, err := gcs.DownloadGrid(ctx, client, opt.grid)
+		if err != nil {
+			logrus.WithError(err).Fatal("Failed to download --grid")
+		}
+		if evals, err = evaluateGrid(ctx, rules, grid, opt.columns); err != nil {
+			logrus.WithError(err).Fatal("Failed to evaluate --grid")
+		}
+	}
+
+	if err := report(os.Stdout, evals, opt.onlyFiring); err != nil {
+		logrus.WithError(err).Fatal("Failed to write report")
+	}
+}
@@ -0,0 +1,323 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	tspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name string
+		args []string
+		err  bool
+	}{
+		{
+			name: "rules and junit",
+			args: []string{"--rules=rules.textproto", "--junit=junit.xml"},
+		},
+		{
+			name: "config and grid",
+			args: []string{"--config=gs://bucket/config", "--test-group=foo", "--grid=gs://bucket/foo", "--columns=5"},
+		},
+		{
+			name: "missing rules",
+			args: []string{"--junit=junit.xml"},
+			err:  true,
+		},
+		{
+			name: "rules and config",
+			args: []string{"--rules=rules.textproto", "--config=gs://bucket/config", "--test-group=foo", "--junit=junit.xml"},
+			err:  true,
+		},
+		{
+			name: "config without test group",
+			args: []string{"--config=gs://bucket/config", "--junit=junit.xml"},
+			err:  true,
+		},
+		{
+			name: "missing results",
+			args: []string{"--rules=rules.textproto"},
+			err:  true,
+		},
+		{
+			name: "junit and grid",
+			args: []string{"--rules=rules.textproto", "--junit=junit.xml", "--grid=gs://bucket/foo"},
+			err:  true,
+		},
+		{
+			name: "no columns",
+			args: []string{"--rules=rules.textproto", "--grid=gs://bucket/foo", "--columns=0"},
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opt := gatherFlagOptions(flag.NewFlagSet(tc.name, flag.ContinueOnError), tc.args...)
+			err := opt.validate()
+			switch {
+			case err != nil && !tc.err:
+				t.Errorf("validate() got unexpected error: %v", err)
+			case err == nil && tc.err:
+				t.Error("validate() failed to return an error")
+			}
+		})
+	}
+}
+
+var (
+	rules = []*evalpb.Rule{
+		{
+			ComputedStatus: tspb.TestStatus_TIMED_OUT,
+			TestResultComparisons: []*evalpb.TestResultComparison{
+				{
+					TestResultInfo: &evalpb.TestResultComparison_TestResultErrorField{
+						TestResultErrorField: "exception_type",
+					},
+					Comparison: &evalpb.Comparison{
+						Op: evalpb.Comparison_OP_CONTAINS,
+						ComparisonValue: &evalpb.Comparison_StringValue{
+							StringValue: "timed out",
+						},
+					},
+				},
+			},
+		},
+		{
+			ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+			TestResultComparisons: []*evalpb.TestResultComparison{
+				{
+					TestResultInfo: &evalpb.TestResultComparison_TestResultField{
+						TestResultField: "name",
+					},
+					Comparison: &evalpb.Comparison{
+						Op: evalpb.Comparison_OP_REGEX,
+						ComparisonValue: &evalpb.Comparison_StringValue{
+							StringValue: "^setup",
+						},
+					},
+				},
+			},
+		},
+	}
+)
+
+func TestEvaluateJUnit(t *testing.T) {
+	suites := &junit.Suites{
+		Suites: []junit.Suite{
+			{
+				Results: []junit.Result{
+					{Name: "pass"},
+					{Name: "slow", Failure: &junit.Failure{Value: "timed out"}},
+				},
+				Suites: []junit.Suite{
+					{
+						Results: []junit.Result{
+							{Name: "setup cluster"},
+						},
+					},
+				},
+			},
+		},
+	}
+	want := []evaluation{
+		{test: "setup cluster", rule: 1, status: tspb.TestStatus_CATEGORIZED_ABORT},
+		{test: "pass", rule: -1},
+		{test: "slow", rule: 0, status: tspb.TestStatus_TIMED_OUT},
+	}
+	got := evaluateJUnit(rules, suites)
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(evaluation{})); diff != "" {
+		t.Errorf("evaluateJUnit() got unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestEvaluateGrid(t *testing.T) {
+	grid := &statepb.Grid{
+		Columns: []*statepb.Column{
+			{Build: "3", Started: 3000},
+			{Build: "2", Started: 2000},
+			{Build: "1", Started: 1000},
+		},
+		Rows: []*statepb.Row{
+			{
+				Name:     "slow",
+				Results:  []int32{int32(tspb.TestStatus_FAIL), 2, int32(tspb.TestStatus_PASS), 1},
+				CellIds:  []string{"", "", ""},
+				Messages: []string{"timed out", "boom", ""},
+				Icons:    []string{"", "", ""},
+			},
+			{
+				Name:     "setup cluster",
+				Results:  []int32{int32(tspb.TestStatus_NO_RESULT), 1, int32(tspb.TestStatus_PASS), 2},
+				CellIds:  []string{"", ""},
+				Messages: []string{"", ""},
+				Icons:    []string{"", ""},
+			},
+		},
+	}
+	want := []evaluation{
+		{build: "3", test: "slow", rule: 0, status: tspb.TestStatus_TIMED_OUT},
+		{build: "2", test: "setup cluster", rule: 1, status: tspb.TestStatus_CATEGORIZED_ABORT},
+		{build: "2", test: "slow", rule: -1},
+	}
+	got, err := evaluateGrid(context.Background(), rules, grid, 2)
+	if err != nil {
+		t.Fatalf("evaluateGrid() got unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(evaluation{})); diff != "" {
+		t.Errorf("evaluateGrid() got unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestReport(t *testing.T) {
+	evals := []evaluation{
+		{build: "2", test: "slow", rule: 0, status: tspb.TestStatus_TIMED_OUT},
+		{build: "2", test: "fast", rule: -1},
+		{test: "setup", rule: 1, status: tspb.TestStatus_CATEGORIZED_ABORT},
+	}
+	cases := []struct {
+		name       string
+		onlyFiring bool
+		want       string
+	}{
+		{
+			name: "all tests",
+			want: "" +
+				"BUILD  TEST   RULE  STATUS\n" +
+				"2      slow   0     TIMED_OUT\n" +
+				"2      fast   -     -\n" +
+				"-      setup  1     CATEGORIZED_ABORT\n",
+		},
+		{
+			name:       "only firing",
+			onlyFiring: true,
+			want: "" +
+				"BUILD  TEST   RULE  STATUS\n" +
+				"2      slow   0     TIMED_OUT\n" +
+				"-      setup  1     CATEGORIZED_ABORT\n",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := report(&buf, evals, tc.onlyFiring); err != nil {
+				t.Fatalf("report() got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, buf.String()); diff != "" {
+				t.Errorf("report() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadRules(t *testing.T) {
+	cfg := &configpb.Configuration{
+		TestGroups: []*configpb.TestGroup{
+			{
+				Name:                   "foo",
+				CustomEvaluatorRuleSet: &evalpb.RuleSet{Rules: rules},
+			},
+		},
+	}
+	buf, err := proto.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("Failed to marshal config: %v", err)
+	}
+	mustPath := func(s string) gcs.Path {
+		p, err := gcs.NewPath(s)
+		if err != nil {
+			t.Fatalf("gcs.NewPath(%q): %v", s, err)
+		}
+		return *p
+	}
+
+	cases := []struct {
+		name      string
+		config    string
+		testGroup string
+		want      []*evalpb.Rule
+		err       bool
+	}{
+		{
+			name:      "s3",
+			config:    "s3://bucket/config",
+			testGroup: "foo",
+			want:      rules,
+		},
+		{
+			name:      "local",
+			config:    "/path/to/config",
+			testGroup: "foo",
+			want:      rules,
+		},
+		{
+			name:      "missing test group",
+			config:    "s3://bucket/config",
+			testGroup: "bar",
+			err:       true,
+		},
+		{
+			name:      "missing config",
+			config:    "gs://bucket/missing",
+			testGroup: "foo",
+			err:       true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.Opener{
+				mustPath("s3://bucket/config"): {Data: string(buf)},
+				mustPath("/path/to/config"):    {Data: string(buf)},
+			}
+			opt := options{
+				config:    mustPath(tc.config),
+				testGroup: tc.testGroup,
+			}
+			got, err := readRules(context.Background(), client, opt)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("readRules() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("readRules() failed to return an error")
+			default:
+				if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("readRules() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -28,6 +28,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/GoogleCloudPlatform/testgrid/config"
@@ -46,6 +47,7 @@ type options struct {
 	diffRatioOK   float64
 	debug, trace  bool
 	testGroupURL  string
+	slowest       int
 }
 
 // validate ensures reasonable options
@@ -59,6 +61,9 @@ func (o *options) validate() error {
 	if o.diffRatioOK < 0.0 || o.diffRatioOK > 1.0 {
 		return fmt.Errorf("--diff-ratio-ok must be a ratio between 0.0 and 1.0: %f", o.diffRatioOK)
 	}
+	if o.slowest < 0 {
+		return fmt.Errorf("--slowest must be non-negative: %d", o.slowest)
+	}
 	if o.debug && o.trace {
 		return fmt.Errorf("set only one of --debug or --trace log levels")
 	}
@@ -81,11 +86,12 @@ func gatherFlagOptions(fs *flag.FlagSet, args ...string) options {
 	fs.Var(&o.first, "first", "First directory of state files to compare.")
 	fs.Var(&o.second, "second", "Second directory of state files to compare.")
 	fs.Var(&o.configPath, "config", "Path to configuration file (e.g. gs://path/to/config)")
-	fs.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	fs.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
 	fs.Float64Var(&o.diffRatioOK, "diff-ratio-ok", 0.0, "Ratio between 0.0 and 1.0. Only count as different if ratio of differences / total is higher than this.")
 	fs.BoolVar(&o.debug, "debug", false, "If true, print detailed info like full diffs.")
 	fs.BoolVar(&o.trace, "trace", false, "If true, print extremely detailed info.")
 	fs.StringVar(&o.testGroupURL, "test-group-url", "", "Provide a TestGrid URL for viewing test group links (e.g. 'http://k8s.testgrid.io/q/testgroup/')")
+	fs.IntVar(&o.slowest, "slowest", 0, "If set, report this many groups whose most recent update in --second took the longest, by phase.")
 	fs.Parse(args)
 	return o
 }
@@ -241,6 +247,52 @@ func compare(ctx context.Context, first, second *statepb.Grid, diffRatioOK float
 	return
 }
 
+// groupUpdate holds the most recent update of a test group in each directory.
+type groupUpdate struct {
+	name          string
+	first, second *statepb.UpdateInfo
+}
+
+// updateSeconds returns the total time the update took, along with its slowest phase.
+func updateSeconds(info *statepb.UpdateInfo) (float64, *statepb.UpdatePhaseData) {
+	var total float64
+	var slowest *statepb.UpdatePhaseData
+	for _, phase := range info.GetUpdatePhaseData() {
+		total += phase.GetPhaseSeconds()
+		if slowest == nil || phase.GetPhaseSeconds() > slowest.GetPhaseSeconds() {
+			slowest = phase
+		}
+	}
+	return total, slowest
+}
+
+func describeUpdate(info *statepb.UpdateInfo) string {
+	total, slowest := updateSeconds(info)
+	if slowest == nil {
+		return "unknown"
+	}
+	return fmt.Sprintf("%.1fs (%s %.1fs)", total, slowest.GetPhaseName(), slowest.GetPhaseSeconds())
+}
+
+// slowestUpdates describes the n groups whose most recent update took the longest in the second directory.
+func slowestUpdates(updates []groupUpdate, n int) []string {
+	seconds := make(map[string]float64, len(updates))
+	for _, u := range updates {
+		seconds[u.name], _ = updateSeconds(u.second)
+	}
+	sort.SliceStable(updates, func(i, j int) bool {
+		return seconds[updates[i].name] > seconds[updates[j].name]
+	})
+	if len(updates) > n {
+		updates = updates[:n]
+	}
+	var msgs []string
+	for _, u := range updates {
+		msgs = append(msgs, fmt.Sprintf("%s: %s, was %s", u.name, describeUpdate(u.second), describeUpdate(u.first)))
+	}
+	return msgs
+}
+
 func filenames(ctx context.Context, dir gcs.Path, client gcs.Client) ([]string, error) {
 	stats := client.Objects(ctx, dir, "/", "")
 	var filenames []string
@@ -300,6 +352,7 @@ func main() {
 	colFirstDups := make(map[string]bool)  // Good; second deduplicates.
 	colSecondDups := make(map[string]bool) // Bad; second adds duplicates.
 	otherDiffed := make(map[string]bool)   // Bad; found unknown differences.
+	var updates []groupUpdate
 	for _, firstP := range firstFiles {
 		tgName := filepath.Base(firstP)
 		secondP := opt.second.String()
@@ -336,6 +389,17 @@ func main() {
 			continue
 		}
 
+		if opt.slowest > 0 {
+			update := groupUpdate{name: tgName}
+			if infos := firstGrid.GetUpdateInfo(); len(infos) > 0 {
+				update.first = infos[0]
+			}
+			if infos := secondGrid.GetUpdateInfo(); len(infos) > 0 {
+				update.second = infos[0]
+			}
+			updates = append(updates, update)
+		}
+
 		if diffed, rowReasons, colReasons := compare(ctx, firstGrid, secondGrid, opt.diffRatioOK, tg.GetNumColumnsRecent()); diffed {
 			msg := fmt.Sprintf("%q vs. %q", firstP, secondP)
 			if opt.testGroupURL != "" {
@@ -369,6 +433,12 @@ func main() {
 	report(rowSecondDups, "❌ rows get duplicated")
 	report(colSecondDups, "❌ columns get duplicated")
 	report(otherDiffed, "❌ other diffs")
+	if opt.slowest > 0 {
+		logrus.Infof("%d slowest updates:", opt.slowest)
+		for _, msg := range slowestUpdates(updates, opt.slowest) {
+			logrus.Infof("\t* %s", msg)
+		}
+	}
 	if n := len(errorMsgs); n > 0 {
 		logrus.WithField("count", n).WithField("errors", errorMsgs).Fatal("Errors when diffing directories.")
 	}
@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/google/go-cmp/cmp"
 
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
 )
@@ -44,6 +45,7 @@ func TestValidate(t *testing.T) {
 		first       gcs.Path
 		second      gcs.Path
 		diffRatioOK float64
+		slowest     int
 		err         bool
 	}{
 		{
@@ -89,6 +91,13 @@ func TestValidate(t *testing.T) {
 			second:      newPathOrDie("gs://path/to/second"),
 			diffRatioOK: 0.5,
 		},
+		{
+			name:    "reject negative slowest",
+			first:   newPathOrDie("gs://path/to/first"),
+			second:  newPathOrDie("gs://path/to/second"),
+			slowest: -1,
+			err:     true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -97,6 +106,7 @@ func TestValidate(t *testing.T) {
 				first:       tc.first,
 				second:      tc.second,
 				diffRatioOK: tc.diffRatioOK,
+				slowest:     tc.slowest,
 			}
 			err := opt.validate()
 			if tc.err && err == nil {
@@ -635,3 +645,65 @@ func TestCompareKeys(t *testing.T) {
 		})
 	}
 }
+
+func TestSlowestUpdates(t *testing.T) {
+	info := func(phases ...*statepb.UpdatePhaseData) *statepb.UpdateInfo {
+		return &statepb.UpdateInfo{UpdatePhaseData: phases}
+	}
+	phase := func(name string, seconds float64) *statepb.UpdatePhaseData {
+		return &statepb.UpdatePhaseData{PhaseName: name, PhaseSeconds: seconds}
+	}
+	updates := []groupUpdate{
+		{
+			name:   "fast",
+			first:  info(phase("read_columns", 1)),
+			second: info(phase("read_columns", 1), phase("shrink", 0.5)),
+		},
+		{
+			name:   "slow",
+			first:  info(phase("read_columns", 3)),
+			second: info(phase("inflate", 2), phase("read_columns", 30), phase("shrink", 1)),
+		},
+		{
+			name:  "unknown",
+			first: info(phase("read_columns", 100)),
+		},
+		{
+			name:   "medium",
+			second: info(phase("construct", 5)),
+		},
+	}
+
+	cases := []struct {
+		name string
+		n    int
+		want []string
+	}{
+		{
+			name: "all",
+			n:    10,
+			want: []string{
+				"slow: 33.0s (read_columns 30.0s), was 3.0s (read_columns 3.0s)",
+				"medium: 5.0s (construct 5.0s), was unknown",
+				"fast: 1.5s (read_columns 1.0s), was 1.0s (read_columns 1.0s)",
+				"unknown: unknown, was 100.0s (read_columns 100.0s)",
+			},
+		},
+		{
+			name: "limit",
+			n:    1,
+			want: []string{
+				"slow: 33.0s (read_columns 30.0s), was 3.0s (read_columns 3.0s)",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := slowestUpdates(append([]groupUpdate(nil), updates...), tc.n)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("slowestUpdates() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
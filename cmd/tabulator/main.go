@@ -31,7 +31,6 @@ import (
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 	"github.com/GoogleCloudPlatform/testgrid/util/metrics/prometheus"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/api/option"
 )
 
 // options configures the updater
@@ -77,7 +76,7 @@ func gatherOptions() options {
 
 	flag.Var(&o.config, "config", "gs://path/to/config.pb")
 	flag.Var(&o.persistQueue, "persist-queue", "Load previous queue state from gs://path/to/queue-state.json and regularly save to it thereafter")
-	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
 	flag.BoolVar(&o.confirm, "confirm", false, "Upload data if set")
 	flag.Var(&o.groups, "group", "Only update named test group if set (repeateable)")
 	flag.BoolVar(&o.useTabAlertSettings, "tab-alerts", false, "Use newer tab settings while caculating alerts")
@@ -183,7 +182,7 @@ func gcsFixer(ctx context.Context, projectSub string, configPath gcs.Path, gridP
 		return nil, errors.New("malformed project/subscription")
 	}
 	projID, subID := parts[0], parts[1]
-	pubsubClient, err := gpubsub.NewClient(ctx, "", option.WithCredentialsFile(credPath))
+	pubsubClient, err := gpubsub.NewClient(ctx, "", gcs.CredsOptions(credPath)...)
 	if err != nil {
 		logrus.WithError(err).Fatal("Failed to create pubsub client")
 	}
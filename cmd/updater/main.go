@@ -21,11 +21,13 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"runtime"
 	"strings"
 	"time"
 
+	"github.com/GoogleCloudPlatform/testgrid/pkg/autobugger"
 	"github.com/GoogleCloudPlatform/testgrid/pkg/pubsub"
 	"github.com/GoogleCloudPlatform/testgrid/pkg/updater"
 	"github.com/GoogleCloudPlatform/testgrid/pkg/updater/resultstore"
@@ -33,7 +35,6 @@ import (
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 	"github.com/GoogleCloudPlatform/testgrid/util/metrics/prometheus"
 	"github.com/sirupsen/logrus"
-	"google.golang.org/api/option"
 
 	gpubsub "cloud.google.com/go/pubsub"
 	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
@@ -56,6 +57,15 @@ type options struct {
 	reprocessList     util.Strings
 	enableIgnoreSkip  bool
 	enableResultStore bool
+	issueStatePrefix  string
+
+	leasePrefix   string
+	leaseHolder   string
+	leaseDuration time.Duration
+
+	githubRepo      string
+	githubTokenFile string
+	githubURL       string
 
 	debug    bool
 	trace    bool
@@ -70,6 +80,9 @@ func (o *options) validate() error {
 	if o.config.Bucket() == "k8s-testgrid" && o.gridPrefix == "" && o.confirm {
 		return fmt.Errorf("--config=%s: cannot write grid state to gs://k8s-testgrid", o.config)
 	}
+	if o.leasePrefix != "" && o.leaseDuration <= 0 {
+		return fmt.Errorf("--lease-duration must be positive: %s", o.leaseDuration)
+	}
 	if o.groupConcurrency == 0 {
 		o.groupConcurrency = runtime.NumCPU()
 	}
@@ -102,7 +115,7 @@ func gatherFlagOptions(fs *flag.FlagSet, args ...string) options {
 	var o options
 	fs.Var(&o.config, "config", "gs://path/to/config.pb")
 	fs.Var(&o.persistQueue, "persist-queue", "Load previous queue state from gs://path/to/queue-state.json and regularly save to it thereafter")
-	fs.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	fs.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
 	fs.BoolVar(&o.confirm, "confirm", false, "Upload data if set")
 	fs.Var(&o.groups, "test-group", "Only update named groups if set (repeatable)")
 	fs.IntVar(&o.groupConcurrency, "group-concurrency", 0, "Manually define the number of groups to concurrently update if non-zero")
@@ -117,6 +130,15 @@ func gatherFlagOptions(fs *flag.FlagSet, args ...string) options {
 
 	fs.BoolVar(&o.enableIgnoreSkip, "enable-ignore-skip", false, "If true, enable ignore_skip behavior.")
 	fs.BoolVar(&o.enableResultStore, "enable-resultstore", false, "If true, fetch results from ResultStore.")
+	fs.StringVar(&o.issueStatePrefix, "issue-state-path", "issues", "Link issues from the issue state under this GCS path for groups that gather bugs.")
+
+	fs.StringVar(&o.leasePrefix, "lease-prefix", "", "Divide groups between replicas with a lease object per group under this GCS path if set")
+	fs.StringVar(&o.leaseHolder, "replica-id", "", "Identify this replica in leases (defaults to hostname and pid)")
+	fs.DurationVar(&o.leaseDuration, "lease-duration", updater.DefaultLeaseDuration, "Another replica may take over a group after its lease goes this long without a heartbeat")
+
+	fs.StringVar(&o.githubRepo, "github-repo", "", "Search this owner/repo for issues if set, for groups with search_issue_tracker")
+	fs.StringVar(&o.githubTokenFile, "github-token-file", "", "/path/to/github/token")
+	fs.StringVar(&o.githubURL, "github-api-url", "", "Base URL of the GitHub API (defaults to api.github.com)")
 
 	fs.BoolVar(&o.debug, "debug", false, "Log debug lines if set")
 	fs.BoolVar(&o.trace, "trace", false, "Log trace and debug lines if set")
@@ -136,20 +158,36 @@ type resultSource string
 const (
 	gcsSource         resultSource = "GCS"
 	resultStoreSource resultSource = "ResultStore"
+	ingestSource      resultSource = "Ingest"
+	bepSource         resultSource = "BEP"
+	githubSource      resultSource = "GitHubActions"
+	combinedSource    resultSource = "Combined"
 	unknownSource     resultSource = "unknown"
 )
 
 func source(tg *configpb.TestGroup) resultSource {
+	if len(tg.GetResultSources()) > 0 {
+		return combinedSource
+	}
 	if tg.GetUseKubernetesClient() || tg.GetResultSource().GetGcsConfig() != nil {
 		return gcsSource
 	}
 	if tg.GetResultSource().GetResultstoreConfig() != nil {
 		return resultStoreSource
 	}
+	if tg.GetResultSource().GetIngestConfig() != nil {
+		return ingestSource
+	}
+	if tg.GetResultSource().GetBepConfig() != nil {
+		return bepSource
+	}
+	if tg.GetResultSource().GetGithubActionsConfig() != nil {
+		return githubSource
+	}
 	return unknownSource
 }
 
-func updateGroup(updateGCS, updateResultStore updater.GroupUpdater) updater.GroupUpdater {
+func updateGroup(updateGCS, updateResultStore, updateIngest, updateBEP, updateGitHub, updateCombined updater.GroupUpdater) updater.GroupUpdater {
 	return func(parent context.Context, log logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path) (bool, error) {
 		source := source(tg)
 		switch source {
@@ -157,12 +195,64 @@ func updateGroup(updateGCS, updateResultStore updater.GroupUpdater) updater.Grou
 			return updateGCS(parent, log, client, tg, gridPath)
 		case resultStoreSource:
 			return updateResultStore(parent, log, client, tg, gridPath)
+		case ingestSource:
+			return updateIngest(parent, log, client, tg, gridPath)
+		case bepSource:
+			return updateBEP(parent, log, client, tg, gridPath)
+		case githubSource:
+			return updateGitHub(parent, log, client, tg, gridPath)
+		case combinedSource:
+			return updateCombined(parent, log, client, tg, gridPath)
+		default:
+			return false, errors.New("invalid result source (must be one of GCS, ResultStore, Ingest, BEP, GitHubActions)")
+		}
+	}
+}
+
+// sourceReader returns the ColumnReader for each of a combined group's result sources.
+func sourceReader(readGCS, readResultStore, readIngest, readBEP, readGitHub updater.ColumnReader) updater.SourceReader {
+	return func(tg *configpb.TestGroup) updater.ColumnReader {
+		switch source(tg) {
+		case gcsSource:
+			return readGCS
+		case resultStoreSource:
+			return readResultStore
+		case ingestSource:
+			return readIngest
+		case bepSource:
+			return readBEP
+		case githubSource:
+			return readGitHub
 		default:
-			return false, errors.New("invalid result source (must be one of GCS, ResultStore)")
+			return nil
 		}
 	}
 }
 
+// githubToken returns the contents of the token file, if any.
+func githubToken(opt options) (string, error) {
+	if opt.githubTokenFile == "" {
+		return "", nil
+	}
+	buf, err := ioutil.ReadFile(opt.githubTokenFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(buf)), nil
+}
+
+// issueSearcher returns the configured searcher, if any.
+func issueSearcher(opt options) (updater.IssueSearcher, error) {
+	if opt.githubRepo == "" {
+		return nil, nil
+	}
+	token, err := githubToken(opt)
+	if err != nil {
+		return nil, err
+	}
+	return autobugger.NewGitHubTracker(opt.githubRepo, token, opt.githubURL)
+}
+
 func main() {
 	opt := gatherOptions()
 	if err := opt.validate(); err != nil {
@@ -209,13 +299,39 @@ func main() {
 		rsClient = resultstore.NewClient(rsConn)
 	}
 
-	updateGCS := updater.GCS(ctx, client, opt.groupTimeout, opt.buildTimeout, opt.buildConcurrency, opt.confirm, opt.enableIgnoreSkip)
-	updateResultStore := resultstore.Updater(rsClient, client, opt.groupTimeout, opt.confirm)
-	updateAll := updateGroup(updateGCS, updateResultStore)
+	searcher, err := issueSearcher(opt)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to configure issue searcher")
+	}
+	gatherIssues := updater.GatherIssues(client, opt.config, opt.issueStatePrefix, searcher)
+
+	updateGCS := updater.GCS(ctx, client, opt.groupTimeout, opt.buildTimeout, opt.buildConcurrency, opt.confirm, opt.enableIgnoreSkip, gatherIssues)
+	updateResultStore := resultstore.Updater(rsClient, client, opt.groupTimeout, opt.confirm, gatherIssues)
+	updateIngest := updater.Ingest(client, opt.groupTimeout, opt.confirm, gatherIssues)
+	updateBEP := resultstore.BEPUpdater(client, opt.groupTimeout, opt.confirm, gatherIssues)
+	token, err := githubToken(opt)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to read GitHub token")
+	}
+	actions := updater.NewGitHubActionsClient(token, opt.githubURL)
+	updateGitHub := updater.GitHubActions(actions, opt.groupTimeout, opt.confirm, gatherIssues)
+	var readResultStore updater.ColumnReader
+	if rsClient != nil {
+		readResultStore = resultstore.ColumnReader(rsClient, 0)
+	}
+	readSource := sourceReader(
+		updater.GCSColumnReader(ctx, client, opt.buildTimeout, opt.buildConcurrency, opt.enableIgnoreSkip),
+		readResultStore,
+		updater.IngestColumnReader(client),
+		resultstore.BEPColumnReader(client, 0),
+		updater.GitHubActionsColumnReader(actions),
+	)
+	updateCombined := updater.Combined(readSource, opt.groupTimeout, opt.confirm, gatherIssues)
+	updateAll := updateGroup(updateGCS, updateResultStore, updateIngest, updateBEP, updateGitHub, updateCombined)
 
 	mets := updater.CreateMetrics(prometheus.NewFactory())
 
-	pubsubClient, err := gpubsub.NewClient(ctx, "", option.WithCredentialsFile(opt.creds))
+	pubsubClient, err := gpubsub.NewClient(ctx, "", gcs.CredsOptions(opt.creds)...)
 	if err != nil {
 		logrus.WithError(err).Fatal("Failed to create pubsub client")
 	}
@@ -238,6 +354,9 @@ func main() {
 		GroupNames:       opt.groups.Strings(),
 		Write:            opt.confirm,
 		Freq:             opt.wait,
+		LeasePrefix:      opt.leasePrefix,
+		LeaseHolder:      opt.leaseHolder,
+		LeaseDuration:    opt.leaseDuration,
 	}
 
 	if err := updater.Update(ctx, client, mets, updateAll, opts, fixers...); err != nil {
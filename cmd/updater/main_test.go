@@ -113,6 +113,30 @@ func TestGatherFlagOptions(t *testing.T) {
 				o.confirm = true
 			},
 		},
+		{
+			name: "leases work",
+			args: []string{
+				"--config=gs://bucket/whatever",
+				"--lease-prefix=leases",
+				"--replica-id=replica-1",
+				"--lease-duration=1m",
+			},
+			want: func(o *options) {
+				o.config = *newPathOrDie("gs://bucket/whatever")
+				o.leasePrefix = "leases"
+				o.leaseHolder = "replica-1"
+				o.leaseDuration = time.Minute
+			},
+		},
+		{
+			name: "reject non-positive --lease-duration",
+			args: []string{
+				"--config=gs://bucket/whatever",
+				"--lease-prefix=leases",
+				"--lease-duration=0s",
+			},
+			err: true,
+		},
 	}
 
 	for _, tc := range cases {
@@ -124,6 +148,8 @@ func TestGatherFlagOptions(t *testing.T) {
 				groupConcurrency: cores,
 				groupTimeout:     10 * time.Minute,
 				gridPrefix:       "grid",
+				issueStatePrefix: "issues",
+				leaseDuration:    5 * time.Minute,
 			}
 			if tc.want != nil {
 				tc.want(&want)
@@ -214,6 +240,58 @@ func TestSource(t *testing.T) {
 			},
 			want: gcsSource,
 		},
+		{
+			name: "Ingest source",
+			tg: &configpb.TestGroup{
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+						IngestConfig: &configpb.IngestConfig{},
+					},
+				},
+			},
+			want: ingestSource,
+		},
+		{
+			name: "BEP source",
+			tg: &configpb.TestGroup{
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_BepConfig{
+						BepConfig: &configpb.BEPConfig{},
+					},
+				},
+			},
+			want: bepSource,
+		},
+		{
+			name: "GitHub Actions source",
+			tg: &configpb.TestGroup{
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_GithubActionsConfig{
+						GithubActionsConfig: &configpb.GitHubActionsConfig{},
+					},
+				},
+			},
+			want: githubSource,
+		},
+		{
+			name: "combined sources",
+			tg: &configpb.TestGroup{
+				UseKubernetesClient: true,
+				ResultSources: []*configpb.TestGroup_ResultSource{
+					{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_GcsConfig{
+							GcsConfig: &configpb.GCSConfig{},
+						},
+					},
+					{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_ResultstoreConfig{
+							ResultstoreConfig: &configpb.ResultStoreConfig{},
+						},
+					},
+				},
+			},
+			want: combinedSource,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -96,11 +96,11 @@ func ReadPath(path string) (*configpb.Configuration, error) {
 	return Unmarshal(f)
 }
 
-// Read will read the Configuration proto message from a local or gs:// path.
+// Read will read the Configuration proto message from a local, gs:// or s3:// path.
 //
-// The ctx and client are only relevant when path refers to GCS.
+// The ctx and client are only relevant when path refers to GCS or S3.
 func Read(ctx context.Context, path string, client *storage.Client) (*configpb.Configuration, error) {
-	if strings.HasPrefix(path, "gs://") {
+	if strings.HasPrefix(path, "gs://") || strings.HasPrefix(path, "s3://") {
 		gcsPath, err := gcs.NewPath(path)
 		if err != nil {
 			return nil, fmt.Errorf("bad path: %v", err)
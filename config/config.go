@@ -256,14 +256,48 @@ func validateEmails(addresses string) error {
 	return nil
 }
 
+// validateResultSource returns errors for an incomplete result source configuration.
+func validateResultSource(src *configpb.TestGroup_ResultSource) error {
+	var mErr error
+	if cfg := src.GetIngestConfig(); cfg != nil && cfg.GetGcsPrefix() == "" {
+		mErr = multierror.Append(mErr, errors.New("ingest_config requires gcs_prefix"))
+	}
+	if cfg := src.GetBepConfig(); cfg != nil && cfg.GetGcsPrefix() == "" {
+		mErr = multierror.Append(mErr, errors.New("bep_config requires gcs_prefix"))
+	}
+	if cfg := src.GetGithubActionsConfig(); cfg != nil {
+		if parts := strings.Split(cfg.GetRepository(), "/"); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			mErr = multierror.Append(mErr, fmt.Errorf("github_actions_config repository must be owner/repo, got %q", cfg.GetRepository()))
+		}
+		if cfg.GetWorkflow() == "" {
+			mErr = multierror.Append(mErr, errors.New("github_actions_config requires workflow"))
+		}
+		if _, err := regexp.Compile(cfg.GetArtifactRegex()); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("github_actions_config artifact_regex: %w", err))
+		}
+	}
+	return mErr
+}
+
 func validateTestGroup(tg *configpb.TestGroup) error {
 	var mErr error
 	if tg == nil {
 		return multierror.Append(mErr, errors.New("got an empty TestGroup"))
 	}
 	// Check that required fields are a non-zero-value.
-	if tg.GetGcsPrefix() == "" && tg.GetResultSource() == nil {
-		mErr = multierror.Append(mErr, errors.New("require one of gcs_prefix or result_source"))
+	if tg.GetGcsPrefix() == "" && tg.GetResultSource() == nil && len(tg.GetResultSources()) == 0 {
+		mErr = multierror.Append(mErr, errors.New("require one of gcs_prefix, result_source or result_sources"))
+	}
+	if err := validateResultSource(tg.GetResultSource()); err != nil {
+		mErr = multierror.Append(mErr, err)
+	}
+	for i, src := range tg.GetResultSources() {
+		if src.GetResultSourceConfig() == nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("result_sources[%d] is empty", i))
+		}
+		if err := validateResultSource(src); err != nil {
+			mErr = multierror.Append(mErr, fmt.Errorf("result_sources[%d]: %w", i, err))
+		}
 	}
 	if tg.GetDaysOfResults() <= 0 {
 		mErr = multierror.Append(mErr, errors.New("days_of_results should be positive"))
@@ -277,6 +311,13 @@ func validateTestGroup(tg *configpb.TestGroup) error {
 		mErr = multierror.Append(mErr, fmt.Errorf("test_method_match_regex doesn't compile: %v", err))
 	}
 
+	if _, err := regexp.Compile(tg.GetOutputPropertyOptions().GetKeyValueRegex()); err != nil {
+		mErr = multierror.Append(mErr, fmt.Errorf("key_value_regex doesn't compile: %v", err))
+	}
+	if opts := tg.GetOutputPropertyOptions(); opts.GetSystemOutChars() < 0 || opts.GetSystemErrChars() < 0 {
+		mErr = multierror.Append(mErr, errors.New("system_out_chars and system_err_chars must not be negative"))
+	}
+
 	// Email address for alerts should be valid.
 	if tg.GetAlertMailToAddresses() != "" {
 		if err := validateEmails(tg.GetAlertMailToAddresses()); err != nil {
@@ -323,6 +364,12 @@ func validateTestGroup(tg *configpb.TestGroup) error {
 		)
 	}
 
+	for _, format := range tg.GetArtifactFormats() {
+		if format == configpb.TestGroup_ARTIFACT_FORMAT_UNSPECIFIED {
+			mErr = multierror.Append(mErr, errors.New("artifact_formats must be specified"))
+		}
+	}
+
 	// For each defined column_header, verify it has exactly one value set.
 	for idx, header := range tg.GetColumnHeader() {
 		if cv, p, l := header.ConfigurationValue, header.Property, header.Label; cv == "" && p == "" && l == "" {
@@ -407,6 +454,16 @@ func validateDashboardTab(dt *configpb.DashboardTab) error {
 		}
 	}
 
+	// Healthiness grouping regex should be valid.
+	if _, err := regexp.Compile(dt.GetHealthAnalysisOptions().GetGroupingRegex()); err != nil {
+		mErr = multierror.Append(mErr, fmt.Errorf("grouping_regex doesn't compile: %v", err))
+	}
+
+	// Metric regression thresholds should be non-negative.
+	if opts := dt.GetMetricRegressionOptions(); opts.GetColumns() < 0 || opts.GetMinSegment() < 0 || opts.GetMinRelativeChange() < 0 || opts.GetMinScore() < 0 {
+		mErr = multierror.Append(mErr, errors.New("metric_regression_options values should not be negative"))
+	}
+
 	// Email address for alerts should be valid.
 	if dt.GetAlertOptions().GetAlertMailToAddresses() != "" {
 		if err := validateEmails(dt.GetAlertOptions().GetAlertMailToAddresses()); err != nil {
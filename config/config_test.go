@@ -479,6 +479,30 @@ func TestValidateTestGroup(t *testing.T) {
 				NumColumnsRecent: 1,
 			},
 		},
+		{
+			name: "Artifact formats pass",
+			pass: true,
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				ArtifactFormats: []configpb.TestGroup_ArtifactFormat{
+					configpb.TestGroup_ARTIFACT_FORMAT_TAP,
+					configpb.TestGroup_ARTIFACT_FORMAT_JUNIT,
+				},
+			},
+		},
+		{
+			name: "Artifact formats must be specified",
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				ArtifactFormats:  []configpb.TestGroup_ArtifactFormat{configpb.TestGroup_ARTIFACT_FORMAT_UNSPECIFIED},
+			},
+		},
 		{
 			name: "Must have days_of_results",
 			testGroup: &configpb.TestGroup{
@@ -847,6 +871,156 @@ func TestValidateTestGroup(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "invalid key value regex",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				OutputPropertyOptions: &configpb.OutputPropertyOptions{
+					KeyValueRegex: "?bad",
+				},
+			},
+		},
+		{
+			name: "ingest config without prefix",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+						IngestConfig: &configpb.IngestConfig{},
+					},
+				},
+			},
+		},
+		{
+			name: "bep config without prefix",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_BepConfig{
+						BepConfig: &configpb.BEPConfig{},
+					},
+				},
+			},
+		},
+		{
+			name: "github actions config without workflow",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_GithubActionsConfig{
+						GithubActionsConfig: &configpb.GitHubActionsConfig{
+							Repository: "org/repo",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "github actions config with bad repository",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_GithubActionsConfig{
+						GithubActionsConfig: &configpb.GitHubActionsConfig{
+							Repository: "repo",
+							Workflow:   "ci.yaml",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "github actions config with bad artifact regex",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_GithubActionsConfig{
+						GithubActionsConfig: &configpb.GitHubActionsConfig{
+							Repository:    "org/repo",
+							Workflow:      "ci.yaml",
+							ArtifactRegex: "?bad",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "combined result sources pass",
+			pass: true,
+			testGroup: &configpb.TestGroup{
+				Name:             "test_group",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSources: []*configpb.TestGroup_ResultSource{
+					{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_GcsConfig{
+							GcsConfig: &configpb.GCSConfig{GcsPrefix: "bucket/old"},
+						},
+					},
+					{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_ResultstoreConfig{
+							ResultstoreConfig: &configpb.ResultStoreConfig{Project: "project"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "empty combined result source",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSources: []*configpb.TestGroup_ResultSource{
+					{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_GcsConfig{
+							GcsConfig: &configpb.GCSConfig{GcsPrefix: "bucket/old"},
+						},
+					},
+					{},
+				},
+			},
+		},
+		{
+			name: "combined ingest config without prefix",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				NumColumnsRecent: 1,
+				ResultSources: []*configpb.TestGroup_ResultSource{
+					{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+							IngestConfig: &configpb.IngestConfig{},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "negative system out chars",
+			testGroup: &configpb.TestGroup{
+				Name:             "bad",
+				DaysOfResults:    1,
+				GcsPrefix:        "fake path",
+				NumColumnsRecent: 1,
+				OutputPropertyOptions: &configpb.OutputPropertyOptions{
+					SystemOutChars: -1,
+				},
+			},
+		},
 		{
 			name: "invalid message regex",
 			testGroup: &configpb.TestGroup{
@@ -990,6 +1164,51 @@ func TestValidateDashboardTab(t *testing.T) {
 			},
 			err: true,
 		},
+		{
+			name: "healthiness grouping regex basically works",
+			tab: &configpb.DashboardTab{
+				Name:          "tabby",
+				TestGroupName: "test_group_1",
+				HealthAnalysisOptions: &configpb.HealthAnalysisOptions{
+					GroupingRegex: ` - \w+`,
+				},
+			},
+		},
+		{
+			name: "healthiness grouping regex, invalid compile",
+			tab: &configpb.DashboardTab{
+				Name:          "tabby",
+				TestGroupName: "test_group_1",
+				HealthAnalysisOptions: &configpb.HealthAnalysisOptions{
+					GroupingRegex: `([1!]`,
+				},
+			},
+			err: true,
+		},
+		{
+			name: "metric regression options basically work",
+			tab: &configpb.DashboardTab{
+				Name:          "tabby",
+				TestGroupName: "test_group_1",
+				MetricRegressionOptions: &configpb.MetricRegressionOptions{
+					Enable:            true,
+					Metrics:           []string{"test-duration-minutes"},
+					MinRelativeChange: 0.2,
+				},
+			},
+		},
+		{
+			name: "negative metric regression options",
+			tab: &configpb.DashboardTab{
+				Name:          "tabby",
+				TestGroupName: "test_group_1",
+				MetricRegressionOptions: &configpb.MetricRegressionOptions{
+					Enable:     true,
+					MinSegment: -1,
+				},
+			},
+			err: true,
+		},
 		{
 			name: "invalid max acceptable flakiness parameter",
 			tab: &configpb.DashboardTab{
@@ -43,7 +43,7 @@ func gatherOptions() options {
 		o.configPath = os.Args[1]
 	}
 	flag.StringVar(&o.configPath, "path", o.configPath, "Local or cloud config to read")
-	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty)")
+	flag.StringVar(&o.creds, "gcp-service-account", "", "/path/to/gcp/creds (use local creds if empty, none if anonymous)")
 	flag.BoolVar(&o.printFieldUse, "print-field-use", false, "If True, print all config fields and # of uses of each")
 	flag.BoolVar(&o.compressed, "compressed", false, "Disables pretty-printing, removing all whitespace between fields")
 	flag.Parse()
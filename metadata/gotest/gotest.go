@@ -0,0 +1,224 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gotest parses the `go test -json` output of test2json into junit
+// suites, so it can be displayed like any other junit result.
+package gotest
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+// ErrNotTestJSON means the stream does not start with a test2json event.
+var ErrNotTestJSON = errors.New("not go test -json output")
+
+// Event is a single line of test2json output, see `go doc test2json`.
+type Event struct {
+	Time        time.Time `json:",omitempty"`
+	Action      string
+	Package     string  `json:",omitempty"`
+	Test        string  `json:",omitempty"`
+	Elapsed     float64 `json:",omitempty"` // Seconds
+	Output      string  `json:",omitempty"`
+	ImportPath  string  `json:",omitempty"`
+	FailedBuild string  `json:",omitempty"`
+}
+
+const (
+	actionPass        = "pass"
+	actionFail        = "fail"
+	actionSkip        = "skip"
+	actionOutput      = "output"
+	actionBuildOutput = "build-output"
+)
+
+// test accumulates the events of a single test or subtest.
+type test struct {
+	name    string
+	action  string
+	elapsed float64
+	output  strings.Builder
+}
+
+// pkg accumulates the events of a package.
+type pkg struct {
+	test
+	tests map[string]*test
+	order []*test
+}
+
+// Parse returns the Suites representation of these test2json bytes.
+func Parse(buf []byte) (*junit.Suites, error) {
+	return ParseStream(bytes.NewReader(buf))
+}
+
+// ParseStream reads test2json events into a Suites object.
+//
+// Each package becomes a suite and each test, including subtests, a result
+// whose message is the output of the test. Packages that fail outside of any
+// test, such as failed builds, get a failing result with an empty name.
+//
+// Returns ErrNotTestJSON when the first line is not a test2json event, and
+// ignores any later lines that are not (such as interleaved stderr).
+func ParseStream(reader io.Reader) (*junit.Suites, error) {
+	var order []*pkg
+	pkgs := map[string]*pkg{}
+	buildOutput := map[string]*strings.Builder{}
+
+	r := bufio.NewReader(reader)
+	var started bool
+	for {
+		line, err := r.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+		if trimmed := bytes.TrimSpace(line); len(trimmed) > 0 {
+			var ev Event
+			if jerr := json.Unmarshal(trimmed, &ev); jerr != nil || ev.Action == "" {
+				if !started {
+					return nil, ErrNotTestJSON
+				}
+			} else {
+				started = true
+				if ev.Action == actionBuildOutput {
+					b, ok := buildOutput[ev.ImportPath]
+					if !ok {
+						b = &strings.Builder{}
+						buildOutput[ev.ImportPath] = b
+					}
+					b.WriteString(ev.Output)
+				} else if ev.Package != "" {
+					p, ok := pkgs[ev.Package]
+					if !ok {
+						p = &pkg{
+							test:  test{name: ev.Package},
+							tests: map[string]*test{},
+						}
+						pkgs[ev.Package] = p
+						order = append(order, p)
+					}
+					p.add(ev, buildOutput)
+				}
+			}
+		}
+		if err == io.EOF {
+			break
+		}
+	}
+
+	var suites junit.Suites
+	for _, p := range order {
+		if suite := p.suite(); len(suite.Results) > 0 {
+			suites.Suites = append(suites.Suites, suite)
+		}
+	}
+	return &suites, nil
+}
+
+func (p *pkg) add(ev Event, buildOutput map[string]*strings.Builder) {
+	t := &p.test
+	if ev.Test != "" {
+		var ok bool
+		t, ok = p.tests[ev.Test]
+		if !ok {
+			t = &test{name: ev.Test}
+			p.tests[ev.Test] = t
+			p.order = append(p.order, t)
+		}
+	}
+	switch ev.Action {
+	case actionOutput:
+		if !framing(ev.Output) {
+			t.output.WriteString(ev.Output)
+		}
+	case actionPass, actionFail, actionSkip:
+		t.action = ev.Action
+		t.elapsed = ev.Elapsed
+		if b, ok := buildOutput[ev.FailedBuild]; ok && ev.FailedBuild != "" {
+			t.output.WriteString(b.String())
+		}
+	}
+}
+
+// framing returns true for the lines go test adds around test output.
+func framing(line string) bool {
+	line = strings.TrimSpace(line)
+	for _, prefix := range []string{"=== ", "--- PASS:", "--- FAIL:", "--- SKIP:", "ok  \t", "FAIL\t"} {
+		if strings.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+	return line == "PASS" || line == "FAIL"
+}
+
+func (p *pkg) suite() junit.Suite {
+	suite := junit.Suite{
+		Name: p.name,
+		Time: p.elapsed,
+	}
+	var failed bool
+	for _, t := range p.order {
+		r := t.result()
+		switch {
+		case r.Failure != nil:
+			failed = true
+			suite.Failures++
+		case r.Skipped != nil:
+			suite.Skipped++
+		}
+		suite.Tests++
+		suite.Results = append(suite.Results, r)
+	}
+	if p.action == actionFail && !failed {
+		r := p.result()
+		r.Name = ""
+		if r.Failure.Value == "" {
+			r.Failure.Message = "package failed"
+		}
+		suite.Failures++
+		suite.Results = append(suite.Results, r)
+	}
+	return suite
+}
+
+func (t *test) result() junit.Result {
+	r := junit.Result{
+		Name: t.name,
+		Time: t.elapsed,
+	}
+	out := strings.TrimSpace(t.output.String())
+	switch t.action {
+	case actionPass:
+		if out != "" {
+			r.Output = &out
+		}
+	case actionSkip:
+		r.Skipped = &junit.Skipped{Value: out}
+	case actionFail:
+		r.Failure = &junit.Failure{Value: out}
+	default:
+		r.Failure = &junit.Failure{Message: "did not finish", Value: out}
+	}
+	return r
+}
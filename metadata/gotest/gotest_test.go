@@ -0,0 +1,206 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gotest
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+func TestParse(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	cases := []struct {
+		name     string
+		buf      string
+		expected *junit.Suites
+		err      error
+	}{
+		{
+			name:     "parse empty file as empty suites",
+			expected: &junit.Suites{},
+		},
+		{
+			name: "reject other json",
+			buf:  `{"hello": "world"}`,
+			err:  ErrNotTestJSON,
+		},
+		{
+			name: "reject pretty json",
+			buf: `{
+  "Action": "pass"
+}`,
+			err: ErrNotTestJSON,
+		},
+		{
+			name: "tests and subtests",
+			buf: `{"Action":"start","Package":"example.com/foo"}
+{"Action":"run","Package":"example.com/foo","Test":"TestPass"}
+{"Action":"output","Package":"example.com/foo","Test":"TestPass","Output":"=== RUN   TestPass\n"}
+{"Action":"output","Package":"example.com/foo","Test":"TestPass","Output":"--- PASS: TestPass (0.50s)\n"}
+{"Action":"pass","Package":"example.com/foo","Test":"TestPass","Elapsed":0.5}
+{"Action":"run","Package":"example.com/foo","Test":"TestFail"}
+{"Action":"run","Package":"example.com/foo","Test":"TestFail/sub"}
+{"Action":"output","Package":"example.com/foo","Test":"TestFail/sub","Output":"    foo_test.go:12: got 1, want 2\n"}
+{"Action":"output","Package":"example.com/foo","Test":"TestFail/sub","Output":"    --- FAIL: TestFail/sub (0.10s)\n"}
+{"Action":"fail","Package":"example.com/foo","Test":"TestFail/sub","Elapsed":0.1}
+{"Action":"output","Package":"example.com/foo","Test":"TestFail","Output":"--- FAIL: TestFail (0.20s)\n"}
+{"Action":"fail","Package":"example.com/foo","Test":"TestFail","Elapsed":0.2}
+{"Action":"run","Package":"example.com/foo","Test":"TestSkip"}
+{"Action":"output","Package":"example.com/foo","Test":"TestSkip","Output":"    foo_test.go:20: windows only\n"}
+{"Action":"skip","Package":"example.com/foo","Test":"TestSkip"}
+this line is not json
+{"Action":"output","Package":"example.com/foo","Output":"FAIL\n"}
+{"Action":"output","Package":"example.com/foo","Output":"FAIL\texample.com/foo\t0.8s\n"}
+{"Action":"fail","Package":"example.com/foo","Elapsed":0.8}
+{"Action":"output","Package":"example.com/empty","Output":"?   \texample.com/empty\t[no test files]\n"}
+{"Action":"skip","Package":"example.com/empty","Elapsed":0}
+`,
+			expected: &junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:     "example.com/foo",
+						Time:     0.8,
+						Tests:    4,
+						Failures: 2,
+						Skipped:  1,
+						Results: []junit.Result{
+							{
+								Name: "TestPass",
+								Time: 0.5,
+							},
+							{
+								Name:    "TestFail",
+								Time:    0.2,
+								Failure: &junit.Failure{},
+							},
+							{
+								Name:    "TestFail/sub",
+								Time:    0.1,
+								Failure: &junit.Failure{Value: "foo_test.go:12: got 1, want 2"},
+							},
+							{
+								Name:    "TestSkip",
+								Skipped: &junit.Skipped{Value: "foo_test.go:20: windows only"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "package failures",
+			buf: `{"ImportPath":"example.com/broken [example.com/broken.test]","Action":"build-output","Output":"# example.com/broken\n"}
+{"ImportPath":"example.com/broken [example.com/broken.test]","Action":"build-output","Output":"./broken.go:3:1: syntax error\n"}
+{"ImportPath":"example.com/broken [example.com/broken.test]","Action":"build-fail"}
+{"Action":"start","Package":"example.com/broken"}
+{"Action":"output","Package":"example.com/broken","Output":"FAIL\texample.com/broken [build failed]\n"}
+{"Action":"fail","Package":"example.com/broken","Elapsed":0,"FailedBuild":"example.com/broken [example.com/broken.test]"}
+{"Action":"start","Package":"example.com/main"}
+{"Action":"output","Package":"example.com/main","Output":"TestMain setup failed\n"}
+{"Action":"output","Package":"example.com/main","Output":"PASS\n"}
+{"Action":"fail","Package":"example.com/main","Elapsed":1.5}
+{"Action":"start","Package":"example.com/silent"}
+{"Action":"fail","Package":"example.com/silent","Elapsed":2}
+{"Action":"start","Package":"example.com/ok"}
+{"Action":"run","Package":"example.com/ok","Test":"TestOK"}
+{"Action":"output","Package":"example.com/ok","Test":"TestOK","Output":"some logging\n"}
+{"Action":"pass","Package":"example.com/ok","Test":"TestOK","Elapsed":0.1}
+{"Action":"run","Package":"example.com/ok","Test":"TestHang"}
+{"Action":"output","Package":"example.com/ok","Test":"TestHang","Output":"waiting\n"}
+{"Action":"pass","Package":"example.com/ok","Elapsed":3}
+`,
+			expected: &junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:     "example.com/broken",
+						Failures: 1,
+						Results: []junit.Result{
+							{
+								Failure: &junit.Failure{Value: "# example.com/broken\n./broken.go:3:1: syntax error"},
+							},
+						},
+					},
+					{
+						Name:     "example.com/main",
+						Time:     1.5,
+						Failures: 1,
+						Results: []junit.Result{
+							{
+								Time:    1.5,
+								Failure: &junit.Failure{Value: "TestMain setup failed"},
+							},
+						},
+					},
+					{
+						Name:     "example.com/silent",
+						Time:     2,
+						Failures: 1,
+						Results: []junit.Result{
+							{
+								Time:    2,
+								Failure: &junit.Failure{Message: "package failed"},
+							},
+						},
+					},
+					{
+						Name:     "example.com/ok",
+						Time:     3,
+						Tests:    2,
+						Failures: 1,
+						Results: []junit.Result{
+							{
+								Name:   "TestOK",
+								Time:   0.1,
+								Output: pstr("some logging"),
+							},
+							{
+								Name:    "TestHang",
+								Failure: &junit.Failure{Message: "did not finish", Value: "waiting"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Parse([]byte(tc.buf))
+			switch {
+			case err != nil:
+				if tc.expected != nil {
+					t.Errorf("Parse() got unexpected error: %v", err)
+				} else if tc.err != nil && !errors.Is(err, tc.err) {
+					t.Errorf("Parse() got error %v, want %v", err, tc.err)
+				}
+			case tc.expected == nil:
+				t.Errorf("Parse() got %v, wanted an error", actual)
+			default:
+				if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("Parse() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
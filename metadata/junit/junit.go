@@ -108,6 +108,15 @@ type Result struct {
 	Skipped    *Skipped    `xml:"skipped,omitempty"`
 	Status     string      `xml:"status,attr"`
 	Properties *Properties `xml:"properties,omitempty"`
+
+	// Surefire reruns of a test that eventually passed.
+	FlakyFailures []Rerun `xml:"flakyFailure,omitempty"`
+	FlakyErrors   []Rerun `xml:"flakyError,omitempty"`
+	// Surefire reruns of a test that failed every time.
+	RerunFailures []Rerun `xml:"rerunFailure,omitempty"`
+	RerunErrors   []Rerun `xml:"rerunError,omitempty"`
+	// pytest-rerunfailures reruns.
+	Reruns []Rerun `xml:"rerun,omitempty"`
 }
 
 // Errored holds <error/> elements.
@@ -130,6 +139,28 @@ type Skipped struct {
 	Value   string `xml:",chardata"`
 }
 
+// Rerun holds <flakyFailure/>, <flakyError/>, <rerunFailure/>, <rerunError/> and <rerun/> elements.
+type Rerun struct {
+	Message    string `xml:"message,attr"`
+	Type       string `xml:"type,attr"`
+	StackTrace string `xml:"stackTrace,omitempty"`
+	Value      string `xml:",chardata"`
+}
+
+// AllReruns returns every failed attempt that was rerun, flakes first.
+func (r Result) AllReruns() []Rerun {
+	var out []Rerun
+	for _, reruns := range [][]Rerun{r.FlakyFailures, r.FlakyErrors, r.RerunFailures, r.RerunErrors, r.Reruns} {
+		out = append(out, reruns...)
+	}
+	return out
+}
+
+// Flaky returns true when the test passed after one or more failed attempts.
+func (r Result) Flaky() bool {
+	return r.Errored == nil && r.Failure == nil && r.Skipped == nil && len(r.AllReruns()) > 0
+}
+
 // SetProperty adds the specified property to the Result or replaces the
 // existing value if a property with that name already exists.
 func (r *Result) SetProperty(name, value string) {
@@ -154,9 +185,17 @@ func (r *Result) SetProperty(name, value string) {
 
 // Message extracts the message for the junit test case.
 //
-// Will use the first non-empty <error/>, <failure/>, <skipped/>, <system-err/>, <system-out/> value.
+// Will use the first non-empty <error/>, <failure/>, <skipped/>, rerun, <system-err/>, <system-out/> value.
 func (r Result) Message(max int) string {
 	var msg string
+	var rerun Rerun
+	if reruns := r.AllReruns(); len(reruns) > 0 {
+		rerun = reruns[0]
+		// Surefire nests the trace in <stackTrace/>, leaving only whitespace chardata.
+		if rerun.Value = strings.TrimSpace(rerun.Value); rerun.Value == "" {
+			rerun.Value = strings.TrimSpace(rerun.StackTrace)
+		}
+	}
 	switch {
 	case r.Errored != nil && (r.Errored.Message != "" || r.Errored.Value != ""):
 		msg = composeMessage(r.Errored.Message, r.Errored.Value)
@@ -164,6 +203,8 @@ func (r Result) Message(max int) string {
 		msg = composeMessage(r.Failure.Message, r.Failure.Value)
 	case r.Skipped != nil && (r.Skipped.Message != "" || r.Skipped.Value != ""):
 		msg = composeMessage(r.Skipped.Message, r.Skipped.Value)
+	case rerun.Message != "" || rerun.Value != "":
+		msg = composeMessage(rerun.Message, rerun.Value)
 	case r.Error != nil && *r.Error != "":
 		msg = *r.Error
 	case r.Output != nil && *r.Output != "":
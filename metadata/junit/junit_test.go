@@ -129,6 +129,27 @@ func TestMessage(t *testing.T) {
 			},
 			expected: "skipped-1",
 		},
+		{
+			name: "first rerun prioritized over error and output",
+			jr: Result{
+				FlakyFailures: []Rerun{
+					{Message: "flaky-0-msg", StackTrace: "\n  flaky-0-trace\n"},
+					{Message: "flaky-1-msg"},
+				},
+				Reruns: []Rerun{{Message: "rerun-2-msg"}},
+				Error:  pstr("error-3"),
+				Output: pstr("output-4"),
+			},
+			expected: "flaky-0-msg\nflaky-0-trace",
+		},
+		{
+			name: "skipped prioritized over reruns",
+			jr: Result{
+				Skipped:     &Skipped{Message: "skipped-0-msg"},
+				RerunErrors: []Rerun{{Message: "rerun-1-msg"}},
+			},
+			expected: "skipped-0-msg",
+		},
 		{
 			name: "error has higher priority than output",
 			jr: Result{
@@ -171,6 +192,54 @@ func TestMessage(t *testing.T) {
 	}
 }
 
+func TestFlaky(t *testing.T) {
+	cases := []struct {
+		name     string
+		jr       Result
+		expected bool
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "passed after flaky failure",
+			jr: Result{
+				FlakyFailures: []Rerun{{Message: "boom"}},
+			},
+			expected: true,
+		},
+		{
+			name: "passed after pytest rerun",
+			jr: Result{
+				Reruns: []Rerun{{Message: "boom"}},
+			},
+			expected: true,
+		},
+		{
+			name: "failed every rerun",
+			jr: Result{
+				Failure:       &Failure{Message: "boom"},
+				RerunFailures: []Rerun{{Message: "bang"}},
+			},
+		},
+		{
+			name: "skipped",
+			jr: Result{
+				Skipped:     &Skipped{},
+				FlakyErrors: []Rerun{{Message: "boom"}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := tc.jr.Flaky(); actual != tc.expected {
+				t.Errorf("jr.Flaky() got %t, want %t", actual, tc.expected)
+			}
+		})
+	}
+}
+
 func TestParse(t *testing.T) {
 	pstr := func(s string) *string {
 		return &s
@@ -278,6 +347,54 @@ func TestParse(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "parse surefire and pytest reruns",
+			buf: []byte(`
+                        <testsuite name="reruns">
+                            <testcase name="flake">
+                                <flakyFailure message="first" type="java.lang.AssertionError"><stackTrace>trace</stackTrace></flakyFailure>
+                                <flakyError message="second" type="java.io.IOException"></flakyError>
+                            </testcase>
+                            <testcase name="broken">
+                                <failure message="first"/>
+                                <rerunFailure message="second"/>
+                                <rerunError message="third"/>
+                            </testcase>
+                            <testcase name="retried">
+                                <rerun message="once">body</rerun>
+                            </testcase>
+                        </testsuite>
+                        `),
+			expected: &Suites{
+				Suites: []Suite{
+					{
+						XMLName: xml.Name{Local: "testsuite"},
+						Name:    "reruns",
+						Results: []Result{
+							{
+								Name: "flake",
+								FlakyFailures: []Rerun{
+									{Message: "first", Type: "java.lang.AssertionError", StackTrace: "trace"},
+								},
+								FlakyErrors: []Rerun{
+									{Message: "second", Type: "java.io.IOException"},
+								},
+							},
+							{
+								Name:          "broken",
+								Failure:       &Failure{Message: "first"},
+								RerunFailures: []Rerun{{Message: "second"}},
+								RerunErrors:   []Rerun{{Message: "third"}},
+							},
+							{
+								Name:   "retried",
+								Reruns: []Rerun{{Message: "once", Value: "body"}},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Refs holds the repository and commits a prow job checks out.
+type Refs struct {
+	Org      string `json:"org"`
+	Repo     string `json:"repo"`
+	RepoLink string `json:"repo_link,omitempty"`
+	BaseRef  string `json:"base_ref,omitempty"`
+	BaseSHA  string `json:"base_sha,omitempty"`
+	BaseLink string `json:"base_link,omitempty"`
+	Pulls    []Pull `json:"pulls,omitempty"`
+}
+
+// Pull holds a pull request merged into the base of the Refs.
+type Pull struct {
+	Number     int    `json:"number"`
+	Author     string `json:"author"`
+	SHA        string `json:"sha"`
+	Title      string `json:"title,omitempty"`
+	Link       string `json:"link,omitempty"`
+	CommitLink string `json:"commit_link,omitempty"`
+	AuthorLink string `json:"author_link,omitempty"`
+}
+
+// ProwJob holds the prowjob.json values of the build.
+type ProwJob struct {
+	Spec   ProwJobSpec   `json:"spec"`
+	Status ProwJobStatus `json:"status,omitempty"`
+}
+
+// ProwJobSpec holds the configuration of the prow job.
+type ProwJobSpec struct {
+	// Type is presubmit, postsubmit, periodic or batch.
+	Type      string `json:"type,omitempty"`
+	Job       string `json:"job,omitempty"`
+	Refs      *Refs  `json:"refs,omitempty"`
+	ExtraRefs []Refs `json:"extra_refs,omitempty"`
+}
+
+// ProwJobStatus holds the state of the prow job.
+type ProwJobStatus struct {
+	State   string `json:"state,omitempty"`
+	URL     string `json:"url,omitempty"`
+	BuildID string `json:"build_id,omitempty"`
+}
+
+// CloneRecord holds a clone-records.json entry, describing a checked out Refs.
+type CloneRecord struct {
+	Refs     Refs   `json:"refs"`
+	Failed   bool   `json:"failed,omitempty"`
+	FinalSHA string `json:"final_sha,omitempty"`
+}
+
+// Keys of the refs values returned by RefsValues.
+const (
+	// JobTypeKey is the type of the job: presubmit, postsubmit, periodic or batch.
+	JobTypeKey = "Job type"
+	// OrgKey is the org of the primary repo.
+	OrgKey = "Org"
+	// RepoKey is the primary repo.
+	RepoKey = "Repo"
+	// BaseRefKey is the branch of the primary repo.
+	BaseRefKey = "Base ref"
+	// BaseSHAKey is the commit of the branch.
+	BaseSHAKey = "Base SHA"
+	// PullKey is the comma-separated list of tested pull request numbers.
+	PullKey = "Pull"
+	// PullAuthorKey is the comma-separated list of tested pull request authors.
+	PullAuthorKey = "Pull author"
+	// PullSHAKey is the comma-separated list of tested pull request commits.
+	PullSHAKey = "Pull SHA"
+)
+
+// PrimaryRefs returns the refs the prow job tests, or else the first extra refs.
+func (pj ProwJob) PrimaryRefs() *Refs {
+	if pj.Spec.Refs != nil {
+		return pj.Spec.Refs
+	}
+	if len(pj.Spec.ExtraRefs) > 0 {
+		return &pj.Spec.ExtraRefs[0]
+	}
+	return nil
+}
+
+// IsRefsKey returns whether RefsValues may return the key.
+func IsRefsKey(key string) bool {
+	switch key {
+	case JobTypeKey, OrgKey, RepoKey, BaseRefKey, BaseSHAKey, PullKey, PullAuthorKey, PullSHAKey:
+		return true
+	}
+	return false
+}
+
+// RefsValues returns the job type and refs as a map of key values.
+//
+// Omits empty values.
+func RefsValues(jobType string, refs *Refs) map[string]string {
+	out := map[string]string{}
+	set := func(key, val string) {
+		if val != "" {
+			out[key] = val
+		}
+	}
+	set(JobTypeKey, jobType)
+	if refs == nil {
+		return out
+	}
+	set(OrgKey, refs.Org)
+	set(RepoKey, refs.Repo)
+	set(BaseRefKey, refs.BaseRef)
+	set(BaseSHAKey, refs.BaseSHA)
+	if len(refs.Pulls) == 0 {
+		return out
+	}
+	numbers := make([]string, 0, len(refs.Pulls))
+	authors := make([]string, 0, len(refs.Pulls))
+	shas := make([]string, 0, len(refs.Pulls))
+	for _, p := range refs.Pulls {
+		numbers = append(numbers, strconv.Itoa(p.Number))
+		authors = append(authors, p.Author)
+		shas = append(shas, p.SHA)
+	}
+	set(PullKey, strings.Join(numbers, ","))
+	set(PullAuthorKey, strings.Join(authors, ","))
+	set(PullSHAKey, strings.Join(shas, ","))
+	return out
+}
@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metadata
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestProwJob(t *testing.T) {
+	const prowJob = `{
+		"kind": "ProwJob",
+		"apiVersion": "prow.k8s.io/v1",
+		"metadata": {"name": "abc"},
+		"spec": {
+			"type": "presubmit",
+			"agent": "kubernetes",
+			"job": "pull-test-infra-bazel",
+			"refs": {
+				"org": "kubernetes",
+				"repo": "test-infra",
+				"base_ref": "master",
+				"base_sha": "deadbeef",
+				"pulls": [
+					{
+						"number": 123,
+						"author": "alice",
+						"sha": "abcd1234",
+						"title": "Fix things",
+						"link": "https://github.com/kubernetes/test-infra/pull/123"
+					}
+				]
+			},
+			"extra_refs": [
+				{"org": "kubernetes", "repo": "kubernetes", "base_ref": "main"}
+			]
+		},
+		"status": {
+			"startTime": "2023-01-02T03:04:05Z",
+			"state": "success",
+			"url": "https://prow.example.com/view/gs/bucket/logs/1",
+			"build_id": "1"
+		}
+	}`
+	want := ProwJob{
+		Spec: ProwJobSpec{
+			Type: "presubmit",
+			Job:  "pull-test-infra-bazel",
+			Refs: &Refs{
+				Org:     "kubernetes",
+				Repo:    "test-infra",
+				BaseRef: "master",
+				BaseSHA: "deadbeef",
+				Pulls: []Pull{
+					{
+						Number: 123,
+						Author: "alice",
+						SHA:    "abcd1234",
+						Title:  "Fix things",
+						Link:   "https://github.com/kubernetes/test-infra/pull/123",
+					},
+				},
+			},
+			ExtraRefs: []Refs{
+				{Org: "kubernetes", Repo: "kubernetes", BaseRef: "main"},
+			},
+		},
+		Status: ProwJobStatus{
+			State:   "success",
+			URL:     "https://prow.example.com/view/gs/bucket/logs/1",
+			BuildID: "1",
+		},
+	}
+	var got ProwJob
+	if err := json.Unmarshal([]byte(prowJob), &got); err != nil {
+		t.Fatalf("Unmarshal() got unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unmarshal() got %#v, want %#v", got, want)
+	}
+}
+
+func TestPrimaryRefs(t *testing.T) {
+	refs := Refs{Org: "primary"}
+	extra := Refs{Org: "extra"}
+	cases := []struct {
+		name string
+		job  ProwJob
+		want *Refs
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "refs",
+			job: ProwJob{
+				Spec: ProwJobSpec{
+					Refs:      &refs,
+					ExtraRefs: []Refs{extra},
+				},
+			},
+			want: &refs,
+		},
+		{
+			name: "extra refs",
+			job: ProwJob{
+				Spec: ProwJobSpec{
+					ExtraRefs: []Refs{extra},
+				},
+			},
+			want: &extra,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.job.PrimaryRefs(); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("PrimaryRefs() got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRefsValues(t *testing.T) {
+	cases := []struct {
+		name    string
+		jobType string
+		refs    *Refs
+		want    map[string]string
+	}{
+		{
+			name: "basically works",
+			want: map[string]string{},
+		},
+		{
+			name:    "periodic",
+			jobType: "periodic",
+			refs: &Refs{
+				Org:     "kubernetes",
+				Repo:    "kubernetes",
+				BaseRef: "main",
+			},
+			want: map[string]string{
+				JobTypeKey: "periodic",
+				OrgKey:     "kubernetes",
+				RepoKey:    "kubernetes",
+				BaseRefKey: "main",
+			},
+		},
+		{
+			name:    "batch",
+			jobType: "batch",
+			refs: &Refs{
+				Org:     "kubernetes",
+				Repo:    "test-infra",
+				BaseRef: "master",
+				BaseSHA: "deadbeef",
+				Pulls: []Pull{
+					{Number: 1, Author: "alice", SHA: "aaa"},
+					{Number: 2, Author: "bob", SHA: "bbb"},
+				},
+			},
+			want: map[string]string{
+				JobTypeKey:    "batch",
+				OrgKey:        "kubernetes",
+				RepoKey:       "test-infra",
+				BaseRefKey:    "master",
+				BaseSHAKey:    "deadbeef",
+				PullKey:       "1,2",
+				PullAuthorKey: "alice,bob",
+				PullSHAKey:    "aaa,bbb",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := RefsValues(tc.jobType, tc.refs); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("RefsValues() got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
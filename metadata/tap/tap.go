@@ -0,0 +1,171 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tap parses Test Anything Protocol (https://testanything.org) output
+// into junit suites, so it can be displayed like any other junit result.
+package tap
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+var (
+	// ok 1 - description # SKIP reason
+	testPointRe = regexp.MustCompile(`^(not ok|ok)\b\s*(\d+)?\s*(?:-\s*)?([^#]*?)\s*(?:#\s*(?i:(skip|todo))\S*\s*(.*))?$`)
+	// # Subtest: name
+	subtestRe = regexp.MustCompile(`^#\s*Subtest:\s*(.*)$`)
+	// message: 'oops'
+	messageRe = regexp.MustCompile(`^message:\s*['"]?(.*?)['"]?$`)
+)
+
+// suite accumulates the test points at one level of indentation.
+type suite struct {
+	junit.Suite
+	indent int
+}
+
+// Parse returns the Suites representation of these TAP bytes.
+func Parse(buf []byte) (*junit.Suites, error) {
+	return ParseStream(bytes.NewReader(buf))
+}
+
+// ParseStream reads TAP output into a Suites object.
+//
+// Each test point becomes a result: "not ok" fails with any YAML diagnostics
+// as the failure value, and SKIP or TODO directives skip it. TAP 14 subtests
+// become nested suites named after the subtest.
+//
+// A "Bail out!" becomes a failing result of the same name.
+func ParseStream(reader io.Reader) (*junit.Suites, error) {
+	stack := []*suite{{}}
+	var last *junit.Result // most recent test point, which owns any diagnostics
+	var yaml *strings.Builder
+	var pendingName string
+
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, 1e6)
+	for scanner.Scan() {
+		raw := strings.TrimRight(scanner.Text(), "\r")
+		line := strings.TrimLeft(raw, " \t")
+		indent := len(raw) - len(line)
+
+		if yaml != nil {
+			if line == "..." {
+				if last != nil && last.Failure != nil {
+					last.Failure.Value = strings.TrimRight(yaml.String(), "\n")
+				}
+				yaml = nil
+				continue
+			}
+			yaml.WriteString(line + "\n")
+			if mat := messageRe.FindStringSubmatch(line); mat != nil && last != nil && last.Failure != nil && last.Failure.Message == "" {
+				last.Failure.Message = mat[1]
+			}
+			continue
+		}
+
+		if line == "" {
+			continue
+		}
+
+		// Leaving a subtest: the test point that closes it belongs to the parent.
+		for len(stack) > 1 && indent < stack[len(stack)-1].indent {
+			child := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			parent := stack[len(stack)-1]
+			parent.Suites = append(parent.Suites, child.Suite)
+		}
+		current := stack[len(stack)-1]
+
+		switch {
+		case line == "---":
+			yaml = &strings.Builder{}
+		case strings.HasPrefix(line, "Bail out!"):
+			current.Results = append(current.Results, junit.Result{
+				Name:    "Bail out!",
+				Failure: &junit.Failure{Message: strings.TrimSpace(strings.TrimPrefix(line, "Bail out!"))},
+			})
+			current.Failures++
+			current.Tests++
+			last = nil
+		case subtestRe.MatchString(line):
+			pendingName = subtestRe.FindStringSubmatch(line)[1]
+			if indent > current.indent {
+				stack = append(stack, &suite{Suite: junit.Suite{Name: pendingName}, indent: indent})
+			}
+		case testPointRe.MatchString(line):
+			if indent > current.indent { // subtest without a # Subtest: comment
+				current = &suite{Suite: junit.Suite{Name: pendingName}, indent: indent}
+				stack = append(stack, current)
+			}
+			current.Results = append(current.Results, testPoint(testPointRe.FindStringSubmatch(line)))
+			last = &current.Results[len(current.Results)-1]
+			current.Tests++
+			switch {
+			case last.Failure != nil:
+				current.Failures++
+			case last.Skipped != nil:
+				current.Skipped++
+			}
+			pendingName = ""
+		case strings.HasPrefix(line, "#"):
+			if last != nil && last.Failure != nil && indent >= current.indent {
+				last.Failure.Value = strings.TrimLeft(last.Failure.Value+"\n"+strings.TrimSpace(strings.TrimPrefix(line, "#")), "\n")
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	for len(stack) > 1 {
+		child := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		parent := stack[len(stack)-1]
+		parent.Suites = append(parent.Suites, child.Suite)
+	}
+	var suites junit.Suites
+	if root := stack[0].Suite; len(root.Results) > 0 || len(root.Suites) > 0 {
+		suites.Suites = append(suites.Suites, root)
+	}
+	return &suites, nil
+}
+
+// testPoint converts the submatches of testPointRe into a result.
+func testPoint(mat []string) junit.Result {
+	status, number, desc, directive, reason := mat[1], mat[2], mat[3], strings.ToLower(mat[4]), mat[5]
+	name := desc
+	if name == "" {
+		name = number
+	}
+	r := junit.Result{Name: name}
+	switch {
+	case directive == "skip":
+		r.Skipped = &junit.Skipped{Message: reason}
+	case directive == "todo" && status == "not ok":
+		// Expected failure of unfinished work.
+		r.Skipped = &junit.Skipped{Message: strings.TrimSpace("TODO " + reason)}
+	case status == "not ok":
+		r.Failure = &junit.Failure{}
+	}
+	return r
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name     string
+		buf      string
+		expected *junit.Suites
+	}{
+		{
+			name:     "parse empty file as empty suites",
+			expected: &junit.Suites{},
+		},
+		{
+			name: "test points",
+			buf: `TAP version 13
+1..6
+ok 1 - Input file opened
+not ok 2 - First line of the input valid
+  ---
+  message: 'First line invalid'
+  severity: fail
+  ...
+ok 3 - Read the rest of the file # SKIP no file
+not ok 4 - Summarized correctly # TODO Not written yet
+not ok 5
+# got: 1
+# expected: 2
+ok 6 # skip
+`,
+			expected: &junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Tests:    6,
+						Failures: 2,
+						Skipped:  3,
+						Results: []junit.Result{
+							{Name: "Input file opened"},
+							{
+								Name: "First line of the input valid",
+								Failure: &junit.Failure{
+									Message: "First line invalid",
+									Value:   "message: 'First line invalid'\nseverity: fail",
+								},
+							},
+							{
+								Name:    "Read the rest of the file",
+								Skipped: &junit.Skipped{Message: "no file"},
+							},
+							{
+								Name:    "Summarized correctly",
+								Skipped: &junit.Skipped{Message: "TODO Not written yet"},
+							},
+							{
+								Name:    "5",
+								Failure: &junit.Failure{Value: "got: 1\nexpected: 2"},
+							},
+							{
+								Name:    "6",
+								Skipped: &junit.Skipped{},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "subtests",
+			buf: `TAP version 14
+# Subtest: parent
+    1..2
+    ok 1 - child
+    not ok 2 - broken child
+not ok 1 - parent
+ok 2 - sibling
+    ok 1 - unnamed child
+ok 3 - unnamed
+Bail out! database down
+`,
+			expected: &junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Tests:    4,
+						Failures: 2,
+						Suites: []junit.Suite{
+							{
+								Name:     "parent",
+								Tests:    2,
+								Failures: 1,
+								Results: []junit.Result{
+									{Name: "child"},
+									{Name: "broken child", Failure: &junit.Failure{}},
+								},
+							},
+							{
+								Tests: 1,
+								Results: []junit.Result{
+									{Name: "unnamed child"},
+								},
+							},
+						},
+						Results: []junit.Result{
+							{Name: "parent", Failure: &junit.Failure{}},
+							{Name: "sibling"},
+							{Name: "unnamed"},
+							{Name: "Bail out!", Failure: &junit.Failure{Message: "database down"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Parse([]byte(tc.buf))
+			if err != nil {
+				t.Fatalf("Parse() got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("Parse() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package trx parses Visual Studio test results (.trx files, as written by
+// `dotnet test --logger trx`) into junit suites, so they can be displayed
+// like any other junit result.
+package trx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+// TestRun holds a <TestRun/> document.
+type TestRun struct {
+	XMLName         xml.Name         `xml:"TestRun"`
+	Name            string           `xml:"name,attr"`
+	Results         []UnitTestResult `xml:"Results>UnitTestResult"`
+	TestDefinitions []UnitTest       `xml:"TestDefinitions>UnitTest"`
+}
+
+// UnitTestResult holds the <UnitTestResult/> of a test execution.
+type UnitTestResult struct {
+	TestID       string           `xml:"testId,attr"`
+	TestName     string           `xml:"testName,attr"`
+	Duration     string           `xml:"duration,attr"` // hh:mm:ss.fffffff
+	Outcome      string           `xml:"outcome,attr"`
+	StdOut       string           `xml:"Output>StdOut"`
+	StdErr       string           `xml:"Output>StdErr"`
+	ErrorInfo    *ErrorInfo       `xml:"Output>ErrorInfo"`
+	InnerResults []UnitTestResult `xml:"InnerResults>UnitTestResult"`
+}
+
+// ErrorInfo holds the <ErrorInfo/> of a failed test.
+type ErrorInfo struct {
+	Message    string `xml:"Message"`
+	StackTrace string `xml:"StackTrace"`
+}
+
+// UnitTest holds the <UnitTest/> definition of a test.
+type UnitTest struct {
+	ID         string     `xml:"id,attr"`
+	Name       string     `xml:"name,attr"`
+	TestMethod TestMethod `xml:"TestMethod"`
+}
+
+// TestMethod holds the <TestMethod/> of a test definition.
+type TestMethod struct {
+	ClassName string `xml:"className,attr"`
+	Name      string `xml:"name,attr"`
+}
+
+// Parse returns the Suites representation of these TRX bytes.
+func Parse(buf []byte) (*junit.Suites, error) {
+	if len(buf) == 0 {
+		return &junit.Suites{}, nil
+	}
+	return ParseStream(bytes.NewReader(buf))
+}
+
+// ParseStream reads a TRX document into a Suites object.
+//
+// Each test class becomes a suite holding a result for each of its tests,
+// including the inner results of data-driven tests.
+func ParseStream(reader io.Reader) (*junit.Suites, error) {
+	var run TestRun
+	if err := xml.NewDecoder(reader).Decode(&run); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	classes := map[string]string{}
+	for _, def := range run.TestDefinitions {
+		classes[def.ID] = def.TestMethod.ClassName
+	}
+
+	var suites junit.Suites
+	index := map[string]int{}
+	var add func(class string, r UnitTestResult) error
+	add = func(class string, r UnitTestResult) error {
+		res, err := convert(r)
+		if err != nil {
+			return fmt.Errorf("%s: %w", r.TestName, err)
+		}
+		i, ok := index[class]
+		if !ok {
+			i = len(suites.Suites)
+			index[class] = i
+			suites.Suites = append(suites.Suites, junit.Suite{Name: class})
+		}
+		suite := &suites.Suites[i]
+		suite.Tests++
+		switch {
+		case res.Failure != nil:
+			suite.Failures++
+		case res.Errored != nil:
+			suite.Errors++
+		case res.Skipped != nil:
+			suite.Skipped++
+		}
+		suite.Results = append(suite.Results, res)
+		for _, inner := range r.InnerResults {
+			if err := add(class, inner); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for _, r := range run.Results {
+		if err := add(classes[r.TestID], r); err != nil {
+			return nil, err
+		}
+	}
+	return &suites, nil
+}
+
+// convert returns the junit result of the test.
+func convert(r UnitTestResult) (junit.Result, error) {
+	res := junit.Result{Name: r.TestName}
+	if r.Duration != "" {
+		d, err := duration(r.Duration)
+		if err != nil {
+			return res, fmt.Errorf("duration: %w", err)
+		}
+		res.Time = d
+	}
+	if out := strings.TrimSpace(r.StdOut); out != "" {
+		res.Output = &out
+	}
+	if stderr := strings.TrimSpace(r.StdErr); stderr != "" {
+		res.Error = &stderr
+	}
+	var msg, trace string
+	if r.ErrorInfo != nil {
+		msg, trace = strings.TrimSpace(r.ErrorInfo.Message), strings.TrimSpace(r.ErrorInfo.StackTrace)
+	}
+	switch r.Outcome {
+	case "Passed", "PassedButRunAborted", "Completed", "Warning", "":
+	case "NotExecuted", "Inconclusive", "NotRunnable":
+		if msg == "" {
+			msg = r.Outcome
+		}
+		res.Skipped = &junit.Skipped{Message: msg}
+	case "Error":
+		res.Errored = &junit.Errored{Message: msg, Value: trace}
+	default: // Failed, Timeout, Aborted, etc
+		if msg == "" && r.Outcome != "Failed" {
+			msg = r.Outcome
+		}
+		res.Failure = &junit.Failure{Message: msg, Value: trace}
+	}
+	return res, nil
+}
+
+// duration returns the seconds in a [d.]hh:mm:ss[.fffffff] TimeSpan.
+func duration(s string) (float64, error) {
+	var days float64
+	if dot, colon := strings.Index(s, "."), strings.Index(s, ":"); dot >= 0 && dot < colon {
+		d, err := strconv.ParseFloat(s[:dot], 64)
+		if err != nil {
+			return 0, err
+		}
+		days, s = d, s[dot+1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) != 3 {
+		return 0, fmt.Errorf("bad timespan %q", s)
+	}
+	h, err := strconv.ParseFloat(parts[0], 64)
+	if err != nil {
+		return 0, err
+	}
+	m, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil {
+		return 0, err
+	}
+	sec, err := strconv.ParseFloat(parts[2], 64)
+	if err != nil {
+		return 0, err
+	}
+	return days*86400 + h*3600 + m*60 + sec, nil
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trx
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+)
+
+func TestParse(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+	cases := []struct {
+		name     string
+		buf      string
+		expected *junit.Suites
+	}{
+		{
+			name:     "parse empty file as empty suites",
+			expected: &junit.Suites{},
+		},
+		{
+			name: "not xml fails",
+			buf:  "<hello",
+		},
+		{
+			name: "bad duration fails",
+			buf: `<TestRun><Results>
+  <UnitTestResult testName="Bad" duration="soon" outcome="Passed" />
+</Results></TestRun>`,
+		},
+		{
+			name: "basically works",
+			buf: `<?xml version="1.0" encoding="utf-8"?>
+<TestRun id="1" name="run" xmlns="http://microsoft.com/schemas/VisualStudio/TeamTest/2010">
+  <Results>
+    <UnitTestResult testId="a" testName="Passes" duration="00:00:01.5000000" outcome="Passed">
+      <Output><StdOut>hello
+</StdOut></Output>
+    </UnitTestResult>
+    <UnitTestResult testId="b" testName="Fails" duration="1.00:01:00" outcome="Failed">
+      <Output>
+        <StdErr>oops</StdErr>
+        <ErrorInfo>
+          <Message>Assert.AreEqual failed. Expected:&lt;1&gt;. Actual:&lt;2&gt;.</Message>
+          <StackTrace>at Tests.Math.Fails() in Math.cs:line 12</StackTrace>
+        </ErrorInfo>
+      </Output>
+    </UnitTestResult>
+    <UnitTestResult testId="c" testName="Ignored" outcome="NotExecuted" />
+    <UnitTestResult testId="d" testName="Slow" duration="00:10:00" outcome="Timeout" />
+    <UnitTestResult testId="e" testName="Crashes" outcome="Error">
+      <Output><ErrorInfo><Message>setup failed</Message></ErrorInfo></Output>
+    </UnitTestResult>
+    <UnitTestResult testId="f" testName="DataDriven" outcome="Failed">
+      <InnerResults>
+        <UnitTestResult testId="f" testName="DataDriven (1)" outcome="Passed" />
+        <UnitTestResult testId="f" testName="DataDriven (2)" outcome="Failed" />
+      </InnerResults>
+    </UnitTestResult>
+  </Results>
+  <TestDefinitions>
+    <UnitTest id="a" name="Passes"><TestMethod className="Tests.Math" name="Passes" /></UnitTest>
+    <UnitTest id="b" name="Fails"><TestMethod className="Tests.Math" name="Fails" /></UnitTest>
+    <UnitTest id="c" name="Ignored"><TestMethod className="Tests.Strings" name="Ignored" /></UnitTest>
+    <UnitTest id="d" name="Slow"><TestMethod className="Tests.Math" name="Slow" /></UnitTest>
+    <UnitTest id="e" name="Crashes"><TestMethod className="Tests.Strings" name="Crashes" /></UnitTest>
+    <UnitTest id="f" name="DataDriven"><TestMethod className="Tests.Data" name="DataDriven" /></UnitTest>
+  </TestDefinitions>
+</TestRun>`,
+			expected: &junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:     "Tests.Math",
+						Tests:    3,
+						Failures: 2,
+						Results: []junit.Result{
+							{
+								Name:   "Passes",
+								Time:   1.5,
+								Output: pstr("hello"),
+							},
+							{
+								Name:  "Fails",
+								Time:  86460,
+								Error: pstr("oops"),
+								Failure: &junit.Failure{
+									Message: "Assert.AreEqual failed. Expected:<1>. Actual:<2>.",
+									Value:   "at Tests.Math.Fails() in Math.cs:line 12",
+								},
+							},
+							{
+								Name:    "Slow",
+								Time:    600,
+								Failure: &junit.Failure{Message: "Timeout"},
+							},
+						},
+					},
+					{
+						Name:    "Tests.Strings",
+						Tests:   2,
+						Errors:  1,
+						Skipped: 1,
+						Results: []junit.Result{
+							{
+								Name:    "Ignored",
+								Skipped: &junit.Skipped{Message: "NotExecuted"},
+							},
+							{
+								Name:    "Crashes",
+								Errored: &junit.Errored{Message: "setup failed"},
+							},
+						},
+					},
+					{
+						Name:     "Tests.Data",
+						Tests:    3,
+						Failures: 2,
+						Results: []junit.Result{
+							{
+								Name:    "DataDriven",
+								Failure: &junit.Failure{},
+							},
+							{
+								Name: "DataDriven (1)",
+							},
+							{
+								Name:    "DataDriven (2)",
+								Failure: &junit.Failure{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := Parse([]byte(tc.buf))
+			switch {
+			case err != nil:
+				if tc.expected != nil {
+					t.Errorf("Parse() got unexpected error: %v", err)
+				}
+			case tc.expected == nil:
+				t.Errorf("Parse() got %v, wanted an error", actual)
+			default:
+				if diff := cmp.Diff(tc.expected, actual); diff != "" {
+					t.Errorf("Parse() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,2297 @@
+/*
+Copyright The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The subset of Bazel's Build Event Protocol that TestGrid reads.
+//
+// Field numbers and names match
+// https://github.com/bazelbuild/bazel/blob/master/src/main/java/com/google/devtools/build/lib/buildeventstream/proto/build_event_stream.proto
+// so files written by --build_event_binary_file or --build_event_json_file
+// parse with these messages. Other events and fields are ignored.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.0
+// 	protoc        v3.21.1
+// source: build_event_stream.proto
+
+package bep
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TestStatus int32
+
+const (
+	TestStatus_NO_STATUS                  TestStatus = 0
+	TestStatus_PASSED                     TestStatus = 1
+	TestStatus_FLAKY                      TestStatus = 2
+	TestStatus_TIMEOUT                    TestStatus = 3
+	TestStatus_FAILED                     TestStatus = 4
+	TestStatus_INCOMPLETE                 TestStatus = 5
+	TestStatus_REMOTE_FAILURE             TestStatus = 6
+	TestStatus_FAILED_TO_BUILD            TestStatus = 7
+	TestStatus_TOOL_HALTED_BEFORE_TESTING TestStatus = 8
+)
+
+// Enum value maps for TestStatus.
+var (
+	TestStatus_name = map[int32]string{
+		0: "NO_STATUS",
+		1: "PASSED",
+		2: "FLAKY",
+		3: "TIMEOUT",
+		4: "FAILED",
+		5: "INCOMPLETE",
+		6: "REMOTE_FAILURE",
+		7: "FAILED_TO_BUILD",
+		8: "TOOL_HALTED_BEFORE_TESTING",
+	}
+	TestStatus_value = map[string]int32{
+		"NO_STATUS":                  0,
+		"PASSED":                     1,
+		"FLAKY":                      2,
+		"TIMEOUT":                    3,
+		"FAILED":                     4,
+		"INCOMPLETE":                 5,
+		"REMOTE_FAILURE":             6,
+		"FAILED_TO_BUILD":            7,
+		"TOOL_HALTED_BEFORE_TESTING": 8,
+	}
+)
+
+func (x TestStatus) Enum() *TestStatus {
+	p := new(TestStatus)
+	*p = x
+	return p
+}
+
+func (x TestStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TestStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_build_event_stream_proto_enumTypes[0].Descriptor()
+}
+
+func (TestStatus) Type() protoreflect.EnumType {
+	return &file_build_event_stream_proto_enumTypes[0]
+}
+
+func (x TestStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TestStatus.Descriptor instead.
+func (TestStatus) EnumDescriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0}
+}
+
+// Identifier for a build event.
+type BuildEventId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Id:
+	//	*BuildEventId_Started
+	//	*BuildEventId_TargetCompleted
+	//	*BuildEventId_TestSummary
+	//	*BuildEventId_TestResult
+	//	*BuildEventId_BuildFinished
+	//	*BuildEventId_WorkspaceStatus
+	//	*BuildEventId_TargetConfigured
+	//	*BuildEventId_BuildMetadata
+	Id isBuildEventId_Id `protobuf_oneof:"id"`
+}
+
+func (x *BuildEventId) Reset() {
+	*x = BuildEventId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId) ProtoMessage() {}
+
+func (x *BuildEventId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId.ProtoReflect.Descriptor instead.
+func (*BuildEventId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0}
+}
+
+func (m *BuildEventId) GetId() isBuildEventId_Id {
+	if m != nil {
+		return m.Id
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetStarted() *BuildEventId_BuildStartedId {
+	if x, ok := x.GetId().(*BuildEventId_Started); ok {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetTargetCompleted() *BuildEventId_TargetCompletedId {
+	if x, ok := x.GetId().(*BuildEventId_TargetCompleted); ok {
+		return x.TargetCompleted
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetTestSummary() *BuildEventId_TestSummaryId {
+	if x, ok := x.GetId().(*BuildEventId_TestSummary); ok {
+		return x.TestSummary
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetTestResult() *BuildEventId_TestResultId {
+	if x, ok := x.GetId().(*BuildEventId_TestResult); ok {
+		return x.TestResult
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetBuildFinished() *BuildEventId_BuildFinishedId {
+	if x, ok := x.GetId().(*BuildEventId_BuildFinished); ok {
+		return x.BuildFinished
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetWorkspaceStatus() *BuildEventId_WorkspaceStatusId {
+	if x, ok := x.GetId().(*BuildEventId_WorkspaceStatus); ok {
+		return x.WorkspaceStatus
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetTargetConfigured() *BuildEventId_TargetConfiguredId {
+	if x, ok := x.GetId().(*BuildEventId_TargetConfigured); ok {
+		return x.TargetConfigured
+	}
+	return nil
+}
+
+func (x *BuildEventId) GetBuildMetadata() *BuildEventId_BuildMetadataId {
+	if x, ok := x.GetId().(*BuildEventId_BuildMetadata); ok {
+		return x.BuildMetadata
+	}
+	return nil
+}
+
+type isBuildEventId_Id interface {
+	isBuildEventId_Id()
+}
+
+type BuildEventId_Started struct {
+	Started *BuildEventId_BuildStartedId `protobuf:"bytes,3,opt,name=started,proto3,oneof"`
+}
+
+type BuildEventId_TargetCompleted struct {
+	TargetCompleted *BuildEventId_TargetCompletedId `protobuf:"bytes,5,opt,name=target_completed,json=targetCompleted,proto3,oneof"`
+}
+
+type BuildEventId_TestSummary struct {
+	TestSummary *BuildEventId_TestSummaryId `protobuf:"bytes,7,opt,name=test_summary,json=testSummary,proto3,oneof"`
+}
+
+type BuildEventId_TestResult struct {
+	TestResult *BuildEventId_TestResultId `protobuf:"bytes,8,opt,name=test_result,json=testResult,proto3,oneof"`
+}
+
+type BuildEventId_BuildFinished struct {
+	BuildFinished *BuildEventId_BuildFinishedId `protobuf:"bytes,9,opt,name=build_finished,json=buildFinished,proto3,oneof"`
+}
+
+type BuildEventId_WorkspaceStatus struct {
+	WorkspaceStatus *BuildEventId_WorkspaceStatusId `protobuf:"bytes,14,opt,name=workspace_status,json=workspaceStatus,proto3,oneof"`
+}
+
+type BuildEventId_TargetConfigured struct {
+	TargetConfigured *BuildEventId_TargetConfiguredId `protobuf:"bytes,16,opt,name=target_configured,json=targetConfigured,proto3,oneof"`
+}
+
+type BuildEventId_BuildMetadata struct {
+	BuildMetadata *BuildEventId_BuildMetadataId `protobuf:"bytes,24,opt,name=build_metadata,json=buildMetadata,proto3,oneof"`
+}
+
+func (*BuildEventId_Started) isBuildEventId_Id() {}
+
+func (*BuildEventId_TargetCompleted) isBuildEventId_Id() {}
+
+func (*BuildEventId_TestSummary) isBuildEventId_Id() {}
+
+func (*BuildEventId_TestResult) isBuildEventId_Id() {}
+
+func (*BuildEventId_BuildFinished) isBuildEventId_Id() {}
+
+func (*BuildEventId_WorkspaceStatus) isBuildEventId_Id() {}
+
+func (*BuildEventId_TargetConfigured) isBuildEventId_Id() {}
+
+func (*BuildEventId_BuildMetadata) isBuildEventId_Id() {}
+
+// Payload of the event indicating the beginning of a new build.
+type BuildStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Uuid             string                 `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	StartTimeMillis  int64                  `protobuf:"varint,2,opt,name=start_time_millis,json=startTimeMillis,proto3" json:"start_time_millis,omitempty"`
+	BuildToolVersion string                 `protobuf:"bytes,3,opt,name=build_tool_version,json=buildToolVersion,proto3" json:"build_tool_version,omitempty"`
+	Command          string                 `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	StartTime        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+}
+
+func (x *BuildStarted) Reset() {
+	*x = BuildStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildStarted) ProtoMessage() {}
+
+func (x *BuildStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildStarted.ProtoReflect.Descriptor instead.
+func (*BuildStarted) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BuildStarted) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *BuildStarted) GetStartTimeMillis() int64 {
+	if x != nil {
+		return x.StartTimeMillis
+	}
+	return 0
+}
+
+func (x *BuildStarted) GetBuildToolVersion() string {
+	if x != nil {
+		return x.BuildToolVersion
+	}
+	return ""
+}
+
+func (x *BuildStarted) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *BuildStarted) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+// Values from the workspace status command.
+type WorkspaceStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item []*WorkspaceStatus_Item `protobuf:"bytes,1,rep,name=item,proto3" json:"item,omitempty"`
+}
+
+func (x *WorkspaceStatus) Reset() {
+	*x = WorkspaceStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceStatus) ProtoMessage() {}
+
+func (x *WorkspaceStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceStatus.ProtoReflect.Descriptor instead.
+func (*WorkspaceStatus) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WorkspaceStatus) GetItem() []*WorkspaceStatus_Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+// Values from --build_metadata.
+type BuildMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata map[string]string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BuildMetadata) Reset() {
+	*x = BuildMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildMetadata) ProtoMessage() {}
+
+func (x *BuildMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildMetadata.ProtoReflect.Descriptor instead.
+func (*BuildMetadata) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BuildMetadata) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// Payload of the event indicating that the configurations for a target have
+// been identified.
+type TargetConfigured struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetKind string   `protobuf:"bytes,1,opt,name=target_kind,json=targetKind,proto3" json:"target_kind,omitempty"`
+	Tag        []string `protobuf:"bytes,3,rep,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *TargetConfigured) Reset() {
+	*x = TargetConfigured{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetConfigured) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetConfigured) ProtoMessage() {}
+
+func (x *TargetConfigured) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetConfigured.ProtoReflect.Descriptor instead.
+func (*TargetConfigured) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TargetConfigured) GetTargetKind() string {
+	if x != nil {
+		return x.TargetKind
+	}
+	return ""
+}
+
+func (x *TargetConfigured) GetTag() []string {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// A file, such as a test.xml output.
+type File struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Types that are assignable to File:
+	//	*File_Uri
+	//	*File_Contents
+	File       isFile_File `protobuf_oneof:"file"`
+	PathPrefix []string    `protobuf:"bytes,4,rep,name=path_prefix,json=pathPrefix,proto3" json:"path_prefix,omitempty"`
+}
+
+func (x *File) Reset() {
+	*x = File{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *File) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*File) ProtoMessage() {}
+
+func (x *File) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use File.ProtoReflect.Descriptor instead.
+func (*File) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *File) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (m *File) GetFile() isFile_File {
+	if m != nil {
+		return m.File
+	}
+	return nil
+}
+
+func (x *File) GetUri() string {
+	if x, ok := x.GetFile().(*File_Uri); ok {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *File) GetContents() []byte {
+	if x, ok := x.GetFile().(*File_Contents); ok {
+		return x.Contents
+	}
+	return nil
+}
+
+func (x *File) GetPathPrefix() []string {
+	if x != nil {
+		return x.PathPrefix
+	}
+	return nil
+}
+
+type isFile_File interface {
+	isFile_File()
+}
+
+type File_Uri struct {
+	Uri string `protobuf:"bytes,2,opt,name=uri,proto3,oneof"`
+}
+
+type File_Contents struct {
+	Contents []byte `protobuf:"bytes,3,opt,name=contents,proto3,oneof"`
+}
+
+func (*File_Uri) isFile_File() {}
+
+func (*File_Contents) isFile_File() {}
+
+// Payload of the event indicating the completion of a target.
+type TargetComplete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool     `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Tag     []string `protobuf:"bytes,3,rep,name=tag,proto3" json:"tag,omitempty"`
+}
+
+func (x *TargetComplete) Reset() {
+	*x = TargetComplete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetComplete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetComplete) ProtoMessage() {}
+
+func (x *TargetComplete) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetComplete.ProtoReflect.Descriptor instead.
+func (*TargetComplete) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TargetComplete) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *TargetComplete) GetTag() []string {
+	if x != nil {
+		return x.Tag
+	}
+	return nil
+}
+
+// Payload of the event reporting an attempt to run a test.
+type TestResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TestActionOutput            []*File                `protobuf:"bytes,2,rep,name=test_action_output,json=testActionOutput,proto3" json:"test_action_output,omitempty"`
+	TestAttemptDurationMillis   int64                  `protobuf:"varint,3,opt,name=test_attempt_duration_millis,json=testAttemptDurationMillis,proto3" json:"test_attempt_duration_millis,omitempty"`
+	CachedLocally               bool                   `protobuf:"varint,4,opt,name=cached_locally,json=cachedLocally,proto3" json:"cached_locally,omitempty"`
+	Status                      TestStatus             `protobuf:"varint,5,opt,name=status,proto3,enum=testgrid.bep.TestStatus" json:"status,omitempty"`
+	TestAttemptStartMillisEpoch int64                  `protobuf:"varint,6,opt,name=test_attempt_start_millis_epoch,json=testAttemptStartMillisEpoch,proto3" json:"test_attempt_start_millis_epoch,omitempty"`
+	StatusDetails               string                 `protobuf:"bytes,9,opt,name=status_details,json=statusDetails,proto3" json:"status_details,omitempty"`
+	TestAttemptStart            *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=test_attempt_start,json=testAttemptStart,proto3" json:"test_attempt_start,omitempty"`
+	TestAttemptDuration         *durationpb.Duration   `protobuf:"bytes,12,opt,name=test_attempt_duration,json=testAttemptDuration,proto3" json:"test_attempt_duration,omitempty"`
+}
+
+func (x *TestResult) Reset() {
+	*x = TestResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestResult) ProtoMessage() {}
+
+func (x *TestResult) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestResult.ProtoReflect.Descriptor instead.
+func (*TestResult) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TestResult) GetTestActionOutput() []*File {
+	if x != nil {
+		return x.TestActionOutput
+	}
+	return nil
+}
+
+func (x *TestResult) GetTestAttemptDurationMillis() int64 {
+	if x != nil {
+		return x.TestAttemptDurationMillis
+	}
+	return 0
+}
+
+func (x *TestResult) GetCachedLocally() bool {
+	if x != nil {
+		return x.CachedLocally
+	}
+	return false
+}
+
+func (x *TestResult) GetStatus() TestStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TestStatus_NO_STATUS
+}
+
+func (x *TestResult) GetTestAttemptStartMillisEpoch() int64 {
+	if x != nil {
+		return x.TestAttemptStartMillisEpoch
+	}
+	return 0
+}
+
+func (x *TestResult) GetStatusDetails() string {
+	if x != nil {
+		return x.StatusDetails
+	}
+	return ""
+}
+
+func (x *TestResult) GetTestAttemptStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TestAttemptStart
+	}
+	return nil
+}
+
+func (x *TestResult) GetTestAttemptDuration() *durationpb.Duration {
+	if x != nil {
+		return x.TestAttemptDuration
+	}
+	return nil
+}
+
+// Payload of the event summarizing a test.
+type TestSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TotalRunCount int32      `protobuf:"varint,1,opt,name=total_run_count,json=totalRunCount,proto3" json:"total_run_count,omitempty"`
+	OverallStatus TestStatus `protobuf:"varint,5,opt,name=overall_status,json=overallStatus,proto3,enum=testgrid.bep.TestStatus" json:"overall_status,omitempty"`
+}
+
+func (x *TestSummary) Reset() {
+	*x = TestSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TestSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TestSummary) ProtoMessage() {}
+
+func (x *TestSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TestSummary.ProtoReflect.Descriptor instead.
+func (*TestSummary) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *TestSummary) GetTotalRunCount() int32 {
+	if x != nil {
+		return x.TotalRunCount
+	}
+	return 0
+}
+
+func (x *TestSummary) GetOverallStatus() TestStatus {
+	if x != nil {
+		return x.OverallStatus
+	}
+	return TestStatus_NO_STATUS
+}
+
+// Payload of the event indicating the end of a build.
+type BuildFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OverallSuccess   bool                    `protobuf:"varint,1,opt,name=overall_success,json=overallSuccess,proto3" json:"overall_success,omitempty"`
+	FinishTimeMillis int64                   `protobuf:"varint,2,opt,name=finish_time_millis,json=finishTimeMillis,proto3" json:"finish_time_millis,omitempty"`
+	ExitCode         *BuildFinished_ExitCode `protobuf:"bytes,3,opt,name=exit_code,json=exitCode,proto3" json:"exit_code,omitempty"`
+	FinishTime       *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+}
+
+func (x *BuildFinished) Reset() {
+	*x = BuildFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFinished) ProtoMessage() {}
+
+func (x *BuildFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFinished.ProtoReflect.Descriptor instead.
+func (*BuildFinished) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BuildFinished) GetOverallSuccess() bool {
+	if x != nil {
+		return x.OverallSuccess
+	}
+	return false
+}
+
+func (x *BuildFinished) GetFinishTimeMillis() int64 {
+	if x != nil {
+		return x.FinishTimeMillis
+	}
+	return 0
+}
+
+func (x *BuildFinished) GetExitCode() *BuildFinished_ExitCode {
+	if x != nil {
+		return x.ExitCode
+	}
+	return nil
+}
+
+func (x *BuildFinished) GetFinishTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishTime
+	}
+	return nil
+}
+
+// Message describing a build event.
+type BuildEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          *BuildEventId   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Children    []*BuildEventId `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	LastMessage bool            `protobuf:"varint,20,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	// Types that are assignable to Payload:
+	//	*BuildEvent_Started
+	//	*BuildEvent_Completed
+	//	*BuildEvent_TestSummary
+	//	*BuildEvent_TestResult
+	//	*BuildEvent_Finished
+	//	*BuildEvent_WorkspaceStatus
+	//	*BuildEvent_Configured
+	//	*BuildEvent_BuildMetadata
+	Payload isBuildEvent_Payload `protobuf_oneof:"payload"`
+}
+
+func (x *BuildEvent) Reset() {
+	*x = BuildEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEvent) ProtoMessage() {}
+
+func (x *BuildEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEvent.ProtoReflect.Descriptor instead.
+func (*BuildEvent) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BuildEvent) GetId() *BuildEventId {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetChildren() []*BuildEventId {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetLastMessage() bool {
+	if x != nil {
+		return x.LastMessage
+	}
+	return false
+}
+
+func (m *BuildEvent) GetPayload() isBuildEvent_Payload {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetStarted() *BuildStarted {
+	if x, ok := x.GetPayload().(*BuildEvent_Started); ok {
+		return x.Started
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetCompleted() *TargetComplete {
+	if x, ok := x.GetPayload().(*BuildEvent_Completed); ok {
+		return x.Completed
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetTestSummary() *TestSummary {
+	if x, ok := x.GetPayload().(*BuildEvent_TestSummary); ok {
+		return x.TestSummary
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetTestResult() *TestResult {
+	if x, ok := x.GetPayload().(*BuildEvent_TestResult); ok {
+		return x.TestResult
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetFinished() *BuildFinished {
+	if x, ok := x.GetPayload().(*BuildEvent_Finished); ok {
+		return x.Finished
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetWorkspaceStatus() *WorkspaceStatus {
+	if x, ok := x.GetPayload().(*BuildEvent_WorkspaceStatus); ok {
+		return x.WorkspaceStatus
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetConfigured() *TargetConfigured {
+	if x, ok := x.GetPayload().(*BuildEvent_Configured); ok {
+		return x.Configured
+	}
+	return nil
+}
+
+func (x *BuildEvent) GetBuildMetadata() *BuildMetadata {
+	if x, ok := x.GetPayload().(*BuildEvent_BuildMetadata); ok {
+		return x.BuildMetadata
+	}
+	return nil
+}
+
+type isBuildEvent_Payload interface {
+	isBuildEvent_Payload()
+}
+
+type BuildEvent_Started struct {
+	Started *BuildStarted `protobuf:"bytes,5,opt,name=started,proto3,oneof"`
+}
+
+type BuildEvent_Completed struct {
+	Completed *TargetComplete `protobuf:"bytes,8,opt,name=completed,proto3,oneof"`
+}
+
+type BuildEvent_TestSummary struct {
+	TestSummary *TestSummary `protobuf:"bytes,9,opt,name=test_summary,json=testSummary,proto3,oneof"`
+}
+
+type BuildEvent_TestResult struct {
+	TestResult *TestResult `protobuf:"bytes,10,opt,name=test_result,json=testResult,proto3,oneof"`
+}
+
+type BuildEvent_Finished struct {
+	Finished *BuildFinished `protobuf:"bytes,14,opt,name=finished,proto3,oneof"`
+}
+
+type BuildEvent_WorkspaceStatus struct {
+	WorkspaceStatus *WorkspaceStatus `protobuf:"bytes,16,opt,name=workspace_status,json=workspaceStatus,proto3,oneof"`
+}
+
+type BuildEvent_Configured struct {
+	Configured *TargetConfigured `protobuf:"bytes,18,opt,name=configured,proto3,oneof"`
+}
+
+type BuildEvent_BuildMetadata struct {
+	BuildMetadata *BuildMetadata `protobuf:"bytes,26,opt,name=build_metadata,json=buildMetadata,proto3,oneof"`
+}
+
+func (*BuildEvent_Started) isBuildEvent_Payload() {}
+
+func (*BuildEvent_Completed) isBuildEvent_Payload() {}
+
+func (*BuildEvent_TestSummary) isBuildEvent_Payload() {}
+
+func (*BuildEvent_TestResult) isBuildEvent_Payload() {}
+
+func (*BuildEvent_Finished) isBuildEvent_Payload() {}
+
+func (*BuildEvent_WorkspaceStatus) isBuildEvent_Payload() {}
+
+func (*BuildEvent_Configured) isBuildEvent_Payload() {}
+
+func (*BuildEvent_BuildMetadata) isBuildEvent_Payload() {}
+
+type BuildEventId_BuildStartedId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BuildEventId_BuildStartedId) Reset() {
+	*x = BuildEventId_BuildStartedId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_BuildStartedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_BuildStartedId) ProtoMessage() {}
+
+func (x *BuildEventId_BuildStartedId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_BuildStartedId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_BuildStartedId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type BuildEventId_WorkspaceStatusId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BuildEventId_WorkspaceStatusId) Reset() {
+	*x = BuildEventId_WorkspaceStatusId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_WorkspaceStatusId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_WorkspaceStatusId) ProtoMessage() {}
+
+func (x *BuildEventId_WorkspaceStatusId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_WorkspaceStatusId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_WorkspaceStatusId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 1}
+}
+
+type BuildEventId_BuildMetadataId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BuildEventId_BuildMetadataId) Reset() {
+	*x = BuildEventId_BuildMetadataId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_BuildMetadataId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_BuildMetadataId) ProtoMessage() {}
+
+func (x *BuildEventId_BuildMetadataId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_BuildMetadataId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_BuildMetadataId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 2}
+}
+
+type BuildEventId_TargetConfiguredId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label  string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Aspect string `protobuf:"bytes,2,opt,name=aspect,proto3" json:"aspect,omitempty"`
+}
+
+func (x *BuildEventId_TargetConfiguredId) Reset() {
+	*x = BuildEventId_TargetConfiguredId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_TargetConfiguredId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_TargetConfiguredId) ProtoMessage() {}
+
+func (x *BuildEventId_TargetConfiguredId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_TargetConfiguredId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_TargetConfiguredId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 3}
+}
+
+func (x *BuildEventId_TargetConfiguredId) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildEventId_TargetConfiguredId) GetAspect() string {
+	if x != nil {
+		return x.Aspect
+	}
+	return ""
+}
+
+type BuildEventId_ConfigurationId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *BuildEventId_ConfigurationId) Reset() {
+	*x = BuildEventId_ConfigurationId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_ConfigurationId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_ConfigurationId) ProtoMessage() {}
+
+func (x *BuildEventId_ConfigurationId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_ConfigurationId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_ConfigurationId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *BuildEventId_ConfigurationId) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BuildEventId_TargetCompletedId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label         string                        `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Aspect        string                        `protobuf:"bytes,2,opt,name=aspect,proto3" json:"aspect,omitempty"`
+	Configuration *BuildEventId_ConfigurationId `protobuf:"bytes,3,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *BuildEventId_TargetCompletedId) Reset() {
+	*x = BuildEventId_TargetCompletedId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_TargetCompletedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_TargetCompletedId) ProtoMessage() {}
+
+func (x *BuildEventId_TargetCompletedId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_TargetCompletedId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_TargetCompletedId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 5}
+}
+
+func (x *BuildEventId_TargetCompletedId) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildEventId_TargetCompletedId) GetAspect() string {
+	if x != nil {
+		return x.Aspect
+	}
+	return ""
+}
+
+func (x *BuildEventId_TargetCompletedId) GetConfiguration() *BuildEventId_ConfigurationId {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type BuildEventId_TestResultId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label         string                        `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Run           int32                         `protobuf:"varint,2,opt,name=run,proto3" json:"run,omitempty"`
+	Shard         int32                         `protobuf:"varint,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	Attempt       int32                         `protobuf:"varint,4,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	Configuration *BuildEventId_ConfigurationId `protobuf:"bytes,5,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *BuildEventId_TestResultId) Reset() {
+	*x = BuildEventId_TestResultId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_TestResultId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_TestResultId) ProtoMessage() {}
+
+func (x *BuildEventId_TestResultId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_TestResultId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_TestResultId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 6}
+}
+
+func (x *BuildEventId_TestResultId) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildEventId_TestResultId) GetRun() int32 {
+	if x != nil {
+		return x.Run
+	}
+	return 0
+}
+
+func (x *BuildEventId_TestResultId) GetShard() int32 {
+	if x != nil {
+		return x.Shard
+	}
+	return 0
+}
+
+func (x *BuildEventId_TestResultId) GetAttempt() int32 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+func (x *BuildEventId_TestResultId) GetConfiguration() *BuildEventId_ConfigurationId {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type BuildEventId_TestSummaryId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Label         string                        `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Configuration *BuildEventId_ConfigurationId `protobuf:"bytes,2,opt,name=configuration,proto3" json:"configuration,omitempty"`
+}
+
+func (x *BuildEventId_TestSummaryId) Reset() {
+	*x = BuildEventId_TestSummaryId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_TestSummaryId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_TestSummaryId) ProtoMessage() {}
+
+func (x *BuildEventId_TestSummaryId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_TestSummaryId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_TestSummaryId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 7}
+}
+
+func (x *BuildEventId_TestSummaryId) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *BuildEventId_TestSummaryId) GetConfiguration() *BuildEventId_ConfigurationId {
+	if x != nil {
+		return x.Configuration
+	}
+	return nil
+}
+
+type BuildEventId_BuildFinishedId struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BuildEventId_BuildFinishedId) Reset() {
+	*x = BuildEventId_BuildFinishedId{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildEventId_BuildFinishedId) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildEventId_BuildFinishedId) ProtoMessage() {}
+
+func (x *BuildEventId_BuildFinishedId) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildEventId_BuildFinishedId.ProtoReflect.Descriptor instead.
+func (*BuildEventId_BuildFinishedId) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{0, 8}
+}
+
+type WorkspaceStatus_Item struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *WorkspaceStatus_Item) Reset() {
+	*x = WorkspaceStatus_Item{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WorkspaceStatus_Item) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WorkspaceStatus_Item) ProtoMessage() {}
+
+func (x *WorkspaceStatus_Item) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WorkspaceStatus_Item.ProtoReflect.Descriptor instead.
+func (*WorkspaceStatus_Item) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{2, 0}
+}
+
+func (x *WorkspaceStatus_Item) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *WorkspaceStatus_Item) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type BuildFinished_ExitCode struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Code int32  `protobuf:"varint,2,opt,name=code,proto3" json:"code,omitempty"`
+}
+
+func (x *BuildFinished_ExitCode) Reset() {
+	*x = BuildFinished_ExitCode{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_build_event_stream_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildFinished_ExitCode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildFinished_ExitCode) ProtoMessage() {}
+
+func (x *BuildFinished_ExitCode) ProtoReflect() protoreflect.Message {
+	mi := &file_build_event_stream_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildFinished_ExitCode.ProtoReflect.Descriptor instead.
+func (*BuildFinished_ExitCode) Descriptor() ([]byte, []int) {
+	return file_build_event_stream_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *BuildFinished_ExitCode) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BuildFinished_ExitCode) GetCode() int32 {
+	if x != nil {
+		return x.Code
+	}
+	return 0
+}
+
+var File_build_event_stream_proto protoreflect.FileDescriptor
+
+var file_build_event_stream_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0c, 0x74, 0x65, 0x73, 0x74,
+	0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb2, 0x0a, 0x0a, 0x0c, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x07, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x49, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x59, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x65,
+	0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x49, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x4d, 0x0a, 0x0c,
+	0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x28, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65,
+	0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x49, 0x64, 0x48, 0x00, 0x52, 0x0b,
+	0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x4a, 0x0a, 0x0b, 0x74,
+	0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x27, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x54, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x49, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x74, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x53, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x59, 0x0a, 0x10,
+	0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69,
+	0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x49, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x5c, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65,
+	0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x49,
+	0x64, 0x48, 0x00, 0x52, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x53, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x49, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x10, 0x0a, 0x0e, 0x42, 0x75,
+	0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x49, 0x64, 0x1a, 0x13, 0x0a, 0x11,
+	0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x49,
+	0x64, 0x1a, 0x11, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x49, 0x64, 0x1a, 0x42, 0x0a, 0x12, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x1a, 0x21, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x1a, 0x93, 0x01, 0x0a, 0x11,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x73, 0x70, 0x65, 0x63, 0x74, 0x12,
+	0x50, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69,
+	0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x1a, 0xb8, 0x01, 0x0a, 0x0c, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x75, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x72, 0x75, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x68,
+	0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x68, 0x61, 0x72, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x12, 0x50, 0x0a, 0x0d, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x0d, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x77, 0x0a, 0x0d,
+	0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x49, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x50, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x11, 0x0a, 0x0f, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x69,
+	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x49, 0x64, 0x42, 0x04, 0x0a, 0x02, 0x69, 0x64, 0x22, 0xd1,
+	0x01, 0x0a, 0x0c, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75,
+	0x75, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x12,
+	0x2c, 0x0a, 0x12, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x6f, 0x6f, 0x6c, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x54, 0x6f, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x79, 0x0a, 0x0f, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62,
+	0x65, 0x70, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x1a, 0x2e, 0x0a,
+	0x04, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x93, 0x01,
+	0x0a, 0x0d, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12,
+	0x45, 0x0a, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70,
+	0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x6d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x45, 0x0a, 0x10, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22, 0x75, 0x0a, 0x04, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1c, 0x0a, 0x08, 0x63, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52, 0x08,
+	0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x74, 0x68,
+	0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x42, 0x06, 0x0a, 0x04, 0x66, 0x69, 0x6c,
+	0x65, 0x22, 0x3c, 0x0a, 0x0e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a,
+	0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x22,
+	0xee, 0x03, 0x0a, 0x0a, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x40,
+	0x0a, 0x12, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x65, 0x73,
+	0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x10,
+	0x74, 0x65, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x12, 0x3f, 0x0a, 0x1c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65,
+	0x6d, 0x70, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67,
+	0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x44, 0x0a, 0x1f, 0x74, 0x65,
+	0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x1b, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x45, 0x70, 0x6f, 0x63, 0x68,
+	0x12, 0x25, 0x0a, 0x0e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x48, 0x0a, 0x12, 0x74, 0x65, 0x73, 0x74, 0x5f,
+	0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x10, 0x74, 0x65, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x12, 0x4d, 0x0a, 0x15, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13, 0x74, 0x65, 0x73,
+	0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x76, 0x0a, 0x0b, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x26, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52,
+	0x75, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x6c, 0x6c, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x54,
+	0x65, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0d, 0x6f, 0x76, 0x65, 0x72, 0x61,
+	0x6c, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x9a, 0x02, 0x0a, 0x0d, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x10, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x69, 0x6c, 0x6c, 0x69,
+	0x73, 0x12, 0x41, 0x0a, 0x09, 0x65, 0x78, 0x69, 0x74, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e,
+	0x62, 0x65, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x08, 0x65, 0x78, 0x69, 0x74,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x54, 0x69, 0x6d,
+	0x65, 0x1a, 0x32, 0x0a, 0x08, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0xa0, 0x05, 0x0a, 0x0a, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x36, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65,
+	0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x52, 0x08,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x72, 0x65, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x36, 0x0a, 0x07, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69,
+	0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x48, 0x00, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72,
+	0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x79, 0x48, 0x00, 0x52, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72,
+	0x79, 0x12, 0x3b, 0x0a, 0x0b, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69,
+	0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x48, 0x00, 0x52, 0x0a, 0x74, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x39,
+	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x08, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x4a, 0x0a, 0x10, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62,
+	0x65, 0x70, 0x2e, 0x57, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x74, 0x65, 0x73, 0x74,
+	0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0e, 0x62, 0x75, 0x69, 0x6c, 0x64,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2e, 0x62, 0x65, 0x70, 0x2e, 0x42,
+	0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x0d,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x0a,
+	0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x2a, 0xa4, 0x01, 0x0a, 0x0a, 0x54, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x50, 0x41, 0x53, 0x53, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4c, 0x41, 0x4b, 0x59, 0x10, 0x02, 0x12, 0x0b, 0x0a,
+	0x07, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x50,
+	0x4c, 0x45, 0x54, 0x45, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x55, 0x52, 0x45, 0x10, 0x06, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x41,
+	0x49, 0x4c, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x5f, 0x42, 0x55, 0x49, 0x4c, 0x44, 0x10, 0x07, 0x12,
+	0x1e, 0x0a, 0x1a, 0x54, 0x4f, 0x4f, 0x4c, 0x5f, 0x48, 0x41, 0x4c, 0x54, 0x45, 0x44, 0x5f, 0x42,
+	0x45, 0x46, 0x4f, 0x52, 0x45, 0x5f, 0x54, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x08, 0x42,
+	0x30, 0x5a, 0x2e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x47, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72,
+	0x6d, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x67, 0x72, 0x69, 0x64, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x65,
+	0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_build_event_stream_proto_rawDescOnce sync.Once
+	file_build_event_stream_proto_rawDescData = file_build_event_stream_proto_rawDesc
+)
+
+func file_build_event_stream_proto_rawDescGZIP() []byte {
+	file_build_event_stream_proto_rawDescOnce.Do(func() {
+		file_build_event_stream_proto_rawDescData = protoimpl.X.CompressGZIP(file_build_event_stream_proto_rawDescData)
+	})
+	return file_build_event_stream_proto_rawDescData
+}
+
+var file_build_event_stream_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_build_event_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
+var file_build_event_stream_proto_goTypes = []interface{}{
+	(TestStatus)(0),                         // 0: testgrid.bep.TestStatus
+	(*BuildEventId)(nil),                    // 1: testgrid.bep.BuildEventId
+	(*BuildStarted)(nil),                    // 2: testgrid.bep.BuildStarted
+	(*WorkspaceStatus)(nil),                 // 3: testgrid.bep.WorkspaceStatus
+	(*BuildMetadata)(nil),                   // 4: testgrid.bep.BuildMetadata
+	(*TargetConfigured)(nil),                // 5: testgrid.bep.TargetConfigured
+	(*File)(nil),                            // 6: testgrid.bep.File
+	(*TargetComplete)(nil),                  // 7: testgrid.bep.TargetComplete
+	(*TestResult)(nil),                      // 8: testgrid.bep.TestResult
+	(*TestSummary)(nil),                     // 9: testgrid.bep.TestSummary
+	(*BuildFinished)(nil),                   // 10: testgrid.bep.BuildFinished
+	(*BuildEvent)(nil),                      // 11: testgrid.bep.BuildEvent
+	(*BuildEventId_BuildStartedId)(nil),     // 12: testgrid.bep.BuildEventId.BuildStartedId
+	(*BuildEventId_WorkspaceStatusId)(nil),  // 13: testgrid.bep.BuildEventId.WorkspaceStatusId
+	(*BuildEventId_BuildMetadataId)(nil),    // 14: testgrid.bep.BuildEventId.BuildMetadataId
+	(*BuildEventId_TargetConfiguredId)(nil), // 15: testgrid.bep.BuildEventId.TargetConfiguredId
+	(*BuildEventId_ConfigurationId)(nil),    // 16: testgrid.bep.BuildEventId.ConfigurationId
+	(*BuildEventId_TargetCompletedId)(nil),  // 17: testgrid.bep.BuildEventId.TargetCompletedId
+	(*BuildEventId_TestResultId)(nil),       // 18: testgrid.bep.BuildEventId.TestResultId
+	(*BuildEventId_TestSummaryId)(nil),      // 19: testgrid.bep.BuildEventId.TestSummaryId
+	(*BuildEventId_BuildFinishedId)(nil),    // 20: testgrid.bep.BuildEventId.BuildFinishedId
+	(*WorkspaceStatus_Item)(nil),            // 21: testgrid.bep.WorkspaceStatus.Item
+	nil,                                     // 22: testgrid.bep.BuildMetadata.MetadataEntry
+	(*BuildFinished_ExitCode)(nil),          // 23: testgrid.bep.BuildFinished.ExitCode
+	(*timestamppb.Timestamp)(nil),           // 24: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),             // 25: google.protobuf.Duration
+}
+var file_build_event_stream_proto_depIdxs = []int32{
+	12, // 0: testgrid.bep.BuildEventId.started:type_name -> testgrid.bep.BuildEventId.BuildStartedId
+	17, // 1: testgrid.bep.BuildEventId.target_completed:type_name -> testgrid.bep.BuildEventId.TargetCompletedId
+	19, // 2: testgrid.bep.BuildEventId.test_summary:type_name -> testgrid.bep.BuildEventId.TestSummaryId
+	18, // 3: testgrid.bep.BuildEventId.test_result:type_name -> testgrid.bep.BuildEventId.TestResultId
+	20, // 4: testgrid.bep.BuildEventId.build_finished:type_name -> testgrid.bep.BuildEventId.BuildFinishedId
+	13, // 5: testgrid.bep.BuildEventId.workspace_status:type_name -> testgrid.bep.BuildEventId.WorkspaceStatusId
+	15, // 6: testgrid.bep.BuildEventId.target_configured:type_name -> testgrid.bep.BuildEventId.TargetConfiguredId
+	14, // 7: testgrid.bep.BuildEventId.build_metadata:type_name -> testgrid.bep.BuildEventId.BuildMetadataId
+	24, // 8: testgrid.bep.BuildStarted.start_time:type_name -> google.protobuf.Timestamp
+	21, // 9: testgrid.bep.WorkspaceStatus.item:type_name -> testgrid.bep.WorkspaceStatus.Item
+	22, // 10: testgrid.bep.BuildMetadata.metadata:type_name -> testgrid.bep.BuildMetadata.MetadataEntry
+	6,  // 11: testgrid.bep.TestResult.test_action_output:type_name -> testgrid.bep.File
+	0,  // 12: testgrid.bep.TestResult.status:type_name -> testgrid.bep.TestStatus
+	24, // 13: testgrid.bep.TestResult.test_attempt_start:type_name -> google.protobuf.Timestamp
+	25, // 14: testgrid.bep.TestResult.test_attempt_duration:type_name -> google.protobuf.Duration
+	0,  // 15: testgrid.bep.TestSummary.overall_status:type_name -> testgrid.bep.TestStatus
+	23, // 16: testgrid.bep.BuildFinished.exit_code:type_name -> testgrid.bep.BuildFinished.ExitCode
+	24, // 17: testgrid.bep.BuildFinished.finish_time:type_name -> google.protobuf.Timestamp
+	1,  // 18: testgrid.bep.BuildEvent.id:type_name -> testgrid.bep.BuildEventId
+	1,  // 19: testgrid.bep.BuildEvent.children:type_name -> testgrid.bep.BuildEventId
+	2,  // 20: testgrid.bep.BuildEvent.started:type_name -> testgrid.bep.BuildStarted
+	7,  // 21: testgrid.bep.BuildEvent.completed:type_name -> testgrid.bep.TargetComplete
+	9,  // 22: testgrid.bep.BuildEvent.test_summary:type_name -> testgrid.bep.TestSummary
+	8,  // 23: testgrid.bep.BuildEvent.test_result:type_name -> testgrid.bep.TestResult
+	10, // 24: testgrid.bep.BuildEvent.finished:type_name -> testgrid.bep.BuildFinished
+	3,  // 25: testgrid.bep.BuildEvent.workspace_status:type_name -> testgrid.bep.WorkspaceStatus
+	5,  // 26: testgrid.bep.BuildEvent.configured:type_name -> testgrid.bep.TargetConfigured
+	4,  // 27: testgrid.bep.BuildEvent.build_metadata:type_name -> testgrid.bep.BuildMetadata
+	16, // 28: testgrid.bep.BuildEventId.TargetCompletedId.configuration:type_name -> testgrid.bep.BuildEventId.ConfigurationId
+	16, // 29: testgrid.bep.BuildEventId.TestResultId.configuration:type_name -> testgrid.bep.BuildEventId.ConfigurationId
+	16, // 30: testgrid.bep.BuildEventId.TestSummaryId.configuration:type_name -> testgrid.bep.BuildEventId.ConfigurationId
+	31, // [31:31] is the sub-list for method output_type
+	31, // [31:31] is the sub-list for method input_type
+	31, // [31:31] is the sub-list for extension type_name
+	31, // [31:31] is the sub-list for extension extendee
+	0,  // [0:31] is the sub-list for field type_name
+}
+
+func init() { file_build_event_stream_proto_init() }
+func file_build_event_stream_proto_init() {
+	if File_build_event_stream_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_build_event_stream_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetConfigured); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*File); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetComplete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildFinished); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_BuildStartedId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_WorkspaceStatusId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_BuildMetadataId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_TargetConfiguredId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_ConfigurationId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_TargetCompletedId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_TestResultId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_TestSummaryId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildEventId_BuildFinishedId); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WorkspaceStatus_Item); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_build_event_stream_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildFinished_ExitCode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_build_event_stream_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*BuildEventId_Started)(nil),
+		(*BuildEventId_TargetCompleted)(nil),
+		(*BuildEventId_TestSummary)(nil),
+		(*BuildEventId_TestResult)(nil),
+		(*BuildEventId_BuildFinished)(nil),
+		(*BuildEventId_WorkspaceStatus)(nil),
+		(*BuildEventId_TargetConfigured)(nil),
+		(*BuildEventId_BuildMetadata)(nil),
+	}
+	file_build_event_stream_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*File_Uri)(nil),
+		(*File_Contents)(nil),
+	}
+	file_build_event_stream_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*BuildEvent_Started)(nil),
+		(*BuildEvent_Completed)(nil),
+		(*BuildEvent_TestSummary)(nil),
+		(*BuildEvent_TestResult)(nil),
+		(*BuildEvent_Finished)(nil),
+		(*BuildEvent_WorkspaceStatus)(nil),
+		(*BuildEvent_Configured)(nil),
+		(*BuildEvent_BuildMetadata)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_build_event_stream_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   23,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_build_event_stream_proto_goTypes,
+		DependencyIndexes: file_build_event_stream_proto_depIdxs,
+		EnumInfos:         file_build_event_stream_proto_enumTypes,
+		MessageInfos:      file_build_event_stream_proto_msgTypes,
+	}.Build()
+	File_build_event_stream_proto = out.File
+	file_build_event_stream_proto_rawDesc = nil
+	file_build_event_stream_proto_goTypes = nil
+	file_build_event_stream_proto_depIdxs = nil
+}
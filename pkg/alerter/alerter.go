@@ -0,0 +1,365 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package alerter emails the failures, recoveries, flakiness, metric regressions and healthiness reported in dashboard summaries.
+package alerter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// Metrics holds metrics relevant to the Alerter.
+type Metrics struct {
+	Alert  metrics.Cyclic
+	Emails metrics.Counter
+}
+
+// CreateMetrics creates all the metrics that the Alerter will use
+// This should be called once
+func CreateMetrics(factory metrics.Factory) *Metrics {
+	return &Metrics{
+		Alert:  factory.NewCyclic("alerter"),
+		Emails: factory.NewCounter("emails", "Number of alert emails sent", "kind"),
+	}
+}
+
+func (mets *Metrics) start() *metrics.CycleReporter {
+	if mets == nil {
+		return nil
+	}
+	return mets.Alert.Start()
+}
+
+func (mets *Metrics) emailed(kind string) {
+	if mets == nil {
+		return
+	}
+	mets.Emails.Add(1, kind)
+}
+
+// UpdateOptions aggregates the Update function parameter into a single structure.
+type UpdateOptions struct {
+	ConfigPath        gcs.Path
+	SummaryPathPrefix string
+	StatePath         gcs.Path
+	AllowedDashboards []string
+	Confirm           bool
+	Freq              time.Duration
+	// Now returns the current time, defaulting to time.Now.
+	Now func() time.Time
+}
+
+// Update emails alerts for the summaries of each dashboard in the config.
+//
+// Runs once when opts.Freq is zero, otherwise repeats until the context expires.
+// Only sends emails and saves state when opts.Confirm is set.
+func Update(ctx context.Context, client gcs.Client, mets *Metrics, sender Sender, opts *UpdateOptions) error {
+	log := logrus.WithField("config", opts.ConfigPath)
+	state, err := ReadState(ctx, client, opts.StatePath)
+	if err != nil {
+		return fmt.Errorf("read state: %w", err)
+	}
+	log.WithField("tabs", len(state.Tabs)).Info("Loaded alert state")
+
+	var timer *time.Timer
+	for {
+		rep := mets.start()
+		if err := alertOnce(ctx, log, client, mets, sender, opts, state); err != nil {
+			rep.Fail()
+			log.WithError(err).Error("Failed to send alerts")
+		} else {
+			rep.Success()
+		}
+		if opts.Freq == 0 {
+			return nil
+		}
+		if timer == nil {
+			timer = time.NewTimer(opts.Freq)
+			defer timer.Stop()
+		} else {
+			timer.Reset(opts.Freq)
+		}
+		log.WithField("wait", opts.Freq).Info("Sleeping")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func alertOnce(ctx context.Context, log logrus.FieldLogger, client gcs.Client, mets *Metrics, sender Sender, opts *UpdateOptions, state *State) error {
+	cfg, _, err := config.ReadGCS(ctx, client, opts.ConfigPath)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+	now := time.Now
+	if opts.Now != nil {
+		now = opts.Now
+	}
+	alertDashboards(ctx, log, client, mets, sender, opts, cfg, state, now())
+	if !opts.Confirm {
+		return nil
+	}
+	if err := WriteState(ctx, client, opts.StatePath, state); err != nil {
+		return fmt.Errorf("write state: %w", err)
+	}
+	return nil
+}
+
+// alertDashboards sends the alerts for each dashboard summary, recording sent alerts in state.
+//
+// Prunes the state of tabs which are no longer configured.
+func alertDashboards(ctx context.Context, log logrus.FieldLogger, client gcs.Client, mets *Metrics, sender Sender, opts *UpdateOptions, cfg *configpb.Configuration, state *State, now time.Time) {
+	allowed := stringset.New(opts.AllowedDashboards...)
+	keep := map[string]bool{}
+	for _, dash := range cfg.Dashboards {
+		for _, tab := range dash.DashboardTab {
+			keep[tabKey(dash.Name, tab.Name)] = true
+		}
+	}
+	var reports []*tabReport
+	var sent int
+	for _, dash := range cfg.Dashboards {
+		if allowed.Len() > 0 && !allowed.Contains(dash.Name) {
+			continue
+		}
+		log := log.WithField("dashboard", dash.Name)
+		path, err := summarizer.SummaryPath(opts.ConfigPath, opts.SummaryPathPrefix, dash.Name)
+		if err != nil {
+			log.WithError(err).Error("Bad summary path")
+			continue
+		}
+		sum, _, _, err := summarizer.ReadSummary(ctx, client, *path)
+		if err != nil {
+			log.WithError(err).Error("Failed to read summary")
+			continue
+		}
+		if sum == nil {
+			log.Debug("No summary")
+			continue
+		}
+		tabSummaries := make(map[string]*summarypb.DashboardTabSummary, len(sum.TabSummaries))
+		for _, ts := range sum.TabSummaries {
+			tabSummaries[ts.DashboardTabName] = ts
+		}
+		for _, tab := range dash.DashboardTab {
+			ts, ok := tabSummaries[tab.Name]
+			if !ok {
+				continue
+			}
+			log := log.WithField("tab", tab.Name)
+			tabState := state.Tab(dash.Name, tab.Name)
+			for _, alert := range []tabAlerter{alertTab, flakinessAlerts, regressionAlerts} {
+				msgs, prune := alert(dash.Name, tab, ts, tabState, now)
+				sent += send(ctx, log, sender, mets, opts.Confirm, msgs)
+				if opts.Confirm {
+					prune()
+				}
+			}
+			r, schedule := dueReport(log, dash.Name, tab, ts, tabState, now)
+			if opts.Confirm {
+				schedule()
+			}
+			if r != nil {
+				reports = append(reports, r)
+			}
+		}
+	}
+	sent += send(ctx, log, sender, mets, opts.Confirm, reportMessages(reports, now))
+	state.Prune(keep)
+	log.WithField("emails", sent).Info("Finished alerting")
+}
+
+// send the messages, recording each one that is sent and returning how many were sent.
+//
+// Only logs the messages unless confirm is set.
+func send(ctx context.Context, log logrus.FieldLogger, sender Sender, mets *Metrics, confirm bool, msgs []kindMessage) int {
+	var sent int
+	for _, m := range msgs {
+		log := log.WithFields(logrus.Fields{
+			"to":      m.msg.To,
+			"subject": m.msg.Subject,
+		})
+		if !confirm {
+			log.Info("Would send email")
+			continue
+		}
+		if err := sender.Send(ctx, m.msg); err != nil {
+			log.WithError(err).Error("Failed to send email")
+			continue
+		}
+		log.Info("Sent email")
+		mets.emailed(m.kind)
+		if m.commit != nil {
+			m.commit()
+		}
+		sent++
+	}
+	return sent
+}
+
+// tabAlerter returns the emails to send for a tab summary along with a function
+// that prunes the tab state of alerts that no longer apply.
+//
+// Each email records itself in the tab state once it is sent.
+type tabAlerter func(dashboard string, tab *configpb.DashboardTab, sum *summarypb.DashboardTabSummary, ts *TabState, now time.Time) ([]kindMessage, func())
+
+const (
+	failureKind  = "failure"
+	recoveryKind = "recovery"
+)
+
+// kindMessage is a message along with the kind of alert it sends.
+type kindMessage struct {
+	kind string
+	msg  Message
+	// commit records the message in the alert state once it is sent.
+	commit func()
+}
+
+// failureKey identifies the failure in TabState.
+func failureKey(f *summarypb.FailingTestSummary) string {
+	if f.TestName != "" {
+		return f.TestName
+	}
+	return f.DisplayName
+}
+
+// alertTab returns the emails to send for the tab summary, each of which records
+// itself in the tab state once it is sent.
+//
+// Emails each new failure and recovery, batching them by recipient. When the tab
+// sets wait_minutes_between_emails, sends at most one batch per wait interval and
+// reminds recipients of ongoing failures after each interval.
+func alertTab(dashboard string, tab *configpb.DashboardTab, sum *summarypb.DashboardTabSummary, ts *TabState, now time.Time) ([]kindMessage, func()) {
+	opts := tab.GetAlertOptions()
+	wait := time.Duration(opts.GetWaitMinutesBetweenEmails()) * time.Minute
+	if wait > 0 && now.Sub(ts.LastEmail) < wait {
+		return nil, func() {}
+	}
+	defaultTo := splitAddresses(opts.GetAlertMailToAddresses())
+
+	current := make(map[string]bool, len(sum.FailingTestSummaries))
+	failures := map[string][]*summarypb.FailingTestSummary{}
+	failureTo := map[string][]string{}
+	for _, f := range sum.FailingTestSummaries {
+		key := failureKey(f)
+		current[key] = true
+		prev, ok := ts.Failures[key]
+		switch {
+		case !ok, prev.FailBuildID != f.FailBuildId:
+		case wait > 0 && now.Sub(prev.Emailed) >= wait:
+		default:
+			continue
+		}
+		to := f.EmailAddresses
+		if len(to) == 0 {
+			to = defaultTo
+		}
+		if len(to) == 0 {
+			continue
+		}
+		to = sortedAddresses(to)
+		group := strings.Join(to, ",")
+		failures[group] = append(failures[group], f)
+		failureTo[group] = to
+	}
+
+	recoveries := map[string][]string{}
+	recoveryTo := map[string][]string{}
+	for key, f := range ts.Failures {
+		if current[key] {
+			continue
+		}
+		group := strings.Join(f.To, ",")
+		recoveries[group] = append(recoveries[group], key)
+		recoveryTo[group] = f.To
+	}
+
+	var msgs []kindMessage
+	failureGroups := make([]string, 0, len(failures))
+	for group := range failures {
+		failureGroups = append(failureGroups, group)
+	}
+	sort.Strings(failureGroups)
+	for _, group := range failureGroups {
+		fs := failures[group]
+		to := failureTo[group]
+		sort.Slice(fs, func(i, j int) bool { return failureKey(fs[i]) < failureKey(fs[j]) })
+		msgs = append(msgs, kindMessage{
+			kind: failureKind,
+			msg: Message{
+				To:      to,
+				Subject: failureSubject(dashboard, tab, len(fs)),
+				Body:    failureBody(dashboard, tab, fs),
+			},
+			commit: func() {
+				for _, f := range fs {
+					ts.Failures[failureKey(f)] = Failure{
+						FailBuildID: f.FailBuildId,
+						To:          to,
+						Emailed:     now,
+					}
+				}
+				ts.LastEmail = now
+			},
+		})
+	}
+	recoveryGroups := make([]string, 0, len(recoveries))
+	for group := range recoveries {
+		recoveryGroups = append(recoveryGroups, group)
+	}
+	sort.Strings(recoveryGroups)
+	for _, group := range recoveryGroups {
+		names := recoveries[group]
+		sort.Strings(names)
+		msgs = append(msgs, kindMessage{
+			kind: recoveryKind,
+			msg: Message{
+				To:      recoveryTo[group],
+				Subject: recoverySubject(dashboard, tab, len(names)),
+				Body:    recoveryBody(dashboard, tab, names),
+			},
+			commit: func() {
+				for _, name := range names {
+					delete(ts.Failures, name)
+				}
+			},
+		})
+	}
+	return msgs, func() {}
+}
+
+func sortedAddresses(addresses []string) []string {
+	out := make([]string, len(addresses))
+	copy(out, addresses)
+	sort.Strings(out)
+	return out
+}
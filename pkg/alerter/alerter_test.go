@@ -0,0 +1,550 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sirupsen/logrus"
+)
+
+func TestAlertTab(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-10 * time.Minute)
+	cases := []struct {
+		name      string
+		tab       *configpb.DashboardTab
+		sum       *summarypb.DashboardTabSummary
+		state     TabState
+		wantTo    [][]string
+		wantKinds []string
+		wantState TabState
+	}{
+		{
+			name: "basically works",
+			tab:  &configpb.DashboardTab{Name: "tab"},
+			sum:  &summarypb.DashboardTabSummary{},
+			wantState: TabState{
+				Failures: map[string]Failure{},
+			},
+		},
+		{
+			name: "email new failure",
+			tab: &configpb.DashboardTab{
+				Name: "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{
+					AlertMailToAddresses: "b@example.com, a@example.com",
+				},
+			},
+			sum: &summarypb.DashboardTabSummary{
+				FailingTestSummaries: []*summarypb.FailingTestSummary{
+					{TestName: "foo", FailBuildId: "10"},
+				},
+			},
+			wantTo:    [][]string{{"a@example.com", "b@example.com"}},
+			wantKinds: []string{failureKind},
+			wantState: TabState{
+				LastEmail: now,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com", "b@example.com"}, Emailed: now},
+				},
+			},
+		},
+		{
+			name: "ignore failures without recipients",
+			tab:  &configpb.DashboardTab{Name: "tab"},
+			sum: &summarypb.DashboardTabSummary{
+				FailingTestSummaries: []*summarypb.FailingTestSummary{
+					{TestName: "foo", FailBuildId: "10"},
+				},
+			},
+			wantState: TabState{
+				Failures: map[string]Failure{},
+			},
+		},
+		{
+			name: "route to failure addresses",
+			tab: &configpb.DashboardTab{
+				Name: "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{
+					AlertMailToAddresses: "default@example.com",
+				},
+			},
+			sum: &summarypb.DashboardTabSummary{
+				FailingTestSummaries: []*summarypb.FailingTestSummary{
+					{TestName: "foo", FailBuildId: "10"},
+					{TestName: "bar", FailBuildId: "11", EmailAddresses: []string{"owner@example.com"}},
+					{TestName: "baz", FailBuildId: "12"},
+				},
+			},
+			wantTo:    [][]string{{"default@example.com"}, {"owner@example.com"}},
+			wantKinds: []string{failureKind, failureKind},
+			wantState: TabState{
+				LastEmail: now,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"default@example.com"}, Emailed: now},
+					"bar": {FailBuildID: "11", To: []string{"owner@example.com"}, Emailed: now},
+					"baz": {FailBuildID: "12", To: []string{"default@example.com"}, Emailed: now},
+				},
+			},
+		},
+		{
+			name: "do not resend ongoing failure",
+			tab: &configpb.DashboardTab{
+				Name: "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{
+					AlertMailToAddresses: "a@example.com",
+				},
+			},
+			sum: &summarypb.DashboardTabSummary{
+				FailingTestSummaries: []*summarypb.FailingTestSummary{
+					{TestName: "foo", FailBuildId: "10"},
+				},
+			},
+			state: TabState{
+				LastEmail: earlier,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: earlier},
+				},
+			},
+			wantState: TabState{
+				LastEmail: earlier,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: earlier},
+				},
+			},
+		},
+		{
+			name: "email new outage of the same test",
+			tab: &configpb.DashboardTab{
+				Name: "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{
+					AlertMailToAddresses: "a@example.com",
+				},
+			},
+			sum: &summarypb.DashboardTabSummary{
+				FailingTestSummaries: []*summarypb.FailingTestSummary{
+					{TestName: "foo", FailBuildId: "20"},
+				},
+			},
+			state: TabState{
+				LastEmail: earlier,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: earlier},
+				},
+			},
+			wantTo:    [][]string{{"a@example.com"}},
+			wantKinds: []string{failureKind},
+			wantState: TabState{
+				LastEmail: now,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "20", To: []string{"a@example.com"}, Emailed: now},
+				},
+			},
+		},
+		{
+			name: "email recovery to original recipients",
+			tab: &configpb.DashboardTab{
+				Name: "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{
+					AlertMailToAddresses: "new@example.com",
+				},
+			},
+			sum: &summarypb.DashboardTabSummary{},
+			state: TabState{
+				LastEmail: earlier,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"old@example.com"}, Emailed: earlier},
+				},
+			},
+			wantTo:    [][]string{{"old@example.com"}},
+			wantKinds: []string{recoveryKind},
+			wantState: TabState{
+				LastEmail: earlier,
+				Failures:  map[string]Failure{},
+			},
+		},
+		{
+			name: "wait between emails",
+			tab: &configpb.DashboardTab{
+				Name: "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{
+					AlertMailToAddresses:     "a@example.com",
+					WaitMinutesBetweenEmails: 30,
+				},
+			},
+			sum: &summarypb.DashboardTabSummary{
+				FailingTestSummaries: []*summarypb.FailingTestSummary{
+					{TestName: "foo", FailBuildId: "10"},
+					{TestName: "bar", FailBuildId: "11"},
+				},
+			},
+			state: TabState{
+				LastEmail: earlier,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: earlier},
+				},
+			},
+			wantState: TabState{
+				LastEmail: earlier,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: earlier},
+				},
+			},
+		},
+		{
+			name: "remind after waiting",
+			tab: &configpb.DashboardTab{
+				Name: "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{
+					AlertMailToAddresses:     "a@example.com",
+					WaitMinutesBetweenEmails: 5,
+				},
+			},
+			sum: &summarypb.DashboardTabSummary{
+				FailingTestSummaries: []*summarypb.FailingTestSummary{
+					{TestName: "foo", FailBuildId: "10"},
+					{TestName: "bar", FailBuildId: "11"},
+				},
+			},
+			state: TabState{
+				LastEmail: earlier,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: earlier},
+				},
+			},
+			wantTo:    [][]string{{"a@example.com"}},
+			wantKinds: []string{failureKind},
+			wantState: TabState{
+				LastEmail: now,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: now},
+					"bar": {FailBuildID: "11", To: []string{"a@example.com"}, Emailed: now},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var s State
+			ts := s.Tab("dash", tc.tab.Name)
+			if tc.state.Failures != nil {
+				*ts = tc.state
+			} else {
+				ts.LastEmail = tc.state.LastEmail
+			}
+			msgs, prune := alertTab("dash", tc.tab, tc.sum, ts, now)
+			commitAll(msgs, prune)
+
+			var gotTo [][]string
+			var gotKinds []string
+			for _, m := range msgs {
+				gotTo = append(gotTo, m.msg.To)
+				gotKinds = append(gotKinds, m.kind)
+			}
+			if diff := cmp.Diff(tc.wantTo, gotTo); diff != "" {
+				t.Errorf("alertTab() got unexpected recipient diff (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantKinds, gotKinds); diff != "" {
+				t.Errorf("alertTab() got unexpected kind diff (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantState, *ts, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("alertTab() got unexpected state diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// commitAll records every message as sent and prunes the state.
+func commitAll(msgs []kindMessage, prune func()) {
+	for _, m := range msgs {
+		if m.commit != nil {
+			m.commit()
+		}
+	}
+	prune()
+}
+
+// recipientSender fails to send messages to the fail address.
+type recipientSender struct {
+	FakeSender
+	fail string
+}
+
+func (s *recipientSender) Send(ctx context.Context, msg Message) error {
+	for _, to := range msg.To {
+		if to == s.fail {
+			return errors.New("injected")
+		}
+	}
+	return s.FakeSender.Send(ctx, msg)
+}
+
+func TestSend(t *testing.T) {
+	cases := []struct {
+		name          string
+		confirm       bool
+		wantSent      int
+		wantCommitted []string
+	}{
+		{
+			name: "dry run",
+		},
+		{
+			name:          "commit each sent message",
+			confirm:       true,
+			wantSent:      2,
+			wantCommitted: []string{"a@example.com", "c@example.com"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var committed []string
+			var msgs []kindMessage
+			for _, to := range []string{"a@example.com", "b@example.com", "c@example.com"} {
+				to := to
+				msgs = append(msgs, kindMessage{
+					kind:   failureKind,
+					msg:    Message{To: []string{to}},
+					commit: func() { committed = append(committed, to) },
+				})
+			}
+			sender := &recipientSender{fail: "b@example.com"}
+			got := send(context.Background(), logrus.WithField("name", tc.name), sender, nil, tc.confirm, msgs)
+			if got != tc.wantSent {
+				t.Errorf("send() got %d sent, want %d", got, tc.wantSent)
+			}
+			if diff := cmp.Diff(tc.wantCommitted, committed); diff != "" {
+				t.Errorf("send() committed unexpected messages (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFailureBody(t *testing.T) {
+	tab := &configpb.DashboardTab{
+		Name: "tab",
+		AlertOptions: &configpb.DashboardTabAlertOptions{
+			AlertMailFailureMessage: "Please fix",
+			DebugUrl:                "http://example.com/debug",
+		},
+	}
+	got := failureBody("dash", tab, []*summarypb.FailingTestSummary{
+		{
+			DisplayName:       "foo",
+			FailBuildId:       "10",
+			LatestFailBuildId: "12",
+			FailCount:         3,
+			FailureMessage:    "boom",
+			LinkedBugs:        []string{"123"},
+		},
+	})
+	for _, want := range []string{
+		"1 test failing on dash/tab",
+		"Please fix",
+		"Failed 3 times since 10, most recently 12",
+		"Message: boom",
+		"Bugs: 123",
+		"Debugging help: http://example.com/debug",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("failureBody() missing %q in:\n%s", want, got)
+		}
+	}
+}
+
+func TestAlertDashboards(t *testing.T) {
+	configPath := mustPath("gs://bucket/config")
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	cfg := &configpb.Configuration{
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name: "dash",
+				DashboardTab: []*configpb.DashboardTab{
+					{
+						Name: "tab",
+						AlertOptions: &configpb.DashboardTabAlertOptions{
+							AlertMailToAddresses: "a@example.com",
+						},
+					},
+					{
+						Name: "missing",
+					},
+					healthTab("health", "@daily", "b@example.com"),
+				},
+			},
+			{
+				Name: "no-summary",
+			},
+			{
+				Name: "broken",
+				DashboardTab: []*configpb.DashboardTab{
+					{Name: "tab"},
+				},
+			},
+		},
+	}
+	sum := &summarypb.DashboardSummary{
+		TabSummaries: []*summarypb.DashboardTabSummary{
+			{
+				DashboardName:    "dash",
+				DashboardTabName: "tab",
+				FailingTestSummaries: []*summarypb.FailingTestSummary{
+					{TestName: "foo", FailBuildId: "10"},
+				},
+			},
+			{
+				DashboardName:    "dash",
+				DashboardTabName: "health",
+			},
+		},
+	}
+	summaryPath, err := summarizer.SummaryPath(configPath, "summary", "dash")
+	if err != nil {
+		t.Fatalf("SummaryPath(): %v", err)
+	}
+	brokenPath, err := summarizer.SummaryPath(configPath, "summary", "broken")
+	if err != nil {
+		t.Fatalf("SummaryPath(): %v", err)
+	}
+	buf, err := proto.Marshal(sum)
+	if err != nil {
+		t.Fatalf("Marshal(): %v", err)
+	}
+
+	cases := []struct {
+		name      string
+		confirm   bool
+		allowed   []string
+		sendErr   error
+		state     State
+		wantSent  int
+		wantState State
+	}{
+		{
+			name: "dry run",
+			wantState: State{
+				Tabs: map[string]*TabState{
+					"dash/tab":    {Failures: map[string]Failure{}},
+					"dash/health": {},
+				},
+			},
+		},
+		{
+			name:     "send",
+			confirm:  true,
+			wantSent: 1,
+			state: State{
+				Tabs: map[string]*TabState{
+					"deleted/tab": {LastEmail: now},
+					"broken/tab":  {LastEmail: now},
+				},
+			},
+			wantState: State{
+				Tabs: map[string]*TabState{
+					"dash/tab": {
+						LastEmail: now,
+						Failures: map[string]Failure{
+							"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: now},
+						},
+					},
+					"dash/health": {LastReport: now},
+					"broken/tab":  {LastEmail: now},
+				},
+			},
+		},
+		{
+			name:    "send error",
+			confirm: true,
+			sendErr: errors.New("injected"),
+			wantState: State{
+				Tabs: map[string]*TabState{
+					"dash/tab":    {Failures: map[string]Failure{}},
+					"dash/health": {LastReport: now},
+				},
+			},
+		},
+		{
+			name:    "keep state of other dashboards",
+			confirm: true,
+			allowed: []string{"broken"},
+			state: State{
+				Tabs: map[string]*TabState{
+					"dash/tab": {LastEmail: now},
+				},
+			},
+			wantState: State{
+				Tabs: map[string]*TabState{
+					"dash/tab": {LastEmail: now},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.UploadClient{
+				Uploader: fake.Uploader{},
+				Client: fake.Client{
+					Opener: fake.Opener{
+						*summaryPath: {Data: string(buf)},
+						*brokenPath:  {OpenErr: errors.New("injected")},
+					},
+				},
+				Stater: fake.Stater{},
+			}
+			sender := &FakeSender{Err: tc.sendErr}
+			opts := &UpdateOptions{
+				ConfigPath:        configPath,
+				SummaryPathPrefix: "summary",
+				Confirm:           tc.confirm,
+				AllowedDashboards: tc.allowed,
+			}
+			state := tc.state
+			alertDashboards(context.Background(), logrus.WithField("name", tc.name), client, nil, sender, opts, cfg, &state, now)
+
+			if got := len(sender.Messages); got != tc.wantSent {
+				t.Errorf("alertDashboards() sent %d messages, want %d", got, tc.wantSent)
+			}
+			if diff := cmp.Diff(tc.wantState, state, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("alertDashboards() got unexpected state diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func mustPath(s string) gcs.Path {
+	p, err := gcs.NewPath(s)
+	if err != nil {
+		panic(err)
+	}
+	return *p
+}
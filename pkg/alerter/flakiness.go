@@ -0,0 +1,134 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+)
+
+const flakinessKind = "flakiness"
+
+// flakinessAlerts returns the flakiness digest to send for the tab summary along
+// with a function that prunes tests which are no longer flaky from the tab state.
+//
+// Each test is reported once when its flakiness reaches minimum_flakiness_to_alert,
+// and again only after it drops below the threshold and then crosses it again.
+func flakinessAlerts(dashboard string, tab *configpb.DashboardTab, sum *summarypb.DashboardTabSummary, ts *TabState, now time.Time) ([]kindMessage, func()) {
+	opts := tab.GetFlakinessAlertOptions()
+	threshold := opts.GetMinimumFlakinessToAlert()
+	if threshold <= 0 {
+		return nil, func() {
+			ts.Flaky = map[string]Flaky{}
+		}
+	}
+	health := sum.GetHealthiness()
+	if health == nil {
+		return nil, func() {}
+	}
+	wait := time.Duration(opts.GetWaitMinutesBetweenEmails()) * time.Minute
+	if wait > 0 && now.Sub(ts.LastFlakinessEmail) < wait {
+		return nil, func() {}
+	}
+
+	flaky := map[string]bool{}
+	var regressed []*summarypb.TestInfo
+	for _, test := range health.Tests {
+		if test.Flakiness < threshold {
+			continue
+		}
+		flaky[test.DisplayName] = true
+		if _, ok := ts.Flaky[test.DisplayName]; ok {
+			continue
+		}
+		regressed = append(regressed, test)
+	}
+
+	prune := func() {
+		for name := range ts.Flaky {
+			if !flaky[name] {
+				delete(ts.Flaky, name)
+			}
+		}
+	}
+
+	to := splitAddresses(opts.GetAlertMailToAddresses())
+	if len(regressed) == 0 || len(to) == 0 {
+		return nil, prune
+	}
+
+	sort.Slice(regressed, func(i, j int) bool {
+		if regressed[i].Flakiness != regressed[j].Flakiness {
+			return regressed[i].Flakiness > regressed[j].Flakiness
+		}
+		return regressed[i].DisplayName < regressed[j].DisplayName
+	})
+
+	msg := Message{
+		To:      to,
+		Subject: flakinessSubject(dashboard, tab, len(regressed)),
+		Body:    flakinessBody(dashboard, tab, health, threshold, regressed),
+	}
+	record := func() {
+		for _, test := range regressed {
+			ts.Flaky[test.DisplayName] = Flaky{
+				Flakiness: test.Flakiness,
+				Emailed:   now,
+			}
+		}
+		ts.LastFlakinessEmail = now
+	}
+	return []kindMessage{{kind: flakinessKind, msg: msg, commit: record}}, prune
+}
+
+func flakinessSubject(dashboard string, tab *configpb.DashboardTab, n int) string {
+	if s := tab.GetFlakinessAlertOptions().GetSubject(); s != "" {
+		return s
+	}
+	return fmt.Sprintf("[testgrid] %s: %s flaky on %s", dashboard, plural(n, "test"), tab.Name)
+}
+
+func flakinessBody(dashboard string, tab *configpb.DashboardTab, health *summarypb.HealthinessInfo, threshold float32, tests []*summarypb.TestInfo) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s on %s/%s reached %.1f%% flakiness", plural(len(tests), "test"), dashboard, tab.Name, threshold)
+	if start, end := health.GetStart(), health.GetEnd(); start != nil && end != nil {
+		fmt.Fprintf(&b, " between %s and %s", start.AsTime().Format("2006-01-02"), end.AsTime().Format("2006-01-02"))
+	}
+	b.WriteString(":\n")
+	if msg := tab.GetFlakinessAlertOptions().GetAlertMailFailureMessage(); msg != "" {
+		fmt.Fprintf(&b, "\n%s\n", msg)
+	}
+	for _, test := range tests {
+		fmt.Fprintf(&b, "\n%s\n", test.DisplayName)
+		fmt.Fprintf(&b, "  Flakiness: %.1f%%", test.Flakiness)
+		if len(test.PreviousFlakiness) > 0 {
+			fmt.Fprintf(&b, " (previously %.1f%%)", test.PreviousFlakiness[0])
+		}
+		b.WriteString("\n")
+		fmt.Fprintf(&b, "  Runs: %d passed, %d failed, %d total\n", test.PassedNonInfraRuns, test.FailedNonInfraRuns, test.TotalNonInfraRuns)
+		if test.FailedInfraRuns > 0 {
+			fmt.Fprintf(&b, "  Infra failures: %d\n", test.FailedInfraRuns)
+		}
+	}
+	fmt.Fprintf(&b, "\nAverage tab flakiness: %.1f%%\n", health.AverageFlakiness)
+	return b.String()
+}
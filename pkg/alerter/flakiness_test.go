@@ -0,0 +1,182 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestFlakinessAlerts(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-10 * time.Minute)
+	tab := func(threshold float32, wait int32) *configpb.DashboardTab {
+		return &configpb.DashboardTab{
+			Name: "tab",
+			FlakinessAlertOptions: &configpb.DashboardTabFlakinessAlertOptions{
+				MinimumFlakinessToAlert:  threshold,
+				AlertMailToAddresses:     "a@example.com",
+				WaitMinutesBetweenEmails: wait,
+			},
+		}
+	}
+	health := &summarypb.DashboardTabSummary{
+		Healthiness: &summarypb.HealthinessInfo{
+			Tests: []*summarypb.TestInfo{
+				{DisplayName: "stable", Flakiness: 5},
+				{DisplayName: "flaky", Flakiness: 30},
+				{DisplayName: "flakier", Flakiness: 60},
+			},
+		},
+	}
+	cases := []struct {
+		name      string
+		tab       *configpb.DashboardTab
+		sum       *summarypb.DashboardTabSummary
+		state     TabState
+		wantTests []string
+		wantState TabState
+	}{
+		{
+			name: "disabled",
+			tab:  &configpb.DashboardTab{Name: "tab"},
+			sum:  health,
+			state: TabState{
+				Flaky: map[string]Flaky{"flaky": {Flakiness: 30, Emailed: earlier}},
+			},
+		},
+		{
+			name: "no healthiness",
+			tab:  tab(20, 0),
+			sum:  &summarypb.DashboardTabSummary{},
+			state: TabState{
+				Flaky: map[string]Flaky{"flaky": {Flakiness: 30, Emailed: earlier}},
+			},
+			wantState: TabState{
+				Flaky: map[string]Flaky{"flaky": {Flakiness: 30, Emailed: earlier}},
+			},
+		},
+		{
+			name:      "email tests over threshold",
+			tab:       tab(20, 0),
+			sum:       health,
+			wantTests: []string{"flakier", "flaky"},
+			wantState: TabState{
+				LastFlakinessEmail: now,
+				Flaky: map[string]Flaky{
+					"flaky":   {Flakiness: 30, Emailed: now},
+					"flakier": {Flakiness: 60, Emailed: now},
+				},
+			},
+		},
+		{
+			name: "report each regression once",
+			tab:  tab(20, 0),
+			sum:  health,
+			state: TabState{
+				LastFlakinessEmail: earlier,
+				Flaky: map[string]Flaky{
+					"flaky": {Flakiness: 25, Emailed: earlier},
+				},
+			},
+			wantTests: []string{"flakier"},
+			wantState: TabState{
+				LastFlakinessEmail: now,
+				Flaky: map[string]Flaky{
+					"flaky":   {Flakiness: 25, Emailed: earlier},
+					"flakier": {Flakiness: 60, Emailed: now},
+				},
+			},
+		},
+		{
+			name: "forget tests under threshold",
+			tab:  tab(50, 0),
+			sum:  health,
+			state: TabState{
+				LastFlakinessEmail: earlier,
+				Flaky: map[string]Flaky{
+					"flaky":   {Flakiness: 55, Emailed: earlier},
+					"flakier": {Flakiness: 60, Emailed: earlier},
+				},
+			},
+			wantState: TabState{
+				LastFlakinessEmail: earlier,
+				Flaky: map[string]Flaky{
+					"flakier": {Flakiness: 60, Emailed: earlier},
+				},
+			},
+		},
+		{
+			name: "wait between emails",
+			tab:  tab(20, 30),
+			sum:  health,
+			state: TabState{
+				LastFlakinessEmail: earlier,
+				Flaky: map[string]Flaky{
+					"flaky": {Flakiness: 25, Emailed: earlier},
+				},
+			},
+			wantState: TabState{
+				LastFlakinessEmail: earlier,
+				Flaky: map[string]Flaky{
+					"flaky": {Flakiness: 25, Emailed: earlier},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var s State
+			ts := s.Tab("dash", tc.tab.Name)
+			ts.LastFlakinessEmail = tc.state.LastFlakinessEmail
+			for name, f := range tc.state.Flaky {
+				ts.Flaky[name] = f
+			}
+			msgs, prune := flakinessAlerts("dash", tc.tab, tc.sum, ts, now)
+			commitAll(msgs, prune)
+
+			var gotTests []string
+			switch len(msgs) {
+			case 0:
+			case 1:
+				if msgs[0].kind != flakinessKind {
+					t.Errorf("flakinessAlerts() got kind %q, want %q", msgs[0].kind, flakinessKind)
+				}
+				for _, line := range strings.Split(msgs[0].msg.Body, "\n") {
+					if strings.HasPrefix(line, "flak") {
+						gotTests = append(gotTests, line)
+					}
+				}
+			default:
+				t.Fatalf("flakinessAlerts() got %d messages, want at most 1", len(msgs))
+			}
+			if diff := cmp.Diff(tc.wantTests, gotTests); diff != "" {
+				t.Errorf("flakinessAlerts() got unexpected tests (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantState, *ts, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("flakinessAlerts() got unexpected state diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"fmt"
+	"strings"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+)
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+func failureSubject(dashboard string, tab *configpb.DashboardTab, n int) string {
+	if s := tab.GetAlertOptions().GetSubject(); s != "" {
+		return s
+	}
+	return fmt.Sprintf("[testgrid] %s: %s failing on %s", dashboard, plural(n, "test"), tab.Name)
+}
+
+func recoverySubject(dashboard string, tab *configpb.DashboardTab, n int) string {
+	if s := tab.GetAlertOptions().GetSubject(); s != "" {
+		return "Recovered: " + s
+	}
+	return fmt.Sprintf("[testgrid] %s: %s recovered on %s", dashboard, plural(n, "test"), tab.Name)
+}
+
+func failureBody(dashboard string, tab *configpb.DashboardTab, failures []*summarypb.FailingTestSummary) string {
+	var b strings.Builder
+	opts := tab.GetAlertOptions()
+	fmt.Fprintf(&b, "%s failing on %s/%s:\n", plural(len(failures), "test"), dashboard, tab.Name)
+	if msg := opts.GetAlertMailFailureMessage(); msg != "" {
+		fmt.Fprintf(&b, "\n%s\n", msg)
+	}
+	for _, f := range failures {
+		name := f.DisplayName
+		if name == "" {
+			name = f.TestName
+		}
+		fmt.Fprintf(&b, "\n%s\n", name)
+		fmt.Fprintf(&b, "  Failed %d times since %s", f.FailCount, f.FailBuildId)
+		if f.LatestFailBuildId != "" && f.LatestFailBuildId != f.FailBuildId {
+			fmt.Fprintf(&b, ", most recently %s", f.LatestFailBuildId)
+		}
+		b.WriteString("\n")
+		if f.PassBuildId != "" {
+			fmt.Fprintf(&b, "  Last passed: %s\n", f.PassBuildId)
+		}
+		if f.FailureMessage != "" {
+			fmt.Fprintf(&b, "  Message: %s\n", f.FailureMessage)
+		}
+		if f.BuildLink != "" {
+			fmt.Fprintf(&b, "  Changes: %s\n", f.BuildLink)
+		}
+		if len(f.LinkedBugs) > 0 {
+			fmt.Fprintf(&b, "  Bugs: %s\n", strings.Join(f.LinkedBugs, ", "))
+		}
+	}
+	if url := opts.GetDebugUrl(); url != "" {
+		text := opts.GetDebugMessage()
+		if text == "" {
+			text = "Debugging help"
+		}
+		fmt.Fprintf(&b, "\n%s: %s\n", text, url)
+	}
+	return b.String()
+}
+
+func recoveryBody(dashboard string, tab *configpb.DashboardTab, names []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s no longer failing on %s/%s:\n\n", plural(len(names), "test"), dashboard, tab.Name)
+	for _, name := range names {
+		fmt.Fprintf(&b, "%s\n", name)
+	}
+	return b.String()
+}
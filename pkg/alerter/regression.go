@@ -0,0 +1,98 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+)
+
+const regressionKind = "regression"
+
+// regressionKey identifies the regression in TabState.
+func regressionKey(r *summarypb.MetricRegression) string {
+	return r.DisplayName + " " + r.Metric
+}
+
+// regressionAlerts returns the metric regressions to email for the tab summary
+// along with a function that prunes resolved regressions from the tab state.
+//
+// Regressions are emailed to the tab's alert_mail_to_addresses, once for each
+// build that starts a step change.
+func regressionAlerts(dashboard string, tab *configpb.DashboardTab, sum *summarypb.DashboardTabSummary, ts *TabState, now time.Time) ([]kindMessage, func()) {
+	current := make(map[string]bool, len(sum.MetricRegressions))
+	var regressions []*summarypb.MetricRegression
+	for _, r := range sum.MetricRegressions {
+		key := regressionKey(r)
+		current[key] = true
+		if prev, ok := ts.Regressions[key]; ok && prev.BuildID == r.BuildId {
+			continue
+		}
+		regressions = append(regressions, r)
+	}
+
+	prune := func() {
+		for key := range ts.Regressions {
+			if !current[key] {
+				delete(ts.Regressions, key)
+			}
+		}
+	}
+
+	to := splitAddresses(tab.GetAlertOptions().GetAlertMailToAddresses())
+	if len(regressions) == 0 || len(to) == 0 {
+		return nil, prune
+	}
+
+	msg := Message{
+		To:      to,
+		Subject: regressionSubject(dashboard, tab, len(regressions)),
+		Body:    regressionBody(dashboard, tab, regressions),
+	}
+	record := func() {
+		for _, r := range regressions {
+			ts.Regressions[regressionKey(r)] = Regression{
+				BuildID: r.BuildId,
+				Emailed: now,
+			}
+		}
+	}
+	return []kindMessage{{kind: regressionKind, msg: msg, commit: record}}, prune
+}
+
+func regressionSubject(dashboard string, tab *configpb.DashboardTab, n int) string {
+	return fmt.Sprintf("[testgrid] %s: %s on %s", dashboard, plural(n, "metric regression"), tab.Name)
+}
+
+func regressionBody(dashboard string, tab *configpb.DashboardTab, regressions []*summarypb.MetricRegression) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s on %s/%s:\n", plural(len(regressions), "metric regression"), dashboard, tab.Name)
+	for _, r := range regressions {
+		fmt.Fprintf(&b, "\n%s\n", r.DisplayName)
+		fmt.Fprintf(&b, "  %s: %g -> %g (%+.1f%%)\n", r.Metric, r.PreviousMean, r.CurrentMean, 100*r.RelativeChange)
+		fmt.Fprintf(&b, "  Since: %s", r.BuildId)
+		if r.PreviousBuildId != "" {
+			fmt.Fprintf(&b, " (last unchanged: %s)", r.PreviousBuildId)
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
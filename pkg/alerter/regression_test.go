@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestRegressionAlerts(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	earlier := now.Add(-10 * time.Minute)
+	tab := &configpb.DashboardTab{
+		Name: "tab",
+		AlertOptions: &configpb.DashboardTabAlertOptions{
+			AlertMailToAddresses: "a@example.com",
+		},
+	}
+	regressions := &summarypb.DashboardTabSummary{
+		MetricRegressions: []*summarypb.MetricRegression{
+			{
+				DisplayName:     "slow",
+				Metric:          "test-duration-minutes",
+				BuildId:         "10",
+				PreviousBuildId: "9",
+				PreviousMean:    10,
+				CurrentMean:     20,
+				RelativeChange:  1,
+			},
+			{
+				DisplayName:     "slower",
+				Metric:          "test-duration-minutes",
+				BuildId:         "12",
+				PreviousBuildId: "11",
+				PreviousMean:    10,
+				CurrentMean:     15,
+				RelativeChange:  0.5,
+			},
+		},
+	}
+	cases := []struct {
+		name      string
+		tab       *configpb.DashboardTab
+		sum       *summarypb.DashboardTabSummary
+		state     TabState
+		wantTests []string
+		wantState TabState
+	}{
+		{
+			name: "no recipients",
+			tab:  &configpb.DashboardTab{Name: "tab"},
+			sum:  regressions,
+		},
+		{
+			name: "no regressions",
+			tab:  tab,
+			sum:  &summarypb.DashboardTabSummary{},
+			state: TabState{
+				Regressions: map[string]Regression{
+					"slow test-duration-minutes": {BuildID: "10", Emailed: earlier},
+				},
+			},
+		},
+		{
+			name:      "email regressions",
+			tab:       tab,
+			sum:       regressions,
+			wantTests: []string{"slow", "slower"},
+			wantState: TabState{
+				Regressions: map[string]Regression{
+					"slow test-duration-minutes":   {BuildID: "10", Emailed: now},
+					"slower test-duration-minutes": {BuildID: "12", Emailed: now},
+				},
+			},
+		},
+		{
+			name: "report each regression once",
+			tab:  tab,
+			sum:  regressions,
+			state: TabState{
+				Regressions: map[string]Regression{
+					"slow test-duration-minutes": {BuildID: "10", Emailed: earlier},
+				},
+			},
+			wantTests: []string{"slower"},
+			wantState: TabState{
+				Regressions: map[string]Regression{
+					"slow test-duration-minutes":   {BuildID: "10", Emailed: earlier},
+					"slower test-duration-minutes": {BuildID: "12", Emailed: now},
+				},
+			},
+		},
+		{
+			name: "report a new change point",
+			tab:  tab,
+			sum:  regressions,
+			state: TabState{
+				Regressions: map[string]Regression{
+					"slow test-duration-minutes":   {BuildID: "5", Emailed: earlier},
+					"slower test-duration-minutes": {BuildID: "12", Emailed: earlier},
+					"gone test-duration-minutes":   {BuildID: "1", Emailed: earlier},
+				},
+			},
+			wantTests: []string{"slow"},
+			wantState: TabState{
+				Regressions: map[string]Regression{
+					"slow test-duration-minutes":   {BuildID: "10", Emailed: now},
+					"slower test-duration-minutes": {BuildID: "12", Emailed: earlier},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var s State
+			ts := s.Tab("dash", tc.tab.Name)
+			for key, r := range tc.state.Regressions {
+				ts.Regressions[key] = r
+			}
+			msgs, prune := regressionAlerts("dash", tc.tab, tc.sum, ts, now)
+			commitAll(msgs, prune)
+
+			var gotTests []string
+			switch len(msgs) {
+			case 0:
+			case 1:
+				if msgs[0].kind != regressionKind {
+					t.Errorf("regressionAlerts() got kind %q, want %q", msgs[0].kind, regressionKind)
+				}
+				if want := []string{"a@example.com"}; !cmp.Equal(want, msgs[0].msg.To) {
+					t.Errorf("regressionAlerts() got recipients %v, want %v", msgs[0].msg.To, want)
+				}
+				for _, line := range strings.Split(msgs[0].msg.Body, "\n") {
+					if strings.HasPrefix(line, "slow") {
+						gotTests = append(gotTests, line)
+					}
+				}
+			default:
+				t.Fatalf("regressionAlerts() got %d messages, want at most 1", len(msgs))
+			}
+			if diff := cmp.Diff(tc.wantTests, gotTests); diff != "" {
+				t.Errorf("regressionAlerts() got unexpected tests (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantState, *ts, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("regressionAlerts() got unexpected state diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
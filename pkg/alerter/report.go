@@ -0,0 +1,220 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"sort"
+	"strings"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	"github.com/sirupsen/logrus"
+)
+
+const reportKind = "report"
+
+// tabReport is the healthiness of a tab whose report is due.
+type tabReport struct {
+	Dashboard string
+	Tab       string
+	Health    *summarypb.HealthinessInfo
+	to        []string
+	state     *TabState
+}
+
+// dueReport returns the report for the tab if its email_schedule has passed since the last report.
+//
+// Starts the schedule at now the first time it sees the tab, so new tabs wait for the next occurrence.
+// The returned function records changes to the schedule in the tab state.
+func dueReport(log logrus.FieldLogger, dashboard string, tab *configpb.DashboardTab, sum *summarypb.DashboardTabSummary, ts *TabState, now time.Time) (*tabReport, func()) {
+	opts := tab.GetHealthAnalysisOptions()
+	if !opts.GetEnable() || opts.GetEmailSchedule() == "" {
+		return nil, func() { ts.LastReport = time.Time{} }
+	}
+	to := splitAddresses(opts.GetEmailRecipients())
+	if len(to) == 0 {
+		return nil, func() {}
+	}
+	sched, err := ParseSchedule(opts.GetEmailSchedule())
+	if err != nil {
+		log.WithError(err).WithField("email_schedule", opts.GetEmailSchedule()).Warning("Bad healthiness email schedule")
+		return nil, func() {}
+	}
+	if ts.LastReport.IsZero() {
+		return nil, func() { ts.LastReport = now }
+	}
+	if next := sched.Next(ts.LastReport); next.IsZero() || next.After(now) {
+		return nil, func() {}
+	}
+	return &tabReport{
+		Dashboard: dashboard,
+		Tab:       tab.Name,
+		Health:    sum.GetHealthiness(),
+		to:        sortedAddresses(to),
+		state:     ts,
+	}, func() {}
+}
+
+// reportMessages groups the reports into one message per recipient list, each of
+// which records itself in the tab state once it is sent.
+func reportMessages(reports []*tabReport, now time.Time) []kindMessage {
+	groups := map[string][]*tabReport{}
+	for _, r := range reports {
+		key := strings.Join(r.to, ",")
+		groups[key] = append(groups[key], r)
+	}
+	keys := make([]string, 0, len(groups))
+	for key := range groups {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var msgs []kindMessage
+	for _, key := range keys {
+		rs := groups[key]
+		sort.Slice(rs, func(i, j int) bool {
+			if rs[i].Dashboard != rs[j].Dashboard {
+				return rs[i].Dashboard < rs[j].Dashboard
+			}
+			return rs[i].Tab < rs[j].Tab
+		})
+		msgs = append(msgs, kindMessage{
+			kind: reportKind,
+			msg: Message{
+				To:      rs[0].to,
+				Subject: reportSubject(rs, now),
+				Body:    reportText(rs),
+				HTML:    reportHTML(rs),
+			},
+			commit: func() {
+				for _, r := range rs {
+					r.state.LastReport = now
+				}
+			},
+		})
+	}
+	return msgs
+}
+
+func reportSubject(reports []*tabReport, now time.Time) string {
+	if len(reports) == 1 {
+		return fmt.Sprintf("[testgrid] Healthiness report for %s/%s (%s)", reports[0].Dashboard, reports[0].Tab, now.Format("2006-01-02"))
+	}
+	return fmt.Sprintf("[testgrid] Healthiness report for %s (%s)", plural(len(reports), "tab"), now.Format("2006-01-02"))
+}
+
+// flakyTests returns the tests with any flakiness, flakiest first.
+func flakyTests(health *summarypb.HealthinessInfo) []*summarypb.TestInfo {
+	var tests []*summarypb.TestInfo
+	for _, t := range health.GetTests() {
+		if t.Flakiness > 0 {
+			tests = append(tests, t)
+		}
+	}
+	sort.SliceStable(tests, func(i, j int) bool {
+		if tests[i].Flakiness != tests[j].Flakiness {
+			return tests[i].Flakiness > tests[j].Flakiness
+		}
+		return tests[i].DisplayName < tests[j].DisplayName
+	})
+	return tests
+}
+
+func trendText(trend summarypb.TestInfo_Trend) string {
+	switch trend {
+	case summarypb.TestInfo_UP:
+		return "up"
+	case summarypb.TestInfo_DOWN:
+		return "down"
+	case summarypb.TestInfo_NO_CHANGE:
+		return "no change"
+	default:
+		return "new"
+	}
+}
+
+func interval(health *summarypb.HealthinessInfo) string {
+	start, end := health.GetStart(), health.GetEnd()
+	if start == nil || end == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s to %s", start.AsTime().Format("2006-01-02"), end.AsTime().Format("2006-01-02"))
+}
+
+func previousFlakiness(health *summarypb.HealthinessInfo) string {
+	if prev := health.GetPreviousFlakiness(); len(prev) > 0 {
+		return fmt.Sprintf("%.1f%%", prev[0])
+	}
+	return "unknown"
+}
+
+func reportText(reports []*tabReport) string {
+	var b strings.Builder
+	for i, r := range reports {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "%s/%s\n", r.Dashboard, r.Tab)
+		if r.Health == nil {
+			b.WriteString("  No healthiness data\n")
+			continue
+		}
+		if iv := interval(r.Health); iv != "" {
+			fmt.Fprintf(&b, "  Interval: %s\n", iv)
+		}
+		fmt.Fprintf(&b, "  Average flakiness: %.1f%% (previously %s)\n", r.Health.AverageFlakiness, previousFlakiness(r.Health))
+		tests := flakyTests(r.Health)
+		if len(tests) == 0 {
+			b.WriteString("  No flaky tests\n")
+			continue
+		}
+		for _, t := range tests {
+			fmt.Fprintf(&b, "  %5.1f%% %-9s %s\n", t.Flakiness, trendText(t.ChangeFromLastInterval), t.DisplayName)
+		}
+	}
+	return b.String()
+}
+
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"flaky":    flakyTests,
+	"trend":    trendText,
+	"interval": interval,
+	"previous": previousFlakiness,
+	"percent":  func(f float32) string { return fmt.Sprintf("%.1f%%", f) },
+}).Parse(`<html><body>
+{{range .}}<h3>{{.Dashboard}}/{{.Tab}}</h3>
+{{if not .Health}}<p>No healthiness data</p>
+{{else}}<p>{{with interval .Health}}Interval: {{.}}<br>{{end}}Average flakiness: {{percent .Health.AverageFlakiness}} (previously {{previous .Health}})</p>
+{{with flaky .Health}}<table>
+<tr><th>Flakiness</th><th>Trend</th><th>Test</th><th>Passed</th><th>Failed</th></tr>
+{{range .}}<tr><td>{{percent .Flakiness}}</td><td>{{trend .ChangeFromLastInterval}}</td><td>{{.DisplayName}}</td><td>{{.PassedNonInfraRuns}}</td><td>{{.FailedNonInfraRuns}}</td></tr>
+{{end}}</table>
+{{else}}<p>No flaky tests</p>
+{{end}}{{end}}{{end}}</body></html>
+`))
+
+func reportHTML(reports []*tabReport) string {
+	var buf bytes.Buffer
+	if err := reportTemplate.Execute(&buf, reports); err != nil {
+		return ""
+	}
+	return buf.String()
+}
@@ -0,0 +1,252 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+	"github.com/golang/protobuf/proto"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func healthTab(name, schedule, recipients string) *configpb.DashboardTab {
+	return &configpb.DashboardTab{
+		Name: name,
+		HealthAnalysisOptions: &configpb.HealthAnalysisOptions{
+			Enable:          true,
+			EmailSchedule:   schedule,
+			EmailRecipients: recipients,
+		},
+	}
+}
+
+func TestDueReport(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name           string
+		tab            *configpb.DashboardTab
+		lastReport     time.Time
+		want           bool
+		wantLastReport time.Time
+	}{
+		{
+			name: "disabled",
+			tab: &configpb.DashboardTab{
+				Name: "tab",
+				HealthAnalysisOptions: &configpb.HealthAnalysisOptions{
+					EmailSchedule:   "@daily",
+					EmailRecipients: "a@example.com",
+				},
+			},
+			lastReport: now.Add(-48 * time.Hour),
+		},
+		{
+			name:       "no recipients",
+			tab:        healthTab("tab", "@daily", ""),
+			lastReport: now.Add(-48 * time.Hour),
+			// Keep the schedule in case recipients are added back.
+			wantLastReport: now.Add(-48 * time.Hour),
+		},
+		{
+			name:           "bad schedule",
+			tab:            healthTab("tab", "whenever", "a@example.com"),
+			lastReport:     now.Add(-48 * time.Hour),
+			wantLastReport: now.Add(-48 * time.Hour),
+		},
+		{
+			name:           "start schedule",
+			tab:            healthTab("tab", "@daily", "a@example.com"),
+			wantLastReport: now,
+		},
+		{
+			name:           "not yet",
+			tab:            healthTab("tab", "@daily", "a@example.com"),
+			lastReport:     now.Add(-time.Hour),
+			wantLastReport: now.Add(-time.Hour),
+		},
+		{
+			name:           "due",
+			tab:            healthTab("tab", "@daily", "a@example.com"),
+			lastReport:     now.Add(-13 * time.Hour),
+			want:           true,
+			wantLastReport: now.Add(-13 * time.Hour),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := TabState{LastReport: tc.lastReport}
+			got, schedule := dueReport(logrus.WithField("name", tc.name), "dash", tc.tab, &summarypb.DashboardTabSummary{}, &ts, now)
+			if !ts.LastReport.Equal(tc.lastReport) {
+				t.Errorf("dueReport() changed LastReport to %v before scheduling", ts.LastReport)
+			}
+			schedule()
+			if (got != nil) != tc.want {
+				t.Errorf("dueReport() got %v, want due=%t", got, tc.want)
+			}
+			if !ts.LastReport.Equal(tc.wantLastReport) {
+				t.Errorf("dueReport() got LastReport %v, want %v", ts.LastReport, tc.wantLastReport)
+			}
+		})
+	}
+}
+
+func TestReportMessages(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	health := &summarypb.HealthinessInfo{
+		AverageFlakiness:  20,
+		PreviousFlakiness: []float32{10},
+		Tests: []*summarypb.TestInfo{
+			{DisplayName: "stable"},
+			{DisplayName: "<flaky>", Flakiness: 20, ChangeFromLastInterval: summarypb.TestInfo_UP},
+			{DisplayName: "flakier", Flakiness: 40, ChangeFromLastInterval: summarypb.TestInfo_DOWN},
+		},
+	}
+	var states [3]TabState
+	reports := []*tabReport{
+		{Dashboard: "dash", Tab: "b", Health: health, to: []string{"a@example.com"}, state: &states[0]},
+		{Dashboard: "dash", Tab: "a", to: []string{"a@example.com"}, state: &states[1]},
+		{Dashboard: "other", Tab: "c", Health: health, to: []string{"b@example.com"}, state: &states[2]},
+	}
+	msgs := reportMessages(reports, now)
+	if len(msgs) != 2 {
+		t.Fatalf("reportMessages() got %d messages, want 2", len(msgs))
+	}
+
+	var gotTo [][]string
+	for _, m := range msgs {
+		gotTo = append(gotTo, m.msg.To)
+	}
+	if diff := cmp.Diff([][]string{{"a@example.com"}, {"b@example.com"}}, gotTo); diff != "" {
+		t.Errorf("reportMessages() got unexpected recipients (-want +got):\n%s", diff)
+	}
+
+	first := msgs[0].msg
+	if want := "[testgrid] Healthiness report for 2 tabs (2023-05-01)"; first.Subject != want {
+		t.Errorf("reportMessages() got subject %q, want %q", first.Subject, want)
+	}
+	wantText := "dash/a\n  No healthiness data\n\ndash/b\n" +
+		"  Average flakiness: 20.0% (previously 10.0%)\n" +
+		"   40.0% down      flakier\n" +
+		"   20.0% up        <flaky>\n"
+	if diff := cmp.Diff(wantText, first.Body); diff != "" {
+		t.Errorf("reportMessages() got unexpected text (-want +got):\n%s", diff)
+	}
+	for _, want := range []string{"<h3>dash/a</h3>", "<td>40.0%</td><td>down</td><td>flakier</td>", "&lt;flaky&gt;"} {
+		if !strings.Contains(first.HTML, want) {
+			t.Errorf("reportMessages() missing %q in HTML:\n%s", want, first.HTML)
+		}
+	}
+	if want := "[testgrid] Healthiness report for other/c (2023-05-01)"; msgs[1].msg.Subject != want {
+		t.Errorf("reportMessages() got subject %q, want %q", msgs[1].msg.Subject, want)
+	}
+
+	msgs[0].commit()
+	for i, want := range []time.Time{now, now, {}} {
+		if !states[i].LastReport.Equal(want) {
+			t.Errorf("commit() got state %d LastReport %v, want %v", i, states[i].LastReport, want)
+		}
+	}
+}
+
+func TestScheduledReports(t *testing.T) {
+	configPath := mustPath("gs://bucket/config")
+	cfg := &configpb.Configuration{
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name: "dash",
+				DashboardTab: []*configpb.DashboardTab{
+					healthTab("weekly", "0 9 * * mon", "a@example.com"),
+					healthTab("daily", "0 9 * * *", "a@example.com"),
+				},
+			},
+		},
+	}
+	sum := &summarypb.DashboardSummary{
+		TabSummaries: []*summarypb.DashboardTabSummary{
+			{DashboardName: "dash", DashboardTabName: "weekly", Healthiness: &summarypb.HealthinessInfo{}},
+			{DashboardName: "dash", DashboardTabName: "daily", Healthiness: &summarypb.HealthinessInfo{}},
+		},
+	}
+	summaryPath, err := summarizer.SummaryPath(configPath, "summary", "dash")
+	if err != nil {
+		t.Fatalf("SummaryPath(): %v", err)
+	}
+	buf, err := proto.Marshal(sum)
+	if err != nil {
+		t.Fatalf("Marshal(): %v", err)
+	}
+	client := fake.UploadClient{
+		Uploader: fake.Uploader{},
+		Client: fake.Client{
+			Opener: fake.Opener{
+				*summaryPath: {Data: string(buf)},
+			},
+		},
+		Stater: fake.Stater{},
+	}
+
+	// Sunday morning.
+	clock := &fakeClock{now: time.Date(2023, 4, 30, 8, 0, 0, 0, time.UTC)}
+	sender := &FakeSender{}
+	opts := &UpdateOptions{
+		ConfigPath:        configPath,
+		SummaryPathPrefix: "summary",
+		Confirm:           true,
+		Now:               clock.Now,
+	}
+	var state State
+	var got []string
+	for i := 0; i < 4*24; i++ {
+		alertDashboards(context.Background(), logrus.WithField("cycle", i), client, nil, sender, opts, cfg, &state, opts.Now())
+		for _, m := range sender.Messages {
+			got = append(got, clock.Now().Format("Mon 15:04")+" "+m.Subject)
+		}
+		sender.Messages = nil
+		clock.Advance(time.Hour)
+	}
+
+	want := []string{
+		"Sun 09:00 [testgrid] Healthiness report for dash/daily (2023-04-30)",
+		"Mon 09:00 [testgrid] Healthiness report for 2 tabs (2023-05-01)",
+		"Tue 09:00 [testgrid] Healthiness report for dash/daily (2023-05-02)",
+		"Wed 09:00 [testgrid] Healthiness report for dash/daily (2023-05-03)",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("alertDashboards() got unexpected reports (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSchedule(t *testing.T) {
+	cases := []struct {
+		spec    string
+		wantErr bool
+	}{
+		{spec: "* * * * *"},
+		{spec: "0 9 * * MON"},
+		{spec: "*/15 0-6,18 1,15 jan-jun 1-5"},
+		{spec: "@weekly"},
+		{spec: "", wantErr: true},
+		{spec: "* * * *", wantErr: true},
+		{spec: "60 * * * *", wantErr: true},
+		{spec: "* 24 * * *", wantErr: true},
+		{spec: "* * 0 * *", wantErr: true},
+		{spec: "* * * 13 *", wantErr: true},
+		{spec: "* * * * 8", wantErr: true},
+		{spec: "5-1 * * * *", wantErr: true},
+		{spec: "*/0 * * * *", wantErr: true},
+		{spec: "@sometimes", wantErr: true},
+	}
+	for _, tc := range cases {
+		_, err := ParseSchedule(tc.spec)
+		switch {
+		case err != nil && !tc.wantErr:
+			t.Errorf("ParseSchedule(%q) got unexpected error: %v", tc.spec, err)
+		case err == nil && tc.wantErr:
+			t.Errorf("ParseSchedule(%q) failed to return an error", tc.spec)
+		}
+	}
+}
+
+func TestScheduleNext(t *testing.T) {
+	// A Monday.
+	now := time.Date(2023, 5, 1, 12, 30, 45, 0, time.UTC)
+	cases := []struct {
+		spec string
+		from time.Time
+		want time.Time
+	}{
+		{
+			spec: "* * * * *",
+			from: now,
+			want: time.Date(2023, 5, 1, 12, 31, 0, 0, time.UTC),
+		},
+		{
+			spec: "@hourly",
+			from: now,
+			want: time.Date(2023, 5, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 9 * * mon",
+			from: now,
+			want: time.Date(2023, 5, 8, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 9 * * 7",
+			from: now,
+			want: time.Date(2023, 5, 7, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "*/20 13 * * *",
+			from: now,
+			want: time.Date(2023, 5, 1, 13, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 0 1 * *",
+			from: now,
+			want: time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 0 29 2 *",
+			from: now,
+			want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			// Either the 15th or a Friday.
+			spec: "0 0 15 * fri",
+			from: now,
+			want: time.Date(2023, 5, 5, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			spec: "0 0 31 2 *",
+			from: now,
+		},
+	}
+	for _, tc := range cases {
+		s, err := ParseSchedule(tc.spec)
+		if err != nil {
+			t.Fatalf("ParseSchedule(%q) got unexpected error: %v", tc.spec, err)
+		}
+		if got := s.Next(tc.from); !got.Equal(tc.want) {
+			t.Errorf("ParseSchedule(%q).Next(%v) got %v, want %v", tc.spec, tc.from, got, tc.want)
+		}
+	}
+}
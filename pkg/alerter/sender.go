@@ -0,0 +1,170 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/smtp"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Message is an email to send.
+type Message struct {
+	To      []string
+	Subject string
+	Body    string
+	// HTML is an optional alternative rendering of Body.
+	HTML string
+}
+
+// A Sender delivers messages.
+type Sender interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// SMTPSender sends messages through an SMTP server.
+type SMTPSender struct {
+	// Addr is the host:port of the server.
+	Addr string
+	// From is the envelope and header sender.
+	From string
+	// Auth is optional.
+	Auth smtp.Auth
+
+	send func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPSender returns a sender that uses the server at addr, authenticating
+// with PLAIN auth when a username is supplied.
+func NewSMTPSender(addr, from, username, password string) (*SMTPSender, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, fmt.Errorf("bad smtp address %q: %w", addr, err)
+	}
+	if from == "" {
+		return nil, errors.New("empty from address")
+	}
+	s := SMTPSender{
+		Addr: addr,
+		From: from,
+	}
+	if username != "" {
+		s.Auth = smtp.PlainAuth("", username, password, host)
+	}
+	return &s, nil
+}
+
+// Send the message through the SMTP server.
+func (s *SMTPSender) Send(ctx context.Context, msg Message) error {
+	if len(msg.To) == 0 {
+		return errors.New("no recipients")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	send := s.send
+	if send == nil {
+		send = smtp.SendMail
+	}
+	return send(s.Addr, s.Auth, s.From, msg.To, render(s.From, msg, time.Now()))
+}
+
+// render formats the message as RFC 822 bytes.
+func render(from string, msg Message, when time.Time) []byte {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(msg.To, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", msg.Subject)
+	fmt.Fprintf(&buf, "Date: %s\r\n", when.Format(time.RFC1123Z))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	if msg.HTML == "" {
+		buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+		buf.WriteString(msg.Body)
+		return buf.Bytes()
+	}
+	const boundary = "testgrid-alternative"
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", boundary)
+	fmt.Fprintf(&buf, "--%s\r\nContent-Type: text/plain; charset=UTF-8\r\n\r\n%s\r\n", boundary, msg.Body)
+	fmt.Fprintf(&buf, "--%s\r\nContent-Type: text/html; charset=UTF-8\r\n\r\n%s\r\n", boundary, msg.HTML)
+	fmt.Fprintf(&buf, "--%s--\r\n", boundary)
+	return buf.Bytes()
+}
+
+// WriterSender writes each message to a writer, for local testing.
+type WriterSender struct {
+	W    io.Writer
+	lock sync.Mutex
+}
+
+// NewFileSender returns a sender that appends messages to the file at path.
+//
+// Writes to stdout when path is "-".
+func NewFileSender(path string) (*WriterSender, error) {
+	if path == "-" {
+		return &WriterSender{W: os.Stdout}, nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	return &WriterSender{W: f}, nil
+}
+
+// Send writes the message.
+func (s *WriterSender) Send(_ context.Context, msg Message) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	_, err := fmt.Fprintf(s.W, "To: %s\nSubject: %s\n\n%s\n---\n", strings.Join(msg.To, ", "), msg.Subject, msg.Body)
+	return err
+}
+
+// FakeSender records each message it sends.
+type FakeSender struct {
+	Messages []Message
+	Err      error
+	lock     sync.Mutex
+}
+
+// Send records the message, or returns Err.
+func (s *FakeSender) Send(_ context.Context, msg Message) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.Err != nil {
+		return s.Err
+	}
+	s.Messages = append(s.Messages, msg)
+	return nil
+}
+
+// splitAddresses converts a comma-separated list into a slice of addresses.
+func splitAddresses(addresses string) []string {
+	var out []string
+	for _, a := range strings.Split(addresses, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			out = append(out, a)
+		}
+	}
+	return out
+}
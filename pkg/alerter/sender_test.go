@@ -0,0 +1,144 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"bytes"
+	"context"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRender(t *testing.T) {
+	when := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		name string
+		msg  Message
+		want []string
+	}{
+		{
+			name: "plain",
+			msg: Message{
+				To:      []string{"a@example.com", "b@example.com"},
+				Subject: "hello",
+				Body:    "world",
+			},
+			want: []string{
+				"From: testgrid@example.com\r\n",
+				"To: a@example.com, b@example.com\r\n",
+				"Subject: hello\r\n",
+				"Date: Mon, 01 May 2023 12:00:00 +0000\r\n",
+				"Content-Type: text/plain; charset=UTF-8\r\n\r\nworld",
+			},
+		},
+		{
+			name: "html",
+			msg: Message{
+				To:      []string{"a@example.com"},
+				Subject: "hello",
+				Body:    "world",
+				HTML:    "<b>world</b>",
+			},
+			want: []string{
+				"Content-Type: multipart/alternative;",
+				"Content-Type: text/plain; charset=UTF-8\r\n\r\nworld\r\n",
+				"Content-Type: text/html; charset=UTF-8\r\n\r\n<b>world</b>\r\n",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := string(render("testgrid@example.com", tc.msg, when))
+			for _, w := range tc.want {
+				if !strings.Contains(got, w) {
+					t.Errorf("render() missing %q in:\n%s", w, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSMTPSender(t *testing.T) {
+	s, err := NewSMTPSender("smtp.example.com:587", "testgrid@example.com", "user", "pass")
+	if err != nil {
+		t.Fatalf("NewSMTPSender() got unexpected error: %v", err)
+	}
+	var gotAddr, gotFrom string
+	var gotTo []string
+	s.send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo = addr, from, to
+		if a == nil {
+			t.Error("send() got nil auth")
+		}
+		return nil
+	}
+	if err := s.Send(context.Background(), Message{To: []string{"a@example.com"}, Subject: "hi"}); err != nil {
+		t.Fatalf("Send() got unexpected error: %v", err)
+	}
+	if gotAddr != "smtp.example.com:587" || gotFrom != "testgrid@example.com" {
+		t.Errorf("Send() got addr=%q from=%q", gotAddr, gotFrom)
+	}
+	if diff := cmp.Diff([]string{"a@example.com"}, gotTo); diff != "" {
+		t.Errorf("Send() got unexpected recipients (-want +got):\n%s", diff)
+	}
+	if err := s.Send(context.Background(), Message{}); err == nil {
+		t.Error("Send() failed to return an error without recipients")
+	}
+
+	if _, err := NewSMTPSender("no-port", "testgrid@example.com", "", ""); err == nil {
+		t.Error("NewSMTPSender() failed to return an error for a bad address")
+	}
+}
+
+func TestWriterSender(t *testing.T) {
+	var buf bytes.Buffer
+	s := WriterSender{W: &buf}
+	if err := s.Send(context.Background(), Message{To: []string{"a@example.com"}, Subject: "hi", Body: "there"}); err != nil {
+		t.Fatalf("Send() got unexpected error: %v", err)
+	}
+	want := "To: a@example.com\nSubject: hi\n\nthere\n---\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Send() wrote %q, want %q", got, want)
+	}
+}
+
+func TestSplitAddresses(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+	}{
+		{},
+		{
+			in:   "a@example.com",
+			want: []string{"a@example.com"},
+		},
+		{
+			in:   " a@example.com,, b@example.com ",
+			want: []string{"a@example.com", "b@example.com"},
+		},
+	}
+	for _, tc := range cases {
+		if diff := cmp.Diff(tc.want, splitAddresses(tc.in)); diff != "" {
+			t.Errorf("splitAddresses(%q) got unexpected diff (-want +got):\n%s", tc.in, diff)
+		}
+	}
+}
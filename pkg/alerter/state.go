@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// State records which alerts have been emailed, so restarts do not resend them.
+type State struct {
+	// Tabs maps a tabKey() to the state of that tab.
+	Tabs map[string]*TabState `json:"tabs,omitempty"`
+}
+
+// TabState records the alerts emailed for a dashboard tab.
+type TabState struct {
+	// LastEmail is when a failure email was last sent for this tab.
+	LastEmail time.Time `json:"last_email,omitempty"`
+	// Failures maps each failing test that has been emailed to the outage it belongs to.
+	Failures map[string]Failure `json:"failures,omitempty"`
+	// LastFlakinessEmail is when a flakiness email was last sent for this tab.
+	LastFlakinessEmail time.Time `json:"last_flakiness_email,omitempty"`
+	// Flaky maps each test emailed for exceeding the flakiness threshold to its alert.
+	Flaky map[string]Flaky `json:"flaky,omitempty"`
+	// LastReport is when the healthiness report schedule last fired for this tab.
+	LastReport time.Time `json:"last_report,omitempty"`
+	// Regressions maps each emailed metric regression to its alert.
+	Regressions map[string]Regression `json:"regressions,omitempty"`
+}
+
+// Failure is an emailed alert.
+type Failure struct {
+	// FailBuildID identifies the first failure of the outage.
+	FailBuildID string `json:"fail_build_id,omitempty"`
+	// To is who received the failure email, and should receive the recovery.
+	To []string `json:"to,omitempty"`
+	// Emailed is when the failure email was sent.
+	Emailed time.Time `json:"emailed,omitempty"`
+}
+
+// Flaky is an emailed flakiness alert.
+type Flaky struct {
+	// Flakiness is the percentage reported in the email.
+	Flakiness float32 `json:"flakiness,omitempty"`
+	// Emailed is when the flakiness email was sent.
+	Emailed time.Time `json:"emailed,omitempty"`
+}
+
+// Regression is an emailed metric regression.
+type Regression struct {
+	// BuildID is the first build after the step change.
+	BuildID string `json:"build_id,omitempty"`
+	// Emailed is when the regression email was sent.
+	Emailed time.Time `json:"emailed,omitempty"`
+}
+
+func tabKey(dashboard, tab string) string {
+	return dashboard + "/" + tab
+}
+
+// Tab returns the state for the tab, creating it if necessary.
+func (s *State) Tab(dashboard, tab string) *TabState {
+	if s.Tabs == nil {
+		s.Tabs = map[string]*TabState{}
+	}
+	key := tabKey(dashboard, tab)
+	ts, ok := s.Tabs[key]
+	if !ok {
+		ts = &TabState{}
+		s.Tabs[key] = ts
+	}
+	if ts.Failures == nil {
+		ts.Failures = map[string]Failure{}
+	}
+	if ts.Flaky == nil {
+		ts.Flaky = map[string]Flaky{}
+	}
+	if ts.Regressions == nil {
+		ts.Regressions = map[string]Regression{}
+	}
+	return ts
+}
+
+// Prune drops state for tabs not in keep.
+func (s *State) Prune(keep map[string]bool) {
+	for key := range s.Tabs {
+		if !keep[key] {
+			delete(s.Tabs, key)
+		}
+	}
+}
+
+// ReadState loads the state at path.
+//
+// Returns an empty state if the object does not exist.
+func ReadState(ctx context.Context, client gcs.Opener, path gcs.Path) (*State, error) {
+	var s State
+	if err := readJSON(ctx, client, path, &s); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+// WriteState saves the state to path.
+func WriteState(ctx context.Context, client gcs.Uploader, path gcs.Path, s *State) error {
+	return writeJSON(ctx, client, path, s)
+}
+
+// readJSON decodes the object at path into v, leaving it untouched if the object does not exist.
+func readJSON(ctx context.Context, client gcs.Opener, path gcs.Path, v interface{}) error {
+	r, _, err := client.Open(ctx, path)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("open: %w", err)
+	}
+	defer r.Close()
+	if err := json.NewDecoder(r).Decode(v); err != nil {
+		return fmt.Errorf("decode: %w", err)
+	}
+	return nil
+}
+
+// writeJSON encodes v into the object at path.
+func writeJSON(ctx context.Context, client gcs.Uploader, path gcs.Path, v interface{}) error {
+	buf, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+	if _, err := client.Upload(ctx, path, buf, gcs.DefaultACL, gcs.NoCache); err != nil {
+		return fmt.Errorf("upload: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alerter
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestState(t *testing.T) {
+	path := mustPath("gs://bucket/alerter-state.json")
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	state := &State{
+		Tabs: map[string]*TabState{
+			"dash/tab": {
+				LastEmail: now,
+				Failures: map[string]Failure{
+					"foo": {FailBuildID: "10", To: []string{"a@example.com"}, Emailed: now},
+				},
+			},
+		},
+	}
+
+	ctx := context.Background()
+	uploader := fake.Uploader{}
+	if err := WriteState(ctx, uploader, path, state); err != nil {
+		t.Fatalf("WriteState() got unexpected error: %v", err)
+	}
+	opener := fake.Opener{
+		path: {Data: string(uploader[path].Buf)},
+	}
+	got, err := ReadState(ctx, opener, path)
+	if err != nil {
+		t.Fatalf("ReadState() got unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(state, got); diff != "" {
+		t.Errorf("ReadState() got unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestReadState(t *testing.T) {
+	path := mustPath("gs://bucket/alerter-state.json")
+	cases := []struct {
+		name    string
+		opener  fake.Opener
+		want    *State
+		wantErr bool
+	}{
+		{
+			name:   "missing",
+			opener: fake.Opener{},
+			want:   &State{},
+		},
+		{
+			name: "open error",
+			opener: fake.Opener{
+				path: {OpenErr: errors.New("injected")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "corrupt",
+			opener: fake.Opener{
+				path: {Data: "{not json"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ReadState(context.Background(), tc.opener, path)
+			switch {
+			case err != nil:
+				if !tc.wantErr {
+					t.Errorf("ReadState() got unexpected error: %v", err)
+				}
+			case tc.wantErr:
+				t.Errorf("ReadState() failed to return an error")
+			default:
+				if diff := cmp.Diff(tc.want, got); diff != "" {
+					t.Errorf("ReadState() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestPrune(t *testing.T) {
+	s := State{
+		Tabs: map[string]*TabState{
+			"keep/tab":  {},
+			"drop/tab":  {},
+			"keep/drop": {},
+		},
+	}
+	s.Prune(map[string]bool{"keep/tab": true})
+	want := State{
+		Tabs: map[string]*TabState{
+			"keep/tab": {},
+		},
+	}
+	if diff := cmp.Diff(want, s); diff != "" {
+		t.Errorf("Prune() got unexpected diff (-want +got):\n%s", diff)
+	}
+}
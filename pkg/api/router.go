@@ -41,6 +41,7 @@ type RouterOptions struct {
 	SummaryPathPrefix        string
 	AccessControlAllowOrigin string
 	Timeout                  time.Duration
+	IngestToken              string
 }
 
 const v1InfixRef = "/api/v1"
@@ -64,6 +65,9 @@ func GetRouters(options RouterOptions, storageClient *storage.Client) (http.Hand
 	grpcOptions := []grpc.ServerOption{}
 	grpcServer := grpc.NewServer(grpcOptions...)
 	v1pb.RegisterTestGridDataServer(grpcServer, server)
+	if server.IngestToken != "" {
+		v1pb.RegisterTestGridIngestServer(grpcServer, server)
+	}
 	reflection.Register(grpcServer)
 
 	return router, grpcServer, nil
@@ -87,5 +91,6 @@ func GetServer(options RouterOptions, storageClient *storage.Client) (*v1.Server
 		SummaryPathPrefix:        options.SummaryPathPrefix,
 		AccessControlAllowOrigin: options.AccessControlAllowOrigin,
 		Timeout:                  options.Timeout,
+		IngestToken:              options.IngestToken,
 	}, nil
 }
@@ -0,0 +1,250 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/go-chi/chi"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	apipb "github.com/GoogleCloudPlatform/testgrid/pb/api/v1"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/updater"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// Ensure the server implementation conforms to the API
+var _ apipb.TestGridIngestServer = (*Server)(nil)
+
+var (
+	// errInvalidColumn wraps errors that reject the column itself.
+	errInvalidColumn = errors.New("invalid column")
+	// errUnauthenticated means the caller did not present the ingest token.
+	errUnauthenticated = errors.New("unauthenticated")
+	// errIngestDenied wraps errors that refuse to ingest results into the group.
+	errIngestDenied = errors.New("permission denied")
+	// errGroupNotFound means the configuration lacks the test group.
+	errGroupNotFound = errors.New("test group not found")
+)
+
+// authorizeIngest ensures the caller presents the server's ingest token,
+// as an "authorization: Bearer <token>" header or gRPC metadata.
+func (s *Server) authorizeIngest(ctx context.Context) error {
+	if s.IngestToken == "" {
+		return fmt.Errorf("%w: ingesting results is disabled", errIngestDenied)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	want := []byte("Bearer " + s.IngestToken)
+	for _, got := range md.Get("authorization") {
+		if subtle.ConstantTimeCompare([]byte(got), want) == 1 {
+			return nil
+		}
+	}
+	return errUnauthenticated
+}
+
+// findIngestGroup locates a test group that accepts pushed results.
+func findIngestGroup(cfg *cachedConfig, groupInput string) (*configpb.TestGroup, error) {
+	if cfg == nil || cfg.Config == nil {
+		return nil, errors.New("empty config")
+	}
+	groupName, ok := cfg.NormalTestGroup[config.Normalize(groupInput)]
+	if !ok {
+		return nil, fmt.Errorf("%w: {%q}", errGroupNotFound, groupInput)
+	}
+	tg := cfg.Config.Groups[groupName]
+	ingestCfg := tg.GetResultSource().GetIngestConfig()
+	if ingestCfg == nil {
+		return nil, fmt.Errorf("%w: test group {%q} does not accept ingested results", errIngestDenied, groupName)
+	}
+	// Only pubsub notifies the updater of the stored column.
+	if ingestCfg.GetPubsubProject() == "" || ingestCfg.GetPubsubSubscription() == "" {
+		return nil, fmt.Errorf("%w: test group {%q} does not notify the updater of ingested results", errIngestDenied, groupName)
+	}
+	return tg, nil
+}
+
+// validateIngestedColumn ensures the column can become part of the group's grid.
+func validateIngestedColumn(tg *configpb.TestGroup, col *statepb.IngestedColumn) error {
+	if col == nil {
+		return errors.New("missing column")
+	}
+	if col.GetBuild() == "" {
+		return errors.New("missing build")
+	}
+	if strings.Contains(col.GetBuild(), "/") {
+		return fmt.Errorf("build %q must not contain /", col.GetBuild())
+	}
+	if col.GetStarted() == nil {
+		return errors.New("missing started")
+	}
+	if err := col.GetStarted().CheckValid(); err != nil {
+		return fmt.Errorf("started: %w", err)
+	}
+	if col.GetStarted().GetSeconds() <= 0 {
+		return errors.New("started must be after the epoch")
+	}
+	if finished := col.GetFinished(); finished != nil {
+		if err := finished.CheckValid(); err != nil {
+			return fmt.Errorf("finished: %w", err)
+		}
+		if finished.AsTime().Before(col.GetStarted().AsTime()) {
+			return errors.New("finished must not be before started")
+		}
+		if key := tg.GetBuildOverrideConfigurationValue(); key != "" && col.GetMetadata()[key] == "" {
+			return fmt.Errorf("missing metadata for build override %q", key)
+		}
+	}
+	for _, h := range tg.GetColumnHeader() {
+		if key := h.GetConfigurationValue(); key != "" {
+			if _, ok := col.GetMetadata()[key]; !ok {
+				return fmt.Errorf("missing metadata for column header %q", key)
+			}
+		}
+	}
+	if _, err := junit.Parse(col.GetJunit()); err != nil {
+		return fmt.Errorf("junit: %w", err)
+	}
+	names := make(map[string]bool, len(col.GetCells()))
+	for i, cell := range col.GetCells() {
+		name := cell.GetName()
+		if name == "" {
+			return fmt.Errorf("cell %d: missing name", i)
+		}
+		if names[name] {
+			return fmt.Errorf("cell %d: duplicate name %q", i, name)
+		}
+		names[name] = true
+		if cell.GetResult() == statuspb.TestStatus_NO_RESULT {
+			return fmt.Errorf("cell %q: missing result", name)
+		}
+	}
+	return nil
+}
+
+// IngestResults stores a column of results for the updater to add to the test group.
+//
+// Requires the server's ingest token, and only ingests into the groups of the
+// server's default bucket, whose configuration determines where columns are stored.
+func (s *Server) IngestResults(ctx context.Context, req *apipb.IngestResultsRequest) (*apipb.IngestResultsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	if err := s.authorizeIngest(ctx); err != nil {
+		return nil, err
+	}
+	if s.DefaultBucket == "" {
+		return nil, fmt.Errorf("%w: server lacks a default scope", errIngestDenied)
+	}
+	if scope := req.GetScope(); scope != "" && scope != s.DefaultBucket {
+		return nil, fmt.Errorf("%w: only ingests results into %s", errIngestDenied, s.DefaultBucket)
+	}
+	cfg, err := s.getConfig(ctx, logrus.WithContext(ctx), "")
+	if err != nil {
+		return nil, err
+	}
+	cfg.Mutex.RLock()
+	tg, err := findIngestGroup(cfg, req.GetTestGroup())
+	cfg.Mutex.RUnlock()
+	if err != nil {
+		return nil, err
+	}
+
+	col := req.GetColumn()
+	if err := validateIngestedColumn(tg, col); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidColumn, err)
+	}
+
+	id := updater.IngestedColumnID(col)
+	path, err := updater.IngestedColumnPath(tg, id)
+	if err != nil {
+		return nil, fmt.Errorf("ingest path: %w", err)
+	}
+	buf, err := proto.Marshal(col)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+	// GCS notifies the updater through the group's subscription, which adds the column ~immediately.
+	if _, err := s.Client.Upload(ctx, *path, buf, gcs.DefaultACL, gcs.NoCache); err != nil {
+		return nil, fmt.Errorf("upload %s: %w", path, err)
+	}
+	return &apipb.IngestResultsResponse{Id: id}, nil
+}
+
+// maxIngestBytes limits the size of an IngestResultsHTTP request body.
+const maxIngestBytes = 10e6
+
+// IngestResultsHTTP stores a column of results for the updater to add to the test group.
+// Request header: Authorization: Bearer <token>
+// Request json: IngestedColumn
+// Response json: IngestResultsResponse
+func (s Server) IngestResultsHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxIngestBytes))
+	if err != nil && len(body) >= maxIngestBytes {
+		http.Error(w, fmt.Sprintf("request body exceeds %d bytes", int(maxIngestBytes)), http.StatusRequestEntityTooLarge)
+		return
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var col statepb.IngestedColumn
+	if err := protojson.Unmarshal(body, &col); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	req := apipb.IngestResultsRequest{
+		Scope:     r.URL.Query().Get(scopeParam),
+		TestGroup: chi.URLParam(r, "test-group"),
+		Column:    &col,
+	}
+	ctx := metadata.NewIncomingContext(r.Context(), metadata.Pairs("authorization", r.Header.Get("Authorization")))
+	resp, err := s.IngestResults(ctx, &req)
+	switch {
+	case errors.Is(err, errInvalidColumn):
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case errors.Is(err, errUnauthenticated):
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	case errors.Is(err, errIngestDenied):
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	case errors.Is(err, errGroupNotFound):
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	s.writeJSON(w, resp)
+}
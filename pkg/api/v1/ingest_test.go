@@ -0,0 +1,514 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apipb "github.com/GoogleCloudPlatform/testgrid/pb/api/v1"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func ingestConfig() map[string]*configpb.Configuration {
+	return map[string]*configpb.Configuration{
+		"gs://default/config": {
+			TestGroups: []*configpb.TestGroup{
+				{
+					Name: "pushed-group",
+					ColumnHeader: []*configpb.TestGroup_ColumnHeader{
+						{ConfigurationValue: "Commit"},
+					},
+					ResultSource: &configpb.TestGroup_ResultSource{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+							IngestConfig: &configpb.IngestConfig{
+								GcsPrefix:          "results-bucket/pushed/",
+								PubsubProject:      "project",
+								PubsubSubscription: "sub",
+							},
+						},
+					},
+				},
+				{
+					Name: "unnotified-group",
+					ResultSource: &configpb.TestGroup_ResultSource{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+							IngestConfig: &configpb.IngestConfig{
+								GcsPrefix: "results-bucket/unnotified/",
+							},
+						},
+					},
+				},
+				{
+					Name:      "polled-group",
+					GcsPrefix: "logs-bucket/polled",
+				},
+			},
+		},
+	}
+}
+
+func TestValidateIngestedColumn(t *testing.T) {
+	group := ingestConfig()["gs://default/config"].TestGroups[0]
+	cases := []struct {
+		name string
+		col  *statepb.IngestedColumn
+		err  bool
+	}{
+		{
+			name: "nil column",
+			err:  true,
+		},
+		{
+			name: "running column",
+			col: &statepb.IngestedColumn{
+				Build:    "10",
+				Started:  timestamppb.New(time.Unix(100, 0)),
+				Metadata: map[string]string{"Commit": "abc"},
+			},
+		},
+		{
+			name: "running without header metadata",
+			col: &statepb.IngestedColumn{
+				Build:   "10",
+				Started: timestamppb.New(time.Unix(100, 0)),
+			},
+			err: true,
+		},
+		{
+			name: "finished column with cells",
+			col: &statepb.IngestedColumn{
+				Build:    "10",
+				Started:  timestamppb.New(time.Unix(100, 0)),
+				Finished: timestamppb.New(time.Unix(200, 0)),
+				Metadata: map[string]string{"Commit": "abc"},
+				Junit:    []byte(`<testsuite><testcase name="hello"/></testsuite>`),
+				Cells: []*statepb.IngestedColumn_Cell{
+					{Name: "world", Result: statuspb.TestStatus_PASS},
+				},
+			},
+		},
+		{
+			name: "missing build",
+			col: &statepb.IngestedColumn{
+				Started: timestamppb.New(time.Unix(100, 0)),
+			},
+			err: true,
+		},
+		{
+			name: "build with slash",
+			col: &statepb.IngestedColumn{
+				Build:   "a/b",
+				Started: timestamppb.New(time.Unix(100, 0)),
+			},
+			err: true,
+		},
+		{
+			name: "missing started",
+			col: &statepb.IngestedColumn{
+				Build: "10",
+			},
+			err: true,
+		},
+		{
+			name: "finished before started",
+			col: &statepb.IngestedColumn{
+				Build:    "10",
+				Started:  timestamppb.New(time.Unix(100, 0)),
+				Finished: timestamppb.New(time.Unix(50, 0)),
+				Metadata: map[string]string{"Commit": "abc"},
+			},
+			err: true,
+		},
+		{
+			name: "finished without header metadata",
+			col: &statepb.IngestedColumn{
+				Build:    "10",
+				Started:  timestamppb.New(time.Unix(100, 0)),
+				Finished: timestamppb.New(time.Unix(200, 0)),
+			},
+			err: true,
+		},
+		{
+			name: "malformed junit",
+			col: &statepb.IngestedColumn{
+				Build:   "10",
+				Started: timestamppb.New(time.Unix(100, 0)),
+				Junit:   []byte("<testsuite><testcase"),
+			},
+			err: true,
+		},
+		{
+			name: "unnamed cell",
+			col: &statepb.IngestedColumn{
+				Build:   "10",
+				Started: timestamppb.New(time.Unix(100, 0)),
+				Cells: []*statepb.IngestedColumn_Cell{
+					{Result: statuspb.TestStatus_PASS},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "duplicate cells",
+			col: &statepb.IngestedColumn{
+				Build:   "10",
+				Started: timestamppb.New(time.Unix(100, 0)),
+				Cells: []*statepb.IngestedColumn_Cell{
+					{Name: "hello", Result: statuspb.TestStatus_PASS},
+					{Name: "hello", Result: statuspb.TestStatus_FAIL},
+				},
+			},
+			err: true,
+		},
+		{
+			name: "cell without result",
+			col: &statepb.IngestedColumn{
+				Build:   "10",
+				Started: timestamppb.New(time.Unix(100, 0)),
+				Cells: []*statepb.IngestedColumn_Cell{
+					{Name: "hello"},
+				},
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateIngestedColumn(group, tc.col)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("validateIngestedColumn() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("validateIngestedColumn() failed to receive an error")
+			}
+		})
+	}
+}
+
+func TestIngestResults(t *testing.T) {
+	col := &statepb.IngestedColumn{
+		Build:    "10",
+		Started:  timestamppb.New(time.Unix(1600000000, 0)),
+		Metadata: map[string]string{"Commit": "abc"},
+		Cells: []*statepb.IngestedColumn_Cell{
+			{Name: "hello", Result: statuspb.TestStatus_PASS},
+		},
+	}
+	cases := []struct {
+		name     string
+		token    string
+		auth     string
+		configs  map[string]*configpb.Configuration
+		req      *apipb.IngestResultsRequest
+		want     *apipb.IngestResultsResponse
+		wantPath string
+		err      bool
+	}{
+		{
+			name: "stores column",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "pushed-group",
+				Column:    col,
+			},
+			want: &apipb.IngestResultsResponse{
+				Id: "1600000000-10",
+			},
+			wantPath: "gs://results-bucket/pushed/1600000000-10.column.pb",
+		},
+		{
+			name: "normalizes group name",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "PushedGroup",
+				Column:    col,
+			},
+			want: &apipb.IngestResultsResponse{
+				Id: "1600000000-10",
+			},
+			wantPath: "gs://results-bucket/pushed/1600000000-10.column.pb",
+		},
+		{
+			name: "home scope",
+			req: &apipb.IngestResultsRequest{
+				Scope:     "gs://default",
+				TestGroup: "pushed-group",
+				Column:    col,
+			},
+			want: &apipb.IngestResultsResponse{
+				Id: "1600000000-10",
+			},
+			wantPath: "gs://results-bucket/pushed/1600000000-10.column.pb",
+		},
+		{
+			name: "other scope",
+			req: &apipb.IngestResultsRequest{
+				Scope:     "gs://other",
+				TestGroup: "pushed-group",
+				Column:    col,
+			},
+			err: true,
+		},
+		{
+			name:  "ingest disabled",
+			token: "-",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "pushed-group",
+				Column:    col,
+			},
+			err: true,
+		},
+		{
+			name: "missing token",
+			auth: "-",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "pushed-group",
+				Column:    col,
+			},
+			err: true,
+		},
+		{
+			name: "wrong token",
+			auth: "Bearer wrong",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "pushed-group",
+				Column:    col,
+			},
+			err: true,
+		},
+		{
+			name:    "missing config",
+			configs: map[string]*configpb.Configuration{},
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "pushed-group",
+				Column:    col,
+			},
+			err: true,
+		},
+		{
+			name: "missing group",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "missing",
+				Column:    col,
+			},
+			err: true,
+		},
+		{
+			name: "group without ingest config",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "polled-group",
+				Column:    col,
+			},
+			err: true,
+		},
+		{
+			name: "group without notification",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "unnotified-group",
+				Column:    col,
+			},
+			err: true,
+		},
+		{
+			name: "invalid column",
+			req: &apipb.IngestResultsRequest{
+				TestGroup: "pushed-group",
+				Column:    &statepb.IngestedColumn{Build: "10"},
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			configs := tc.configs
+			if configs == nil {
+				configs = ingestConfig()
+			}
+			server := setupTestServer(t, configs, nil, nil)
+			server.IngestToken = "secret"
+			if tc.token == "-" {
+				server.IngestToken = ""
+			}
+			ctx := context.Background()
+			switch tc.auth {
+			case "":
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer secret"))
+			case "-":
+			default:
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", tc.auth))
+			}
+			got, err := server.IngestResults(ctx, tc.req)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("IngestResults() got unexpected error: %v", err)
+				}
+				return
+			case tc.err:
+				t.Fatal("IngestResults() failed to receive an error")
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("IngestResults() got unexpected diff (-want +got):\n%s", diff)
+			}
+			path, err := gcs.NewPath(tc.wantPath)
+			if err != nil {
+				t.Fatalf("bad path: %v", err)
+			}
+			buf, ok := server.Client.(fakeClient).Datastore[*path]
+			if !ok {
+				t.Fatalf("IngestResults() did not write to %s", tc.wantPath)
+			}
+			var stored statepb.IngestedColumn
+			if err := proto.Unmarshal(buf, &stored); err != nil {
+				t.Fatalf("IngestResults() wrote a bad column: %v", err)
+			}
+			if diff := cmp.Diff(tc.req.Column, &stored, protocmp.Transform()); diff != "" {
+				t.Errorf("IngestResults() stored unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIngestResultsHTTP(t *testing.T) {
+	cases := []struct {
+		name         string
+		token        string
+		auth         string
+		configs      map[string]*configpb.Configuration
+		endpoint     string
+		body         string
+		expectedCode int
+	}{
+		{
+			name:         "stores column",
+			endpoint:     "/test-groups/pushed-group/results",
+			body:         `{"build": "10", "started": "2020-09-13T12:26:40Z", "metadata": {"Commit": "abc"}, "cells": [{"name": "hello", "result": "PASS"}]}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			name:         "body too large",
+			endpoint:     "/test-groups/pushed-group/results",
+			body:         `{"build": "` + strings.Repeat("1", maxIngestBytes) + `"}`,
+			expectedCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:         "malformed json",
+			endpoint:     "/test-groups/pushed-group/results",
+			body:         `{"build": `,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "invalid column",
+			endpoint:     "/test-groups/pushed-group/results",
+			body:         `{"build": "10"}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name:         "missing group",
+			endpoint:     "/test-groups/missing/results",
+			body:         `{"build": "10", "started": "2020-09-13T12:26:40Z"}`,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "group without ingest config",
+			endpoint:     "/test-groups/polled-group/results",
+			body:         `{"build": "10", "started": "2020-09-13T12:26:40Z"}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "other scope",
+			endpoint:     "/test-groups/pushed-group/results?scope=gs://other",
+			body:         `{"build": "10", "started": "2020-09-13T12:26:40Z", "metadata": {"Commit": "abc"}}`,
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name:         "missing token",
+			auth:         "-",
+			endpoint:     "/test-groups/pushed-group/results",
+			body:         `{"build": "10", "started": "2020-09-13T12:26:40Z", "metadata": {"Commit": "abc"}}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "wrong token",
+			auth:         "Bearer wrong",
+			endpoint:     "/test-groups/pushed-group/results",
+			body:         `{"build": "10", "started": "2020-09-13T12:26:40Z", "metadata": {"Commit": "abc"}}`,
+			expectedCode: http.StatusUnauthorized,
+		},
+		{
+			name:         "ingest disabled",
+			token:        "-",
+			endpoint:     "/test-groups/pushed-group/results",
+			body:         `{"build": "10", "started": "2020-09-13T12:26:40Z", "metadata": {"Commit": "abc"}}`,
+			expectedCode: http.StatusNotFound,
+		},
+		{
+			name:         "missing config",
+			configs:      map[string]*configpb.Configuration{},
+			endpoint:     "/test-groups/pushed-group/results",
+			body:         `{"build": "10", "started": "2020-09-13T12:26:40Z", "metadata": {"Commit": "abc"}}`,
+			expectedCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			configs := tc.configs
+			if configs == nil {
+				configs = ingestConfig()
+			}
+			server := setupTestServer(t, configs, nil, nil)
+			server.IngestToken = "secret"
+			if tc.token == "-" {
+				server.IngestToken = ""
+			}
+			router := Route(nil, server)
+			request, err := http.NewRequest("POST", tc.endpoint, strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatalf("Can't form request: %v", err)
+			}
+			switch tc.auth {
+			case "":
+				request.Header.Set("Authorization", "Bearer secret")
+			case "-":
+			default:
+				request.Header.Set("Authorization", tc.auth)
+			}
+			response := httptest.NewRecorder()
+			router.ServeHTTP(response, request)
+			if response.Code != tc.expectedCode {
+				t.Errorf("Expected %d, but got %d: %s", tc.expectedCode, response.Code, response.Body.String())
+			}
+		})
+	}
+}
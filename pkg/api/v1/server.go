@@ -33,7 +33,10 @@ type Server struct {
 	SummaryPathPrefix        string
 	AccessControlAllowOrigin string
 	Timeout                  time.Duration
-	defaultCache             *cachedConfig
+	// IngestToken authorizes callers to ingest results into the DefaultBucket's
+	// test groups, which the server refuses when empty.
+	IngestToken  string
+	defaultCache *cachedConfig
 }
 
 // Ensure the server implementation conforms to the API
@@ -53,11 +56,17 @@ func Route(r *chi.Mux, s Server) *chi.Mux {
 
 	r.Get("/dashboards/{dashboard}/tabs/{tab}/headers", s.ListHeadersHTTP)
 	r.Get("/dashboards/{dashboard}/tabs/{tab}/rows", s.ListRowsHTTP)
+	r.Get("/dashboards/{dashboard}/tabs/{tab}/clusters", s.ListClustersHTTP)
+	r.Get("/dashboards/{dashboard}/tabs/{tab}/update-info", s.ListUpdateInfoHTTP)
 
 	r.Get("/dashboards/{dashboard}/tab-summaries", s.ListTabSummariesHTTP)
 	r.Get("/dashboards/{dashboard}/tab-summaries/{tab}", s.GetTabSummaryHTTP)
 
 	r.Get("/dashboard-groups/{dashboard-group}/dashboard-summaries", s.ListDashboardSummariesHTTP)
 	r.Get("/dashboards/{dashboard}/summary", s.GetDashboardSummaryHTTP)
+
+	if s.IngestToken != "" {
+		r.Post("/test-groups/{test-group}/results", s.IngestResultsHTTP)
+	}
 	return r
 }
@@ -109,7 +109,8 @@ func (f fakeClient) Open(ctx context.Context, path gcs.Path) (io.ReadCloser, *st
 }
 
 func (f fakeClient) Upload(ctx context.Context, path gcs.Path, bytes []byte, b bool, s string) (*storage.ObjectAttrs, error) {
-	panic("fakeClient Upload not implemented")
+	f.Datastore[path] = bytes
+	return &storage.ObjectAttrs{}, nil
 }
 
 func (f fakeClient) Objects(ctx context.Context, prefix gcs.Path, delimiter, start string) gcs.Iterator {
@@ -181,16 +181,19 @@ func (s *Server) ListRows(ctx context.Context, req *apipb.ListRowsRequest) (*api
 	}
 
 	dashboardTabResponse := apipb.ListRowsResponse{
-		Rows: make([]*apipb.ListRowsResponse_Row, 0, len(grid.Rows)),
+		Rows:         make([]*apipb.ListRowsResponse_Row, 0, len(grid.Rows)),
+		TabularNames: grid.TabularNames,
 	}
 	for _, gRow := range grid.Rows {
 		gRowDecodedResults := decodeRLE(gRow.Results)
 		cellsCount := len(gRowDecodedResults)
 		row := apipb.ListRowsResponse_Row{
-			Name:   gRow.Name,
-			Issues: gRow.Issues,
-			Alert:  gRow.AlertInfo,
-			Cells:  make([]*apipb.ListRowsResponse_Cell, 0, cellsCount),
+			Name:          gRow.Name,
+			Issues:        gRow.Issues,
+			Alert:         gRow.AlertInfo,
+			Cells:         make([]*apipb.ListRowsResponse_Cell, 0, cellsCount),
+			TabularValues: gRow.TabularValues,
+			Group:         gRow.Group,
 		}
 		var filledIdx int
 		// loop through CellIds, Messages, Icons slices and build cell struct objects
@@ -210,6 +213,9 @@ func (s *Server) ListRows(ctx context.Context, req *apipb.ListRowsRequest) (*api
 				if len(gRow.Icons) != 0 {
 					cell.Icon = gRow.Icons[filledIdx]
 				}
+				if filledIdx < len(gRow.Properties) {
+					cell.Properties = gRow.Properties[filledIdx].GetProperty()
+				}
 				filledIdx++
 			}
 			row.Cells = append(row.Cells, &cell)
@@ -235,3 +241,125 @@ func (s Server) ListRowsHTTP(w http.ResponseWriter, r *http.Request) {
 
 	s.writeJSON(w, resp)
 }
+
+// ListClusters returns the clusters of similar failures in a dashboard tab
+func (s *Server) ListClusters(ctx context.Context, req *apipb.ListClustersRequest) (*apipb.ListClustersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	cfg, err := s.getConfig(ctx, logrus.WithContext(ctx), req.GetScope())
+	if err != nil {
+		return nil, err
+	}
+	cfg.Mutex.RLock()
+	defer cfg.Mutex.RUnlock()
+
+	dashboardName, tabName, testGroupName, err := findDashboardTab(cfg, req.GetDashboard(), req.GetTab())
+	if err != nil {
+		return nil, err
+	}
+
+	grid, err := s.Grid(ctx, req.GetScope(), dashboardName, tabName, testGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("Dashboard {%q} or tab {%q} not found", req.GetDashboard(), req.GetTab())
+	}
+	if grid == nil {
+		return nil, errors.New("grid not found")
+	}
+
+	resp := apipb.ListClustersResponse{
+		Clusters: make([]*apipb.ListClustersResponse_Cluster, 0, len(grid.Cluster)),
+	}
+	if millis := grid.MostRecentClusterTimestamp; millis > 0 {
+		resp.MostRecentClusterTimestamp = &timestamp.Timestamp{
+			Seconds: int64(millis / 1000),
+			Nanos:   int32(math.Mod(millis, 1000) * 1e6),
+		}
+	}
+	for _, gCluster := range grid.Cluster {
+		cluster := apipb.ListClustersResponse_Cluster{
+			TestStatus: gCluster.TestStatus,
+			Message:    gCluster.Message,
+			Rows:       make([]*apipb.ListClustersResponse_ClusterRow, 0, len(gCluster.ClusterRow)),
+		}
+		for _, gRow := range gCluster.ClusterRow {
+			row := apipb.ListClustersResponse_ClusterRow{
+				Name: gRow.DisplayName,
+			}
+			for _, idx := range gRow.Index {
+				if int(idx) >= len(grid.Columns) {
+					continue
+				}
+				row.Builds = append(row.Builds, grid.Columns[idx].Build)
+				cluster.Cells++
+			}
+			cluster.Rows = append(cluster.Rows, &row)
+		}
+		resp.Clusters = append(resp.Clusters, &cluster)
+	}
+	return &resp, nil
+}
+
+// ListClustersHTTP returns the clusters of similar failures in a dashboard tab
+// Response json: ListClustersResponse
+func (s Server) ListClustersHTTP(w http.ResponseWriter, r *http.Request) {
+	req := apipb.ListClustersRequest{
+		Scope:     r.URL.Query().Get(scopeParam),
+		Dashboard: chi.URLParam(r, "dashboard"),
+		Tab:       chi.URLParam(r, "tab"),
+	}
+	resp, err := s.ListClusters(r.Context(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	s.writeJSON(w, resp)
+}
+
+// ListUpdateInfo returns how long recent updates of a dashboard tab's test group took
+func (s *Server) ListUpdateInfo(ctx context.Context, req *apipb.ListUpdateInfoRequest) (*apipb.ListUpdateInfoResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, s.Timeout)
+	defer cancel()
+
+	cfg, err := s.getConfig(ctx, logrus.WithContext(ctx), req.GetScope())
+	if err != nil {
+		return nil, err
+	}
+	cfg.Mutex.RLock()
+	defer cfg.Mutex.RUnlock()
+
+	dashboardName, tabName, testGroupName, err := findDashboardTab(cfg, req.GetDashboard(), req.GetTab())
+	if err != nil {
+		return nil, err
+	}
+
+	grid, err := s.Grid(ctx, req.GetScope(), dashboardName, tabName, testGroupName)
+	if err != nil {
+		return nil, fmt.Errorf("Dashboard {%q} or tab {%q} not found", req.GetDashboard(), req.GetTab())
+	}
+	if grid == nil {
+		return nil, errors.New("grid not found")
+	}
+
+	return &apipb.ListUpdateInfoResponse{
+		UpdateInfo: grid.UpdateInfo,
+	}, nil
+}
+
+// ListUpdateInfoHTTP returns how long recent updates of a dashboard tab's test group took
+// Response json: ListUpdateInfoResponse
+func (s Server) ListUpdateInfoHTTP(w http.ResponseWriter, r *http.Request) {
+	req := apipb.ListUpdateInfoRequest{
+		Scope:     r.URL.Query().Get(scopeParam),
+		Dashboard: chi.URLParam(r, "dashboard"),
+		Tab:       chi.URLParam(r, "tab"),
+	}
+	resp, err := s.ListUpdateInfo(r.Context(), &req)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	s.writeJSON(w, resp)
+}
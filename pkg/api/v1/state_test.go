@@ -464,6 +464,121 @@ func TestListRows(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Returns cell properties",
+			config: map[string]*pb.Configuration{
+				"gs://default/config": {
+					Dashboards: []*pb.Dashboard{
+						{
+							Name: "Dashboard1",
+							DashboardTab: []*pb.DashboardTab{
+								{
+									Name:          "tab 1",
+									TestGroupName: "testgroupname",
+								},
+							},
+						},
+					},
+				},
+			},
+			grid: map[string]*statepb.Grid{
+				"gs://default/tabs/Dashboard1/tab%201": {
+					Rows: []*statepb.Row{
+						{
+							Name:     "tabrow1",
+							Id:       "tabrow1",
+							Results:  []int32{1, 1, 0, 1, 12, 1},
+							CellIds:  []string{"cell-1", "cell-3"},
+							Messages: []string{"", "boom"},
+							Icons:    []string{"", "F"},
+							Properties: []*statepb.Property{
+								{},
+								{Property: map[string]string{"attachment": "screenshot.png"}},
+							},
+						},
+					},
+				},
+			},
+			req: &apipb.ListRowsRequest{
+				Scope:     "gs://default",
+				Dashboard: "dashboard1",
+				Tab:       "tab1",
+			},
+			want: &apipb.ListRowsResponse{
+				Rows: []*apipb.ListRowsResponse_Row{
+					{
+						Name: "tabrow1",
+						Cells: []*apipb.ListRowsResponse_Cell{
+							{
+								Result: 1,
+								CellId: "cell-1",
+							},
+							{},
+							{
+								Result:     12,
+								CellId:     "cell-3",
+								Message:    "boom",
+								Icon:       "F",
+								Properties: map[string]string{"attachment": "screenshot.png"},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Returns tabular names",
+			config: map[string]*pb.Configuration{
+				"gs://default/config": {
+					Dashboards: []*pb.Dashboard{
+						{
+							Name: "Dashboard1",
+							DashboardTab: []*pb.DashboardTab{
+								{
+									Name:              "tab 1",
+									TestGroupName:     "testgroupname",
+									TabularNamesRegex: `^(?P<test>\w+)/(?P<arch>\w+)$`,
+								},
+							},
+						},
+					},
+				},
+			},
+			grid: map[string]*statepb.Grid{
+				"gs://default/tabs/Dashboard1/tab%201": {
+					Rows: []*statepb.Row{
+						{
+							Name:          "TestFoo/amd64",
+							Id:            "TestFoo/amd64",
+							Results:       []int32{1, 1},
+							CellIds:       []string{"cell-1"},
+							TabularValues: []string{"TestFoo", "amd64"},
+						},
+					},
+					TabularNames: []string{"test", "arch"},
+				},
+			},
+			req: &apipb.ListRowsRequest{
+				Scope:     "gs://default",
+				Dashboard: "dashboard1",
+				Tab:       "tab1",
+			},
+			want: &apipb.ListRowsResponse{
+				Rows: []*apipb.ListRowsResponse_Row{
+					{
+						Name: "TestFoo/amd64",
+						Cells: []*apipb.ListRowsResponse_Cell{
+							{
+								Result: 1,
+								CellId: "cell-1",
+							},
+						},
+						TabularValues: []string{"TestFoo", "amd64"},
+					},
+				},
+				TabularNames: []string{"test", "arch"},
+			},
+		},
 		{
 			name: "Returns tab from tab state",
 			config: map[string]*pb.Configuration{
@@ -615,3 +730,212 @@ func TestListRows(t *testing.T) {
 		})
 	}
 }
+
+func TestListClusters(t *testing.T) {
+	config := map[string]*pb.Configuration{
+		"gs://default/config": {
+			Dashboards: []*pb.Dashboard{
+				{
+					Name: "Dashboard1",
+					DashboardTab: []*pb.DashboardTab{
+						{
+							Name:          "tab 1",
+							TestGroupName: "testgroupname",
+						},
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name string
+		grid map[string]*statepb.Grid
+		req  *apipb.ListClustersRequest
+		want *apipb.ListClustersResponse
+		err  bool
+	}{
+		{
+			name: "Returns an error when there's no tab resource",
+			req: &apipb.ListClustersRequest{
+				Dashboard: "dashboard1",
+				Tab:       "missing",
+			},
+			err: true,
+		},
+		{
+			name: "Returns empty clusters from a tab",
+			grid: map[string]*statepb.Grid{
+				"gs://default/tabs/Dashboard1/tab%201": {},
+			},
+			req: &apipb.ListClustersRequest{
+				Dashboard: "dashboard1",
+				Tab:       "tab1",
+			},
+			want: &apipb.ListClustersResponse{},
+		},
+		{
+			name: "Returns clusters from a tab",
+			grid: map[string]*statepb.Grid{
+				"gs://default/tabs/Dashboard1/tab%201": {
+					Columns: []*statepb.Column{
+						{Build: "3", Started: 3000},
+						{Build: "2", Started: 2000},
+						{Build: "1", Started: 1000},
+					},
+					Cluster: []*statepb.Cluster{
+						{
+							TestStatus: 12,
+							Message:    "dial tcp <n>.<n>:<n>: i/o timeout",
+							ClusterRow: []*statepb.ClusterRow{
+								{DisplayName: "a", Index: []int32{0, 1}},
+								{DisplayName: "b", Index: []int32{2, 5}},
+							},
+						},
+						{
+							TestStatus: 9,
+							Message:    "killed",
+							ClusterRow: []*statepb.ClusterRow{
+								{DisplayName: "c", Index: []int32{1}},
+							},
+						},
+					},
+					MostRecentClusterTimestamp: 2000,
+				},
+			},
+			req: &apipb.ListClustersRequest{
+				Dashboard: "dashboard1",
+				Tab:       "tab1",
+			},
+			want: &apipb.ListClustersResponse{
+				Clusters: []*apipb.ListClustersResponse_Cluster{
+					{
+						TestStatus: 12,
+						Message:    "dial tcp <n>.<n>:<n>: i/o timeout",
+						Cells:      3,
+						Rows: []*apipb.ListClustersResponse_ClusterRow{
+							{Name: "a", Builds: []string{"3", "2"}},
+							{Name: "b", Builds: []string{"1"}},
+						},
+					},
+					{
+						TestStatus: 9,
+						Message:    "killed",
+						Cells:      1,
+						Rows: []*apipb.ListClustersResponse_ClusterRow{
+							{Name: "c", Builds: []string{"2"}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: &timestamppb.Timestamp{Seconds: 2},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := setupTestServer(t, config, tc.grid, nil)
+			got, err := server.ListClusters(context.Background(), tc.req)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("ListClusters() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("ListClusters() failed to receive an error")
+			default:
+				if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("ListClusters() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestListUpdateInfo(t *testing.T) {
+	config := map[string]*pb.Configuration{
+		"gs://default/config": {
+			Dashboards: []*pb.Dashboard{
+				{
+					Name: "Dashboard1",
+					DashboardTab: []*pb.DashboardTab{
+						{
+							Name:          "tab 1",
+							TestGroupName: "testgroupname",
+						},
+					},
+				},
+			},
+		},
+	}
+	updateInfo := []*statepb.UpdateInfo{
+		{
+			UpdatePhaseData: []*statepb.UpdatePhaseData{
+				{PhaseName: "read_columns", PhaseSeconds: 12.5},
+				{PhaseName: "shrink", PhaseSeconds: 0.5},
+			},
+			UpdateTime: &timestamppb.Timestamp{Seconds: 1000},
+		},
+		{
+			UpdatePhaseData: []*statepb.UpdatePhaseData{
+				{PhaseName: "read_columns", PhaseSeconds: 3},
+			},
+			UpdateTime: &timestamppb.Timestamp{Seconds: 900},
+		},
+	}
+	tests := []struct {
+		name string
+		grid map[string]*statepb.Grid
+		req  *apipb.ListUpdateInfoRequest
+		want *apipb.ListUpdateInfoResponse
+		err  bool
+	}{
+		{
+			name: "Returns an error when there's no tab resource",
+			req: &apipb.ListUpdateInfoRequest{
+				Dashboard: "dashboard1",
+				Tab:       "missing",
+			},
+			err: true,
+		},
+		{
+			name: "Returns an error when there's no grid",
+			req: &apipb.ListUpdateInfoRequest{
+				Dashboard: "dashboard1",
+				Tab:       "tab1",
+			},
+			err: true,
+		},
+		{
+			name: "Returns update info from a tab",
+			grid: map[string]*statepb.Grid{
+				"gs://default/tabs/Dashboard1/tab%201": {
+					UpdateInfo: updateInfo,
+				},
+			},
+			req: &apipb.ListUpdateInfoRequest{
+				Dashboard: "dashboard1",
+				Tab:       "tab1",
+			},
+			want: &apipb.ListUpdateInfoResponse{
+				UpdateInfo: updateInfo,
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := setupTestServer(t, config, tc.grid, nil)
+			got, err := server.ListUpdateInfo(context.Background(), tc.req)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("ListUpdateInfo() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("ListUpdateInfo() failed to receive an error")
+			default:
+				if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("ListUpdateInfo() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
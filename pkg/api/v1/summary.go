@@ -38,6 +38,7 @@ import (
 const (
 	numSummaryFailingTests = 5
 	numSummaryFlakyTests   = 5
+	numSummaryRegressions  = 5
 
 	passing    = "PASSING"
 	failing    = "FAILING"
@@ -67,16 +68,18 @@ func convertSummary(tabSummary *summarypb.DashboardTabSummary) *apipb.TabSummary
 
 	fs := extractFailuresSummary(tabSummary.GetFailingTestSummaries())
 	hs := extractHealthinessSummary(tabSummary.GetHealthiness())
+	rs := extractMetricRegressionsSummary(tabSummary.GetMetricRegressions())
 	return &apipb.TabSummary{
-		DashboardName:         tabSummary.DashboardName,
-		TabName:               tabSummary.DashboardTabName,
-		OverallStatus:         tabStatusStr[tabSummary.OverallStatus],
-		DetailedStatusMessage: tabSummary.Status,
-		LastRunTimestamp:      generateTimestamp(tabSummary.LastRunTimestamp),
-		LastUpdateTimestamp:   generateTimestamp(tabSummary.LastUpdateTimestamp),
-		LatestPassingBuild:    tabSummary.LatestGreen,
-		FailuresSummary:       fs,
-		HealthinessSummary:    hs,
+		DashboardName:            tabSummary.DashboardName,
+		TabName:                  tabSummary.DashboardTabName,
+		OverallStatus:            tabStatusStr[tabSummary.OverallStatus],
+		DetailedStatusMessage:    tabSummary.Status,
+		LastRunTimestamp:         generateTimestamp(tabSummary.LastRunTimestamp),
+		LastUpdateTimestamp:      generateTimestamp(tabSummary.LastUpdateTimestamp),
+		LatestPassingBuild:       tabSummary.LatestGreen,
+		FailuresSummary:          fs,
+		HealthinessSummary:       hs,
+		MetricRegressionsSummary: rs,
 	}
 }
 
@@ -142,14 +145,33 @@ func extractHealthinessSummary(healthiness *summarypb.HealthinessInfo) *apipb.He
 		prevFlakiness = healthiness.PreviousFlakiness[0]
 	}
 
-	sort.SliceStable(healthiness.Tests, func(i, j int) bool {
-		return healthiness.Tests[i].Flakiness > healthiness.Tests[j].Flakiness
+	topTests, numFlakyTests := topFlakyTests(healthiness.Tests)
+	topGroups, _ := topFlakyTests(healthiness.Groups)
+
+	return &apipb.HealthinessSummary{
+		TopFlakyTests:  topTests,
+		TopFlakyGroups: topGroups,
+		HealthinessStats: &apipb.HealthinessStats{
+			Start:             healthiness.Start,
+			End:               healthiness.End,
+			AverageFlakiness:  healthiness.AverageFlakiness,
+			PreviousFlakiness: prevFlakiness,
+			NumFlakyTests:     int32(numFlakyTests),
+		},
+	}
+}
+
+// topFlakyTests sorts the tests by flakiness, returning the flakiest
+// numSummaryFlakyTests along with the number of tests with +ve flakiness.
+func topFlakyTests(tests []*summarypb.TestInfo) ([]*apipb.FlakyTestInfo, int) {
+	sort.SliceStable(tests, func(i, j int) bool {
+		return tests[i].Flakiness > tests[j].Flakiness
 	})
 
 	// fetch top flaky tests (with +ve flakiness)
 	numFlakyTests := 0
-	for i := 0; i < len(healthiness.Tests); i++ {
-		t := healthiness.Tests[i]
+	for i := 0; i < len(tests); i++ {
+		t := tests[i]
 		if t.Flakiness <= 0 {
 			break
 		}
@@ -160,7 +182,7 @@ func extractHealthinessSummary(healthiness *summarypb.HealthinessInfo) *apipb.He
 
 	var topTests []*apipb.FlakyTestInfo
 	for i := 0; i < topN; i++ {
-		test := healthiness.Tests[i]
+		test := tests[i]
 		fti := &apipb.FlakyTestInfo{
 			DisplayName: test.DisplayName,
 			Flakiness:   test.Flakiness,
@@ -168,16 +190,34 @@ func extractHealthinessSummary(healthiness *summarypb.HealthinessInfo) *apipb.He
 		}
 		topTests = append(topTests, fti)
 	}
+	return topTests, numFlakyTests
+}
 
-	return &apipb.HealthinessSummary{
-		TopFlakyTests: topTests,
-		HealthinessStats: &apipb.HealthinessStats{
-			Start:             healthiness.Start,
-			End:               healthiness.End,
-			AverageFlakiness:  healthiness.AverageFlakiness,
-			PreviousFlakiness: prevFlakiness,
-			NumFlakyTests:     int32(numFlakyTests),
-		},
+// extractMetricRegressionsSummary extracts the most important info from summary proto's MetricRegressions field.
+// Regressions are already sorted by the summarizer, so the top regressions are the first numSummaryRegressions.
+func extractMetricRegressionsSummary(regressions []*summarypb.MetricRegression) *apipb.MetricRegressionsSummary {
+	if len(regressions) == 0 {
+		return nil
+	}
+
+	topN := int(math.Min(float64(numSummaryRegressions), float64(len(regressions))))
+
+	var top []*apipb.MetricRegressionInfo
+	for _, reg := range regressions[:topN] {
+		top = append(top, &apipb.MetricRegressionInfo{
+			DisplayName:    reg.DisplayName,
+			Metric:         reg.Metric,
+			BuildId:        reg.BuildId,
+			Timestamp:      generateTimestamp(reg.Timestamp),
+			PreviousMean:   reg.PreviousMean,
+			CurrentMean:    reg.CurrentMean,
+			RelativeChange: reg.RelativeChange,
+		})
+	}
+
+	return &apipb.MetricRegressionsSummary{
+		TopRegressions: top,
+		NumRegressions: int32(len(regressions)),
 	}
 }
 
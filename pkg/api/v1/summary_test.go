@@ -390,6 +390,16 @@ func TestListTabSummaries(t *testing.T) {
 										ChangeFromLastInterval: summarypb.TestInfo_UNKNOWN,
 									},
 								},
+								Groups: []*summarypb.TestInfo{
+									{
+										DisplayName: "stable-group",
+									},
+									{
+										DisplayName:            "flaky-group",
+										Flakiness:              float32(37.5),
+										ChangeFromLastInterval: summarypb.TestInfo_DOWN,
+									},
+								},
 							},
 						},
 					},
@@ -488,6 +498,110 @@ func TestListTabSummaries(t *testing.T) {
 									Change:      summarypb.TestInfo_UP,
 								},
 							},
+							TopFlakyGroups: []*apipb.FlakyTestInfo{
+								{
+									DisplayName: "flaky-group",
+									Flakiness:   float32(37.5),
+									Change:      summarypb.TestInfo_DOWN,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "Returns correct tab summaries for a dashboard, with metric regressions",
+			config: map[string]*configpb.Configuration{
+				"gs://default/config": {
+					Dashboards: []*configpb.Dashboard{
+						{
+							Name: "Bench",
+							DashboardTab: []*configpb.DashboardTab{
+								{
+									Name:          "latency",
+									TestGroupName: "benchmarks",
+								},
+							},
+						},
+					},
+				},
+			},
+			summaries: map[string]*summarypb.DashboardSummary{
+				"gs://default/summary/summary-bench": {
+					TabSummaries: []*summarypb.DashboardTabSummary{
+						{
+							DashboardName:       "Bench",
+							DashboardTabName:    "latency",
+							Status:              "All tests are passing!",
+							OverallStatus:       summarypb.DashboardTabSummary_PASS,
+							LastUpdateTimestamp: float64(915166800),
+							LastRunTimestamp:    float64(915166800),
+							MetricRegressions: []*summarypb.MetricRegression{
+								{
+									DisplayName:     "//bench:slow",
+									Metric:          "test-duration-minutes",
+									BuildId:         "12",
+									PreviousBuildId: "11",
+									Timestamp:       float64(915166000),
+									PreviousMean:    10,
+									CurrentMean:     20,
+									RelativeChange:  1,
+									Score:           30,
+								},
+								{
+									DisplayName:    "//bench:also-slow",
+									Metric:         "test-duration-minutes",
+									BuildId:        "13",
+									Timestamp:      float64(915166500),
+									PreviousMean:   10,
+									CurrentMean:    15,
+									RelativeChange: 0.5,
+									Score:          12,
+								},
+							},
+						},
+					},
+				},
+			},
+			req: &apipb.ListTabSummariesRequest{
+				Dashboard: "Bench",
+			},
+			want: &apipb.ListTabSummariesResponse{
+				TabSummaries: []*apipb.TabSummary{
+					{
+						DashboardName:         "Bench",
+						TabName:               "latency",
+						DetailedStatusMessage: "All tests are passing!",
+						OverallStatus:         "PASSING",
+						LastRunTimestamp: &timestamp.Timestamp{
+							Seconds: 915166800,
+						},
+						LastUpdateTimestamp: &timestamp.Timestamp{
+							Seconds: 915166800,
+						},
+						MetricRegressionsSummary: &apipb.MetricRegressionsSummary{
+							NumRegressions: 2,
+							TopRegressions: []*apipb.MetricRegressionInfo{
+								{
+									DisplayName:    "//bench:slow",
+									Metric:         "test-duration-minutes",
+									BuildId:        "12",
+									Timestamp:      &timestamp.Timestamp{Seconds: 915166000},
+									PreviousMean:   10,
+									CurrentMean:    20,
+									RelativeChange: 1,
+								},
+								{
+									DisplayName:    "//bench:also-slow",
+									Metric:         "test-duration-minutes",
+									BuildId:        "13",
+									Timestamp:      &timestamp.Timestamp{Seconds: 915166500},
+									PreviousMean:   10,
+									CurrentMean:    15,
+									RelativeChange: 0.5,
+								},
+							},
 						},
 					},
 				},
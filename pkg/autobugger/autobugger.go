@@ -0,0 +1,236 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package autobugger files, updates and closes issues for the alerts in tab state.
+package autobugger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"bitbucket.org/creachadair/stringset"
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/issuestate"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/tabulator"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/metrics"
+	"github.com/sirupsen/logrus"
+)
+
+// Metrics holds metrics relevant to the Autobugger.
+type Metrics struct {
+	Update metrics.Cyclic
+	Issues metrics.Counter
+}
+
+// CreateMetrics creates all the metrics that the Autobugger will use
+// This should be called once
+func CreateMetrics(factory metrics.Factory) *Metrics {
+	return &Metrics{
+		Update: factory.NewCyclic("autobugger"),
+		Issues: factory.NewCounter("issues", "Number of issues changed", "action"),
+	}
+}
+
+func (mets *Metrics) start() *metrics.CycleReporter {
+	if mets == nil {
+		return nil
+	}
+	return mets.Update.Start()
+}
+
+func (mets *Metrics) changed(action string) {
+	if mets == nil {
+		return
+	}
+	mets.Issues.Add(1, action)
+}
+
+// UpdateOptions aggregates the Update function parameter into a single structure.
+type UpdateOptions struct {
+	ConfigPath       gcs.Path
+	TabsPathPrefix   string
+	IssueStatePrefix string
+	AllowedGroups    []string
+	Confirm          bool
+	Freq             time.Duration
+	// Now returns the current time, defaulting to time.Now.
+	Now func() time.Time
+}
+
+// Update files issues for the test groups of tabs that set auto_file_bugs.
+//
+// Runs once when opts.Freq is zero, otherwise repeats until the context expires.
+// Only changes issues and saves issue state when opts.Confirm is set.
+func Update(ctx context.Context, client gcs.Client, mets *Metrics, tracker IssueTracker, opts *UpdateOptions) error {
+	log := logrus.WithField("config", opts.ConfigPath)
+	if !opts.Confirm {
+		tracker = &dryRunTracker{log: log}
+	}
+
+	var timer *time.Timer
+	for {
+		rep := mets.start()
+		if err := fileOnce(ctx, log, client, mets, tracker, opts); err != nil {
+			rep.Fail()
+			log.WithError(err).Error("Failed to file issues")
+		} else {
+			rep.Success()
+		}
+		if opts.Freq == 0 {
+			return nil
+		}
+		if timer == nil {
+			timer = time.NewTimer(opts.Freq)
+			defer timer.Stop()
+		} else {
+			timer.Reset(opts.Freq)
+		}
+		log.WithField("wait", opts.Freq).Info("Sleeping")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func fileOnce(ctx context.Context, log logrus.FieldLogger, client gcs.Client, mets *Metrics, tracker IssueTracker, opts *UpdateOptions) error {
+	cfg, _, err := config.ReadGCS(ctx, client, opts.ConfigPath)
+	if err != nil {
+		return fmt.Errorf("read config: %w", err)
+	}
+	now := time.Now
+	if opts.Now != nil {
+		now = opts.Now
+	}
+	fileGroups(ctx, log, client, mets, tracker, opts, cfg, now())
+	return nil
+}
+
+// autoBugTab is a dashboard tab that files bugs for its test group.
+type autoBugTab struct {
+	dashboard string
+	tab       *configpb.DashboardTab
+}
+
+// autoBugTabs returns the tabs that file bugs for each test group, ordered by dashboard and tab.
+func autoBugTabs(cfg *configpb.Configuration) map[string][]autoBugTab {
+	tabs := map[string][]autoBugTab{}
+	for _, dash := range cfg.Dashboards {
+		for _, tab := range dash.DashboardTab {
+			if !tab.AutoFileBugs {
+				continue
+			}
+			tabs[tab.TestGroupName] = append(tabs[tab.TestGroupName], autoBugTab{dash.Name, tab})
+		}
+	}
+	for _, ts := range tabs {
+		sort.SliceStable(ts, func(i, j int) bool {
+			if ts[i].dashboard != ts[j].dashboard {
+				return ts[i].dashboard < ts[j].dashboard
+			}
+			return ts[i].tab.Name < ts[j].tab.Name
+		})
+	}
+	return tabs
+}
+
+// autoBugOptions returns the options of the first tab that sets them, otherwise those of the group.
+func autoBugOptions(group *configpb.TestGroup, tabs []autoBugTab) *configpb.AutoBugOptions {
+	for _, t := range tabs {
+		if opts := t.tab.GetBetaAutobugOptions(); opts != nil {
+			return opts
+		}
+	}
+	return group.GetAutoBugOptions()
+}
+
+// groupTargets returns the targets of every tab of the group.
+//
+// Fails when any tab cannot be read, so that issues of its rows are neither filed nor closed.
+func groupTargets(ctx context.Context, client gcs.Opener, opts *UpdateOptions, group *configpb.TestGroup, tabs []autoBugTab, now time.Time) ([]target, error) {
+	var targets []target
+	for _, t := range tabs {
+		path, err := tabulator.TabStatePath(opts.ConfigPath, opts.TabsPathPrefix, t.dashboard, t.tab.Name)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: bad tab state path: %w", t.dashboard, t.tab.Name, err)
+		}
+		grid, _, err := gcs.DownloadGrid(ctx, client, *path)
+		if err != nil {
+			return nil, fmt.Errorf("%s/%s: %w", t.dashboard, t.tab.Name, err)
+		}
+		targets = append(targets, collectTargets(t.dashboard, t.tab, grid, autoBugOptions(group, tabs), now)...)
+	}
+	return targets, nil
+}
+
+// fileGroups files the issues for each test group, saving the updated issue state.
+func fileGroups(ctx context.Context, log logrus.FieldLogger, client gcs.Client, mets *Metrics, tracker IssueTracker, opts *UpdateOptions, cfg *configpb.Configuration, now time.Time) {
+	allowed := stringset.New(opts.AllowedGroups...)
+	groups := make(map[string]*configpb.TestGroup, len(cfg.TestGroups))
+	for _, tg := range cfg.TestGroups {
+		groups[tg.Name] = tg
+	}
+	tabsByGroup := autoBugTabs(cfg)
+	names := make([]string, 0, len(tabsByGroup))
+	for name := range tabsByGroup {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if allowed.Len() > 0 && !allowed.Contains(name) {
+			continue
+		}
+		log := log.WithField("group", name)
+		group, ok := groups[name]
+		if !ok {
+			log.Warning("Tab references missing test group")
+			continue
+		}
+		tabs := tabsByGroup[name]
+		targets, err := groupTargets(ctx, client, opts, group, tabs, now)
+		if err != nil {
+			log.WithError(err).Error("Failed to read tab states")
+			continue
+		}
+
+		statePath, err := issuestate.Path(opts.ConfigPath, opts.IssueStatePrefix, name)
+		if err != nil {
+			log.WithError(err).Error("Bad issue state path")
+			continue
+		}
+		state, err := issuestate.Read(ctx, client, *statePath)
+		if err != nil {
+			log.WithError(err).Error("Failed to read issue state")
+			continue
+		}
+		if !fileGroup(ctx, log, tracker, mets, name, autoBugOptions(group, tabs), targets, state, now) {
+			continue
+		}
+		if !opts.Confirm {
+			log.Info("Would save issue state")
+			continue
+		}
+		if err := issuestate.Write(ctx, client, *statePath, state); err != nil {
+			log.WithError(err).Error("Failed to save issue state")
+		}
+	}
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autobugger
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+)
+
+func TestAutoBugOptions(t *testing.T) {
+	group := &configpb.TestGroup{AutoBugOptions: &configpb.AutoBugOptions{AutoClose: true}}
+	tab := &configpb.DashboardTab{BetaAutobugOptions: &configpb.AutoBugOptions{FileIndividual: true}}
+	cases := []struct {
+		name     string
+		tabs     []autoBugTab
+		expected *configpb.AutoBugOptions
+	}{
+		{
+			name:     "group options",
+			tabs:     []autoBugTab{{"dash", &configpb.DashboardTab{}}},
+			expected: group.AutoBugOptions,
+		},
+		{
+			name:     "tab options win",
+			tabs:     []autoBugTab{{"dash", &configpb.DashboardTab{}}, {"dash", tab}},
+			expected: tab.BetaAutobugOptions,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := autoBugOptions(group, tc.tabs); got != tc.expected {
+				t.Errorf("autoBugOptions() got %v, want %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFileGroups(t *testing.T) {
+	configPath := mustPath("gs://bucket/config")
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	cfg := &configpb.Configuration{
+		TestGroups: []*configpb.TestGroup{
+			{Name: "group"},
+			{Name: "quiet"},
+		},
+		Dashboards: []*configpb.Dashboard{
+			{
+				Name: "dash",
+				DashboardTab: []*configpb.DashboardTab{
+					{
+						Name:               "tab",
+						TestGroupName:      "group",
+						AutoFileBugs:       true,
+						BetaAutobugOptions: &configpb.AutoBugOptions{FileIndividual: true},
+					},
+					{
+						Name:          "other",
+						TestGroupName: "group",
+						AutoFileBugs:  true,
+					},
+					{
+						Name:          "quiet",
+						TestGroupName: "quiet",
+					},
+				},
+			},
+		},
+	}
+	grid := &statepb.Grid{
+		Rows: []*statepb.Row{
+			{Name: "passing"},
+			{Name: "failing", AlertInfo: &statepb.AlertInfo{FailBuildId: "10"}},
+		},
+	}
+	buf, err := gcs.MarshalGrid(grid)
+	if err != nil {
+		t.Fatalf("MarshalGrid(): %v", err)
+	}
+	tabPath := mustPath("gs://bucket/tabs/dash/tab")
+	otherPath := mustPath("gs://bucket/tabs/dash/other")
+	statePath := mustPath("gs://bucket/issues/group")
+	wantState := &issuepb.IssueState{
+		IssueInfo: []*issuepb.IssueInfo{
+			{
+				IssueId:      "1",
+				Title:        "dash/tab: failing is failing",
+				IsAutobug:    true,
+				LastModified: float64(now.Unix()),
+				RowIds:       []string{"failing"},
+				RunIds:       []string{"10"},
+			},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		confirm  bool
+		allowed  []string
+		otherErr error
+		want     *issuepb.IssueState
+	}{
+		{
+			name:    "basically works",
+			confirm: true,
+			want:    wantState,
+		},
+		{
+			name: "dry run",
+		},
+		{
+			name:    "not allowed",
+			confirm: true,
+			allowed: []string{"quiet"},
+		},
+		{
+			name:     "skip group with unreadable tab",
+			confirm:  true,
+			otherErr: errors.New("injected"),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fake.UploadClient{
+				Uploader: fake.Uploader{},
+				Client: fake.Client{
+					Opener: fake.Opener{
+						tabPath: {Data: string(buf)},
+					},
+				},
+				Stater: fake.Stater{},
+			}
+			if tc.otherErr != nil {
+				client.Opener[otherPath] = fake.Object{OpenErr: tc.otherErr}
+			}
+			opts := &UpdateOptions{
+				ConfigPath:       configPath,
+				TabsPathPrefix:   "tabs",
+				IssueStatePrefix: "issues",
+				AllowedGroups:    tc.allowed,
+				Confirm:          tc.confirm,
+			}
+			fakeTracker := &FakeTracker{}
+			var tracker IssueTracker = fakeTracker
+			if !tc.confirm {
+				tracker = &dryRunTracker{log: logrus.WithField("name", tc.name)}
+			}
+			fileGroups(context.Background(), logrus.WithField("name", tc.name), client, nil, tracker, opts, cfg, now)
+
+			var got *issuepb.IssueState
+			if up, ok := client.Uploader[statePath]; ok {
+				got = &issuepb.IssueState{}
+				if err := proto.Unmarshal(up.Buf, got); err != nil {
+					t.Fatalf("Unmarshal(): %v", err)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("fileGroups() got unexpected issue state (-want +got):\n%s", diff)
+			}
+			if tc.want == nil && len(fakeTracker.Issues) > 0 {
+				t.Errorf("fileGroups() unexpectedly filed issues: %v", fakeTracker.Issues)
+			}
+			if len(client.Uploader) > 1 {
+				t.Errorf("fileGroups() wrote unexpected objects: %v", client.Uploader)
+			}
+		})
+	}
+}
+
+func mustPath(s string) gcs.Path {
+	p, err := gcs.NewPath(s)
+	if err != nil {
+		panic(err)
+	}
+	return *p
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autobugger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	overallRow = "Overall"
+
+	filedAction     = "filed"
+	commentedAction = "commented"
+	closedAction    = "closed"
+)
+
+// target is a failing row, or a stale tab, that should have an issue.
+type target struct {
+	// id is the row name, which the updater uses to link the issue to the row.
+	id        string
+	dashboard string
+	tab       string
+	// alert is nil for a stale tab.
+	alert      *statepb.AlertInfo
+	staleHours int32
+}
+
+// staleID identifies the issue for a tab without recent results.
+func staleID(dashboard, tab string) string {
+	return fmt.Sprintf("stale:%s/%s", dashboard, tab)
+}
+
+// collectTargets returns the alerting rows of the tab state, as well as the tab itself if it is stale.
+func collectTargets(dashboard string, tab *configpb.DashboardTab, grid *statepb.Grid, opts *configpb.AutoBugOptions, now time.Time) []target {
+	var targets []target
+	for _, row := range grid.GetRows() {
+		if row.AlertInfo == nil {
+			continue
+		}
+		if row.Name == overallRow && (!opts.GetFileOverall() || opts.GetIgnoreOverall()) {
+			continue
+		}
+		targets = append(targets, target{
+			id:        row.Name,
+			dashboard: dashboard,
+			tab:       tab.Name,
+			alert:     row.AlertInfo,
+		})
+	}
+	hours := tab.GetAlertOptions().GetAlertStaleResultsHours()
+	if opts.GetFileStale() && hours > 0 && len(grid.GetColumns()) > 0 {
+		// Column.Started is in milliseconds.
+		latest := time.Unix(0, int64(grid.Columns[0].Started*float64(time.Millisecond)))
+		if now.Sub(latest) > time.Duration(hours)*time.Hour {
+			targets = append(targets, target{
+				id:         staleID(dashboard, tab.Name),
+				dashboard:  dashboard,
+				tab:        tab.Name,
+				staleHours: hours,
+			})
+		}
+	}
+	return targets
+}
+
+// fileGroup closes the issues of recovered targets and files issues for new failures,
+// recording them in state.
+//
+// Returns true when state changed.
+func fileGroup(ctx context.Context, log logrus.FieldLogger, tracker IssueTracker, mets *Metrics, group string, opts *configpb.AutoBugOptions, targets []target, state *issuepb.IssueState, now time.Time) bool {
+	var changed bool
+	failing := map[string]bool{}
+	var unique []target
+	for _, t := range targets {
+		if failing[t.id] {
+			continue
+		}
+		failing[t.id] = true
+		unique = append(unique, t)
+	}
+
+	if opts.GetAutoClose() {
+		kept := make([]*issuepb.IssueInfo, 0, len(state.IssueInfo))
+		for _, info := range state.IssueInfo {
+			if !info.IsAutobug || anyFailing(info.RowIds, failing) {
+				kept = append(kept, info)
+				continue
+			}
+			log := log.WithField("issue", info.IssueId)
+			if err := tracker.Close(ctx, info.IssueId, "TestGrid no longer reports these failures, closing."); err != nil {
+				log.WithError(err).Error("Failed to close issue")
+				kept = append(kept, info)
+				continue
+			}
+			log.Info("Closed issue")
+			mets.changed(closedAction)
+			changed = true
+		}
+		state.IssueInfo = kept
+	}
+
+	open := map[string][]*issuepb.IssueInfo{}
+	for _, info := range state.IssueInfo {
+		if !info.IsAutobug {
+			continue
+		}
+		for _, id := range info.RowIds {
+			open[id] = append(open[id], info)
+		}
+	}
+
+	var fresh []target
+	for _, t := range unique {
+		existing := open[t.id]
+		if len(existing) == 0 {
+			fresh = append(fresh, t)
+			continue
+		}
+		if t.alert == nil || hasRun(existing, t.alert.FailBuildId) {
+			continue
+		}
+		if !opts.GetFileIndividual() || !opts.GetSingletonAutobug() {
+			fresh = append(fresh, t)
+			continue
+		}
+		info := existing[0]
+		log := log.WithField("issue", info.IssueId)
+		if err := tracker.Comment(ctx, info.IssueId, "Failing again:\n\n"+targetText(t)); err != nil {
+			log.WithError(err).Error("Failed to comment on issue")
+			continue
+		}
+		log.Info("Commented on issue")
+		mets.changed(commentedAction)
+		info.RunIds = append(info.RunIds, t.alert.FailBuildId)
+		info.LastModified = float64(now.Unix())
+		changed = true
+	}
+
+	for _, batch := range batches(fresh, opts) {
+		issue := newIssue(group, batch, opts)
+		id, err := tracker.Create(ctx, issue)
+		if err != nil {
+			log.WithError(err).WithField("title", issue.Title).Error("Failed to file issue")
+			continue
+		}
+		log.WithFields(logrus.Fields{"issue": id, "title": issue.Title}).Info("Filed issue")
+		mets.changed(filedAction)
+		info := &issuepb.IssueInfo{
+			IssueId:      id,
+			Title:        issue.Title,
+			IsAutobug:    true,
+			LastModified: float64(now.Unix()),
+		}
+		for _, t := range batch {
+			info.RowIds = append(info.RowIds, t.id)
+			if t.alert != nil {
+				info.RunIds = appendMissing(info.RunIds, t.alert.FailBuildId)
+			}
+		}
+		state.IssueInfo = append(state.IssueInfo, info)
+		changed = true
+	}
+	return changed
+}
+
+func anyFailing(ids []string, failing map[string]bool) bool {
+	for _, id := range ids {
+		if failing[id] {
+			return true
+		}
+	}
+	return false
+}
+
+// hasRun returns true if any of the issues was filed for the build.
+func hasRun(infos []*issuepb.IssueInfo, build string) bool {
+	for _, info := range infos {
+		for _, id := range info.RunIds {
+			if id == build {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func appendMissing(list []string, s string) []string {
+	for _, v := range list {
+		if v == s {
+			return list
+		}
+	}
+	return append(list, s)
+}
+
+// batches splits the targets into the sets that each get an issue.
+//
+// Unless file_individual is set, targets that started failing in the same build share an issue.
+// Otherwise each target gets its own issue, unless there are more than max_allowed_individual_bugs.
+// A stale tab always gets its own issue.
+func batches(targets []target, opts *configpb.AutoBugOptions) [][]target {
+	var out [][]target
+	var failures []target
+	for _, t := range targets {
+		if t.alert == nil {
+			out = append(out, []target{t})
+			continue
+		}
+		failures = append(failures, t)
+	}
+	if len(failures) == 0 {
+		return out
+	}
+
+	if opts.GetFileIndividual() {
+		if max := int(opts.GetMaxAllowedIndividualBugs()); max > 0 && len(failures) > max {
+			return append(out, failures)
+		}
+		for _, t := range failures {
+			out = append(out, []target{t})
+		}
+		return out
+	}
+
+	index := map[string]int{}
+	for _, t := range failures {
+		key := t.dashboard + "/" + t.tab + "@" + t.alert.FailBuildId
+		i, ok := index[key]
+		if !ok {
+			i = len(out)
+			index[key] = i
+			out = append(out, nil)
+		}
+		out[i] = append(out[i], t)
+	}
+	return out
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, word)
+	}
+	return fmt.Sprintf("%d %ss", n, word)
+}
+
+func newIssue(group string, batch []target, opts *configpb.AutoBugOptions) Issue {
+	first := batch[0]
+	var title string
+	switch {
+	case first.alert == nil:
+		title = fmt.Sprintf("%s/%s: no results in %s", first.dashboard, first.tab, plural(int(first.staleHours), "hour"))
+	case len(batch) == 1:
+		title = fmt.Sprintf("%s/%s: %s is failing", first.dashboard, first.tab, first.id)
+	default:
+		title = fmt.Sprintf("%s/%s: %s are failing", first.dashboard, first.tab, plural(len(batch), "test"))
+	}
+
+	var body strings.Builder
+	for _, t := range batch {
+		body.WriteString(targetText(t))
+		body.WriteString("\n")
+	}
+	fmt.Fprintf(&body, "Filed automatically by TestGrid for test group %s.\n", group)
+
+	var labels []string
+	if p := opts.GetPriority(); p != configpb.AutoBugOptions_PRIORITY_UNSPECIFIED {
+		labels = append(labels, "priority/"+p.String())
+	}
+	return Issue{
+		Title:  title,
+		Body:   body.String(),
+		Labels: labels,
+	}
+}
+
+// targetText describes why the target has an issue.
+func targetText(t target) string {
+	var b strings.Builder
+	if t.alert == nil {
+		fmt.Fprintf(&b, "%s/%s has no results in the last %s.\n", t.dashboard, t.tab, plural(int(t.staleHours), "hour"))
+		return b.String()
+	}
+	a := t.alert
+	fmt.Fprintf(&b, "%s\n", t.id)
+	fmt.Fprintf(&b, "  Failed %s since build %s", plural(int(a.FailCount), "time"), a.FailBuildId)
+	if a.FailTime != nil {
+		fmt.Fprintf(&b, " (%s)", a.FailTime.AsTime().UTC().Format(time.RFC3339))
+	}
+	b.WriteString("\n")
+	if a.PassBuildId != "" {
+		fmt.Fprintf(&b, "  Last passed in build %s\n", a.PassBuildId)
+	}
+	if a.FailureMessage != "" {
+		fmt.Fprintf(&b, "  %s\n", a.FailureMessage)
+	}
+	return b.String()
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autobugger
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+)
+
+func failure(id, build string) target {
+	return target{
+		id:        id,
+		dashboard: "dash",
+		tab:       "tab",
+		alert:     &statepb.AlertInfo{FailBuildId: build, FailCount: 3},
+	}
+}
+
+func TestCollectTargets(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	millis := func(t time.Time) float64 {
+		return float64(t.UnixNano() / int64(time.Millisecond))
+	}
+	grid := &statepb.Grid{
+		Columns: []*statepb.Column{
+			{Started: millis(now.Add(-3 * time.Hour))},
+		},
+		Rows: []*statepb.Row{
+			{Name: "Overall", AlertInfo: &statepb.AlertInfo{FailBuildId: "1"}},
+			{Name: "passing"},
+			{Name: "failing", AlertInfo: &statepb.AlertInfo{FailBuildId: "2"}},
+		},
+	}
+	cases := []struct {
+		name     string
+		tab      *configpb.DashboardTab
+		opts     *configpb.AutoBugOptions
+		expected []string
+	}{
+		{
+			name:     "basically works",
+			tab:      &configpb.DashboardTab{Name: "tab"},
+			expected: []string{"failing"},
+		},
+		{
+			name:     "file overall",
+			tab:      &configpb.DashboardTab{Name: "tab"},
+			opts:     &configpb.AutoBugOptions{FileOverall: true},
+			expected: []string{"Overall", "failing"},
+		},
+		{
+			name:     "ignore overall wins",
+			tab:      &configpb.DashboardTab{Name: "tab"},
+			opts:     &configpb.AutoBugOptions{FileOverall: true, IgnoreOverall: true},
+			expected: []string{"failing"},
+		},
+		{
+			name: "stale",
+			tab: &configpb.DashboardTab{
+				Name:         "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{AlertStaleResultsHours: 2},
+			},
+			opts:     &configpb.AutoBugOptions{FileStale: true},
+			expected: []string{"failing", "stale:dash/tab"},
+		},
+		{
+			name: "not stale yet",
+			tab: &configpb.DashboardTab{
+				Name:         "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{AlertStaleResultsHours: 4},
+			},
+			opts:     &configpb.AutoBugOptions{FileStale: true},
+			expected: []string{"failing"},
+		},
+		{
+			name: "stale without file_stale",
+			tab: &configpb.DashboardTab{
+				Name:         "tab",
+				AlertOptions: &configpb.DashboardTabAlertOptions{AlertStaleResultsHours: 2},
+			},
+			expected: []string{"failing"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got []string
+			for _, target := range collectTargets("dash", tc.tab, grid, tc.opts, now) {
+				got = append(got, target.id)
+			}
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("collectTargets() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBatches(t *testing.T) {
+	stale := target{id: "stale:dash/tab", dashboard: "dash", tab: "tab", staleHours: 2}
+	targets := []target{
+		failure("a", "1"),
+		failure("b", "2"),
+		stale,
+		failure("c", "1"),
+	}
+	cases := []struct {
+		name     string
+		opts     *configpb.AutoBugOptions
+		expected [][]string
+	}{
+		{
+			name:     "group by build",
+			expected: [][]string{{"stale:dash/tab"}, {"a", "c"}, {"b"}},
+		},
+		{
+			name:     "individual",
+			opts:     &configpb.AutoBugOptions{FileIndividual: true},
+			expected: [][]string{{"stale:dash/tab"}, {"a"}, {"b"}, {"c"}},
+		},
+		{
+			name:     "too many individual bugs",
+			opts:     &configpb.AutoBugOptions{FileIndividual: true, MaxAllowedIndividualBugs: 2},
+			expected: [][]string{{"stale:dash/tab"}, {"a", "b", "c"}},
+		},
+		{
+			name:     "within max individual bugs",
+			opts:     &configpb.AutoBugOptions{FileIndividual: true, MaxAllowedIndividualBugs: 3},
+			expected: [][]string{{"stale:dash/tab"}, {"a"}, {"b"}, {"c"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var got [][]string
+			for _, batch := range batches(targets, tc.opts) {
+				var ids []string
+				for _, target := range batch {
+					ids = append(ids, target.id)
+				}
+				got = append(got, ids)
+			}
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("batches() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFileGroup(t *testing.T) {
+	now := time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC)
+	then := float64(now.Add(-time.Hour).Unix())
+	cases := []struct {
+		name        string
+		opts        *configpb.AutoBugOptions
+		targets     []target
+		issues      map[string]*FakeIssue
+		trackerErr  error
+		state       *issuepb.IssueState
+		expected    *issuepb.IssueState
+		wantChanged bool
+		wantOpen    []string
+		wantComment string
+	}{
+		{
+			name:     "nothing failing",
+			state:    &issuepb.IssueState{},
+			expected: &issuepb.IssueState{},
+		},
+		{
+			name:    "file new failures",
+			opts:    &configpb.AutoBugOptions{FileIndividual: true},
+			targets: []target{failure("a", "1"), failure("b", "1")},
+			state:   &issuepb.IssueState{},
+			expected: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{
+						IssueId:      "1",
+						Title:        "dash/tab: a is failing",
+						IsAutobug:    true,
+						LastModified: float64(now.Unix()),
+						RowIds:       []string{"a"},
+						RunIds:       []string{"1"},
+					},
+					{
+						IssueId:      "2",
+						Title:        "dash/tab: b is failing",
+						IsAutobug:    true,
+						LastModified: float64(now.Unix()),
+						RowIds:       []string{"b"},
+						RunIds:       []string{"1"},
+					},
+				},
+			},
+			wantChanged: true,
+			wantOpen:    []string{"dash/tab: a is failing", "dash/tab: b is failing"},
+		},
+		{
+			name:    "already filed",
+			targets: []target{failure("a", "1")},
+			issues: map[string]*FakeIssue{
+				"1": {Issue: Issue{Title: "old"}},
+			},
+			state: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "old", IsAutobug: true, LastModified: then, RowIds: []string{"a"}, RunIds: []string{"1"}},
+				},
+			},
+			expected: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "old", IsAutobug: true, LastModified: then, RowIds: []string{"a"}, RunIds: []string{"1"}},
+				},
+			},
+			wantOpen: []string{"old"},
+		},
+		{
+			name:    "new failure files another issue",
+			targets: []target{failure("a", "2")},
+			issues: map[string]*FakeIssue{
+				"1": {Issue: Issue{Title: "old"}},
+			},
+			state: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "old", IsAutobug: true, LastModified: then, RowIds: []string{"a"}, RunIds: []string{"1"}},
+				},
+			},
+			expected: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "old", IsAutobug: true, LastModified: then, RowIds: []string{"a"}, RunIds: []string{"1"}},
+					{IssueId: "2", Title: "dash/tab: a is failing", IsAutobug: true, LastModified: float64(now.Unix()), RowIds: []string{"a"}, RunIds: []string{"2"}},
+				},
+			},
+			wantChanged: true,
+			wantOpen:    []string{"old", "dash/tab: a is failing"},
+		},
+		{
+			name:    "singleton comments on the existing issue",
+			opts:    &configpb.AutoBugOptions{FileIndividual: true, SingletonAutobug: true},
+			targets: []target{failure("a", "2")},
+			issues: map[string]*FakeIssue{
+				"1": {Issue: Issue{Title: "old"}},
+			},
+			state: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "old", IsAutobug: true, LastModified: then, RowIds: []string{"a"}, RunIds: []string{"1"}},
+				},
+			},
+			expected: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "old", IsAutobug: true, LastModified: float64(now.Unix()), RowIds: []string{"a"}, RunIds: []string{"1", "2"}},
+				},
+			},
+			wantChanged: true,
+			wantOpen:    []string{"old"},
+			wantComment: "Failing again:\n\na\n  Failed 3 times since build 2\n",
+		},
+		{
+			name: "auto close recovered issues",
+			opts: &configpb.AutoBugOptions{AutoClose: true},
+			issues: map[string]*FakeIssue{
+				"1": {Issue: Issue{Title: "recovered"}},
+				"2": {Issue: Issue{Title: "still failing"}},
+				"3": {Issue: Issue{Title: "filed by hand"}},
+			},
+			targets: []target{failure("b", "1")},
+			state: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "recovered", IsAutobug: true, RowIds: []string{"a"}, RunIds: []string{"1"}},
+					{IssueId: "2", Title: "still failing", IsAutobug: true, RowIds: []string{"b", "c"}, RunIds: []string{"1"}},
+					{IssueId: "3", Title: "filed by hand", RowIds: []string{"d"}},
+				},
+			},
+			expected: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "2", Title: "still failing", IsAutobug: true, RowIds: []string{"b", "c"}, RunIds: []string{"1"}},
+					{IssueId: "3", Title: "filed by hand", RowIds: []string{"d"}},
+				},
+			},
+			wantChanged: true,
+			wantOpen:    []string{"still failing", "filed by hand"},
+		},
+		{
+			name: "keep recovered issues without auto close",
+			issues: map[string]*FakeIssue{
+				"1": {Issue: Issue{Title: "recovered"}},
+			},
+			state: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "recovered", IsAutobug: true, RowIds: []string{"a"}, RunIds: []string{"1"}},
+				},
+			},
+			expected: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "recovered", IsAutobug: true, RowIds: []string{"a"}, RunIds: []string{"1"}},
+				},
+			},
+			wantOpen: []string{"recovered"},
+		},
+		{
+			name:       "tracker errors leave state alone",
+			opts:       &configpb.AutoBugOptions{AutoClose: true},
+			targets:    []target{failure("b", "1")},
+			trackerErr: errors.New("injected"),
+			state: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "recovered", IsAutobug: true, RowIds: []string{"a"}, RunIds: []string{"1"}},
+				},
+			},
+			expected: &issuepb.IssueState{
+				IssueInfo: []*issuepb.IssueInfo{
+					{IssueId: "1", Title: "recovered", IsAutobug: true, RowIds: []string{"a"}, RunIds: []string{"1"}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := &FakeTracker{Issues: tc.issues, Err: tc.trackerErr}
+			changed := fileGroup(context.Background(), logrus.WithField("name", tc.name), tracker, nil, "group", tc.opts, tc.targets, tc.state, now)
+			if changed != tc.wantChanged {
+				t.Errorf("fileGroup() got changed %t, want %t", changed, tc.wantChanged)
+			}
+			if diff := cmp.Diff(tc.expected, tc.state, protocmp.Transform()); diff != "" {
+				t.Errorf("fileGroup() got unexpected state diff (-want +got):\n%s", diff)
+			}
+			if tc.trackerErr != nil {
+				return
+			}
+			if diff := cmp.Diff(tc.wantOpen, tracker.Open(), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("fileGroup() got unexpected open issues (-want +got):\n%s", diff)
+			}
+			if tc.wantComment != "" {
+				comments := tracker.Issues["1"].Comments
+				if len(comments) != 1 || comments[0] != tc.wantComment {
+					t.Errorf("fileGroup() got comments %q, want %q", comments, tc.wantComment)
+				}
+			}
+		})
+	}
+}
+
+func TestNewIssue(t *testing.T) {
+	a := failure("a", "1")
+	a.alert.PassBuildId = "0"
+	a.alert.FailureMessage = "boom"
+	got := newIssue("group", []target{a, failure("b", "1")}, &configpb.AutoBugOptions{Priority: configpb.AutoBugOptions_P1})
+	if want := "dash/tab: 2 tests are failing"; got.Title != want {
+		t.Errorf("newIssue() got title %q, want %q", got.Title, want)
+	}
+	wantBody := "a\n  Failed 3 times since build 1\n  Last passed in build 0\n  boom\n\n" +
+		"b\n  Failed 3 times since build 1\n\n" +
+		"Filed automatically by TestGrid for test group group.\n"
+	if diff := cmp.Diff(wantBody, got.Body); diff != "" {
+		t.Errorf("newIssue() got unexpected body (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"priority/P1"}, got.Labels); diff != "" {
+		t.Errorf("newIssue() got unexpected labels (-want +got):\n%s", diff)
+	}
+
+	stale := newIssue("group", []target{{id: staleID("dash", "tab"), dashboard: "dash", tab: "tab", staleHours: 1}}, nil)
+	if want := "dash/tab: no results in 1 hour"; stale.Title != want {
+		t.Errorf("newIssue() got title %q, want %q", stale.Title, want)
+	}
+	if !strings.HasPrefix(stale.Body, "dash/tab has no results in the last 1 hour.\n") {
+		t.Errorf("newIssue() got unexpected stale body %q", stale.Body)
+	}
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autobugger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+)
+
+// DefaultGitHubURL is the base URL of the public GitHub REST API.
+const DefaultGitHubURL = "https://api.github.com"
+
+// GitHubTracker files issues in a GitHub repository.
+type GitHubTracker struct {
+	owner   string
+	repo    string
+	token   string
+	baseURL string
+	labels  []string
+	client  *http.Client
+}
+
+// NewGitHubTracker returns a tracker for the owner/repo repository.
+//
+// Authenticates with token when set, and adds labels to every issue it files.
+// Uses DefaultGitHubURL unless baseURL is set (such as for GitHub Enterprise).
+func NewGitHubTracker(repository, token, baseURL string, labels ...string) (*GitHubTracker, error) {
+	parts := strings.Split(repository, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("repository must be owner/repo, got %q", repository)
+	}
+	if baseURL == "" {
+		baseURL = DefaultGitHubURL
+	}
+	return &GitHubTracker{
+		owner:   parts[0],
+		repo:    parts[1],
+		token:   token,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		labels:  labels,
+		client:  http.DefaultClient,
+	}, nil
+}
+
+type githubIssue struct {
+	Number int      `json:"number,omitempty"`
+	Title  string   `json:"title,omitempty"`
+	Body   string   `json:"body,omitempty"`
+	Labels []string `json:"labels,omitempty"`
+	State  string   `json:"state,omitempty"`
+}
+
+type githubSearch struct {
+	Items []struct {
+		Number    int       `json:"number"`
+		Title     string    `json:"title"`
+		UpdatedAt time.Time `json:"updated_at"`
+	} `json:"items"`
+}
+
+type githubComment struct {
+	Body string `json:"body"`
+}
+
+// Create files an issue, returning its number.
+func (gt *GitHubTracker) Create(ctx context.Context, issue Issue) (string, error) {
+	in := githubIssue{
+		Title:  issue.Title,
+		Body:   issue.Body,
+		Labels: append(append([]string(nil), gt.labels...), issue.Labels...),
+	}
+	var out githubIssue
+	if err := gt.do(ctx, http.MethodPost, gt.issuesPath(), in, &out); err != nil {
+		return "", err
+	}
+	return strconv.Itoa(out.Number), nil
+}
+
+// Comment on the numbered issue.
+func (gt *GitHubTracker) Comment(ctx context.Context, id, body string) error {
+	return gt.do(ctx, http.MethodPost, gt.issuesPath()+"/"+id+"/comments", githubComment{Body: body}, nil)
+}
+
+// Close the numbered issue after commenting on it.
+func (gt *GitHubTracker) Close(ctx context.Context, id, comment string) error {
+	if comment != "" {
+		if err := gt.Comment(ctx, id, comment); err != nil {
+			return fmt.Errorf("comment: %w", err)
+		}
+	}
+	return gt.do(ctx, http.MethodPatch, gt.issuesPath()+"/"+id, githubIssue{State: "closed"}, nil)
+}
+
+// SearchIssues returns the open issues in the repository that mention text.
+func (gt *GitHubTracker) SearchIssues(ctx context.Context, text string) ([]*issuepb.IssueInfo, error) {
+	q := fmt.Sprintf("repo:%s/%s is:issue is:open %q", gt.owner, gt.repo, text)
+	var out githubSearch
+	if err := gt.do(ctx, http.MethodGet, "/search/issues?q="+url.QueryEscape(q), nil, &out); err != nil {
+		return nil, err
+	}
+	var issues []*issuepb.IssueInfo
+	for _, item := range out.Items {
+		issues = append(issues, &issuepb.IssueInfo{
+			IssueId:      strconv.Itoa(item.Number),
+			Title:        item.Title,
+			LastModified: float64(item.UpdatedAt.Unix()),
+		})
+	}
+	return issues, nil
+}
+
+func (gt *GitHubTracker) issuesPath() string {
+	return fmt.Sprintf("/repos/%s/%s/issues", gt.owner, gt.repo)
+}
+
+// do sends in as JSON to the path, decoding the response into out when set.
+func (gt *GitHubTracker) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body bytes.Buffer
+	if in != nil {
+		if err := json.NewEncoder(&body).Encode(in); err != nil {
+			return fmt.Errorf("encode: %w", err)
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, gt.baseURL+path, &body)
+	if err != nil {
+		return fmt.Errorf("request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("Content-Type", "application/json")
+	if gt.token != "" {
+		req.Header.Set("Authorization", "Bearer "+gt.token)
+	}
+	resp, err := gt.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	if out == nil {
+		return nil
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode: %w", err)
+	}
+	return nil
+}
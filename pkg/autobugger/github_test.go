@@ -0,0 +1,175 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autobugger
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+)
+
+type githubRequest struct {
+	Method string
+	Path   string
+	Auth   string
+	Body   map[string]interface{}
+}
+
+func fakeGitHub(t *testing.T, status int, response string) (*httptest.Server, *[]githubRequest) {
+	var reqs []githubRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := githubRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Auth:   r.Header.Get("Authorization"),
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		reqs = append(reqs, req)
+		w.WriteHeader(status)
+		w.Write([]byte(response))
+	}))
+	return server, &reqs
+}
+
+func TestNewGitHubTracker(t *testing.T) {
+	for _, repo := range []string{"", "owner", "owner/", "/repo", "a/b/c"} {
+		if _, err := NewGitHubTracker(repo, "", ""); err == nil {
+			t.Errorf("NewGitHubTracker(%q) failed to return an error", repo)
+		}
+	}
+	gt, err := NewGitHubTracker("owner/repo", "", "")
+	if err != nil {
+		t.Fatalf("NewGitHubTracker() got unexpected error: %v", err)
+	}
+	if gt.baseURL != DefaultGitHubURL {
+		t.Errorf("NewGitHubTracker() got base URL %q, want %q", gt.baseURL, DefaultGitHubURL)
+	}
+}
+
+func TestGitHubTracker(t *testing.T) {
+	server, reqs := fakeGitHub(t, http.StatusCreated, `{"number": 42}`)
+	defer server.Close()
+	gt, err := NewGitHubTracker("owner/repo", "secret", server.URL+"/", "testgrid")
+	if err != nil {
+		t.Fatalf("NewGitHubTracker() got unexpected error: %v", err)
+	}
+	ctx := context.Background()
+
+	id, err := gt.Create(ctx, Issue{Title: "hello", Body: "world", Labels: []string{"priority/P1"}})
+	if err != nil {
+		t.Fatalf("Create() got unexpected error: %v", err)
+	}
+	if id != "42" {
+		t.Errorf("Create() got id %q, want 42", id)
+	}
+	if err := gt.Comment(ctx, id, "again"); err != nil {
+		t.Errorf("Comment() got unexpected error: %v", err)
+	}
+	if err := gt.Close(ctx, id, "fixed"); err != nil {
+		t.Errorf("Close() got unexpected error: %v", err)
+	}
+
+	want := []githubRequest{
+		{
+			Method: http.MethodPost,
+			Path:   "/repos/owner/repo/issues",
+			Auth:   "Bearer secret",
+			Body: map[string]interface{}{
+				"title":  "hello",
+				"body":   "world",
+				"labels": []interface{}{"testgrid", "priority/P1"},
+			},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/repos/owner/repo/issues/42/comments",
+			Auth:   "Bearer secret",
+			Body:   map[string]interface{}{"body": "again"},
+		},
+		{
+			Method: http.MethodPost,
+			Path:   "/repos/owner/repo/issues/42/comments",
+			Auth:   "Bearer secret",
+			Body:   map[string]interface{}{"body": "fixed"},
+		},
+		{
+			Method: http.MethodPatch,
+			Path:   "/repos/owner/repo/issues/42",
+			Auth:   "Bearer secret",
+			Body:   map[string]interface{}{"state": "closed"},
+		},
+	}
+	if diff := cmp.Diff(want, *reqs); diff != "" {
+		t.Errorf("GitHubTracker got unexpected requests (-want +got):\n%s", diff)
+	}
+}
+
+func TestGitHubTrackerError(t *testing.T) {
+	server, _ := fakeGitHub(t, http.StatusForbidden, `{"message": "nope"}`)
+	defer server.Close()
+	gt, err := NewGitHubTracker("owner/repo", "", server.URL)
+	if err != nil {
+		t.Fatalf("NewGitHubTracker() got unexpected error: %v", err)
+	}
+	if _, err := gt.Create(context.Background(), Issue{Title: "hello"}); err == nil {
+		t.Error("Create() failed to return an error")
+	}
+}
+
+func TestGitHubTrackerSearchIssues(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/search/issues" {
+			t.Errorf("SearchIssues() got unexpected path %q", r.URL.Path)
+		}
+		query = r.URL.Query().Get("q")
+		w.Write([]byte(`{"items": [{"number": 7, "title": "//pkg:test is broken", "updated_at": "2023-05-01T12:00:00Z"}]}`))
+	}))
+	defer server.Close()
+	gt, err := NewGitHubTracker("owner/repo", "", server.URL)
+	if err != nil {
+		t.Fatalf("NewGitHubTracker() got unexpected error: %v", err)
+	}
+
+	got, err := gt.SearchIssues(context.Background(), "//pkg:test")
+	if err != nil {
+		t.Fatalf("SearchIssues() got unexpected error: %v", err)
+	}
+	if want := `repo:owner/repo is:issue is:open "//pkg:test"`; query != want {
+		t.Errorf("SearchIssues() got query %q, want %q", query, want)
+	}
+	want := []*issuepb.IssueInfo{
+		{
+			IssueId:      "7",
+			Title:        "//pkg:test is broken",
+			LastModified: float64(time.Date(2023, 5, 1, 12, 0, 0, 0, time.UTC).Unix()),
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("SearchIssues() got unexpected diff (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package autobugger
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Issue is a bug to file with an IssueTracker.
+type Issue struct {
+	Title  string
+	Body   string
+	Labels []string
+}
+
+// IssueTracker files, updates and closes issues.
+type IssueTracker interface {
+	// Create files a new issue, returning its ID.
+	Create(ctx context.Context, issue Issue) (string, error)
+	// Comment adds a comment to an existing issue.
+	Comment(ctx context.Context, id, body string) error
+	// Close closes an existing issue, explaining why in a comment.
+	Close(ctx context.Context, id, comment string) error
+}
+
+// FakeIssue is an issue filed with the FakeTracker.
+type FakeIssue struct {
+	Issue
+	Comments []string
+	Closed   bool
+}
+
+// FakeTracker is an in-memory IssueTracker for tests and dry runs.
+//
+// Issues are numbered sequentially from 1.
+type FakeTracker struct {
+	Issues map[string]*FakeIssue
+	// Err is returned by every call when set.
+	Err  error
+	lock sync.Mutex
+}
+
+// Create a new issue in memory.
+func (ft *FakeTracker) Create(_ context.Context, issue Issue) (string, error) {
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+	if ft.Err != nil {
+		return "", ft.Err
+	}
+	if ft.Issues == nil {
+		ft.Issues = map[string]*FakeIssue{}
+	}
+	id := strconv.Itoa(len(ft.Issues) + 1)
+	ft.Issues[id] = &FakeIssue{Issue: issue}
+	return id, nil
+}
+
+// Comment on an existing issue.
+func (ft *FakeTracker) Comment(_ context.Context, id, body string) error {
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+	if ft.Err != nil {
+		return ft.Err
+	}
+	issue, ok := ft.Issues[id]
+	if !ok {
+		return fmt.Errorf("issue %s not found", id)
+	}
+	issue.Comments = append(issue.Comments, body)
+	return nil
+}
+
+// Close an existing issue.
+func (ft *FakeTracker) Close(ctx context.Context, id, comment string) error {
+	if err := ft.Comment(ctx, id, comment); err != nil {
+		return err
+	}
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+	ft.Issues[id].Closed = true
+	return nil
+}
+
+// Open returns the titles of the open issues, sorted by ID.
+func (ft *FakeTracker) Open() []string {
+	ft.lock.Lock()
+	defer ft.lock.Unlock()
+	ids := make([]int, 0, len(ft.Issues))
+	for id, issue := range ft.Issues {
+		if issue.Closed {
+			continue
+		}
+		n, _ := strconv.Atoi(id)
+		ids = append(ids, n)
+	}
+	sort.Ints(ids)
+	titles := make([]string, 0, len(ids))
+	for _, n := range ids {
+		titles = append(titles, ft.Issues[strconv.Itoa(n)].Title)
+	}
+	return titles
+}
+
+// dryRunTracker logs what it would do rather than touching a real tracker.
+type dryRunTracker struct {
+	log logrus.FieldLogger
+	n   int
+}
+
+func (dr *dryRunTracker) Create(_ context.Context, issue Issue) (string, error) {
+	dr.n++
+	id := fmt.Sprintf("dry-run-%d", dr.n)
+	dr.log.WithFields(logrus.Fields{
+		"id":     id,
+		"title":  issue.Title,
+		"labels": issue.Labels,
+	}).Info("Would file issue")
+	return id, nil
+}
+
+func (dr *dryRunTracker) Comment(_ context.Context, id, body string) error {
+	dr.log.WithField("id", id).Info("Would comment on issue")
+	return nil
+}
+
+func (dr *dryRunTracker) Close(_ context.Context, id, comment string) error {
+	dr.log.WithField("id", id).Info("Would close issue")
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package issuestate reads and writes the issue state of test groups,
+// which the autobugger saves and the updater links to rows.
+package issuestate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/protobuf/proto"
+
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// Path returns the path to the issue state of a test group, relative to the config.
+func Path(configPath gcs.Path, issuePrefix, groupName string) (*gcs.Path, error) {
+	name := path.Join(issuePrefix, groupName)
+	u, err := url.Parse(name)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url %s: %w", name, err)
+	}
+	np, err := configPath.ResolveReference(u)
+	if err != nil {
+		return nil, fmt.Errorf("resolve reference: %w", err)
+	}
+	if np.Bucket() != configPath.Bucket() {
+		return nil, fmt.Errorf("issue state %s should not change bucket", name)
+	}
+	return np, nil
+}
+
+// Read loads the issue state at path.
+//
+// Returns an empty state if the object does not exist.
+func Read(ctx context.Context, client gcs.Opener, path gcs.Path) (*issuepb.IssueState, error) {
+	var state issuepb.IssueState
+	r, _, err := client.Open(ctx, path)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return &state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+	defer r.Close()
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	if err := proto.Unmarshal(buf, &state); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+	return &state, nil
+}
+
+// Write saves the issue state to path.
+func Write(ctx context.Context, client gcs.Uploader, path gcs.Path, state *issuepb.IssueState) error {
+	buf, err := proto.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+	if _, err := client.Upload(ctx, path, buf, gcs.DefaultACL, gcs.NoCache); err != nil {
+		return fmt.Errorf("upload: %w", err)
+	}
+	return nil
+}
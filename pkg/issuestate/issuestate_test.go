@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuestate
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+)
+
+func mustPath(s string) gcs.Path {
+	p, err := gcs.NewPath(s)
+	if err != nil {
+		panic(err)
+	}
+	return *p
+}
+
+func TestPath(t *testing.T) {
+	configPath := mustPath("gs://bucket/config")
+	cases := []struct {
+		name     string
+		prefix   string
+		group    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "basically works",
+			prefix:   "issues",
+			group:    "group",
+			expected: "gs://bucket/issues/group",
+		},
+		{
+			name:     "no prefix",
+			group:    "group",
+			expected: "gs://bucket/group",
+		},
+		{
+			name:    "reject other buckets",
+			prefix:  "gs://other",
+			group:   "group",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Path(configPath, tc.prefix, tc.group)
+			switch {
+			case err != nil:
+				if !tc.wantErr {
+					t.Errorf("Path() got unexpected error: %v", err)
+				}
+			case tc.wantErr:
+				t.Error("Path() failed to return an error")
+			case got.String() != tc.expected:
+				t.Errorf("Path() got %q, want %q", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestReadWrite(t *testing.T) {
+	path := mustPath("gs://bucket/issues/group")
+	state := &issuepb.IssueState{
+		IssueInfo: []*issuepb.IssueInfo{
+			{IssueId: "1", IsAutobug: true, RowIds: []string{"a"}},
+		},
+	}
+
+	ctx := context.Background()
+	uploader := fake.Uploader{}
+	if err := Write(ctx, uploader, path, state); err != nil {
+		t.Fatalf("Write() got unexpected error: %v", err)
+	}
+	opener := fake.Opener{
+		path: {Data: string(uploader[path].Buf)},
+	}
+	got, err := Read(ctx, opener, path)
+	if err != nil {
+		t.Fatalf("Read() got unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(state, got, protocmp.Transform()); diff != "" {
+		t.Errorf("Read() got unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestRead(t *testing.T) {
+	path := mustPath("gs://bucket/issues/group")
+	cases := []struct {
+		name    string
+		opener  fake.Opener
+		want    *issuepb.IssueState
+		wantErr bool
+	}{
+		{
+			name:   "missing",
+			opener: fake.Opener{},
+			want:   &issuepb.IssueState{},
+		},
+		{
+			name: "open error",
+			opener: fake.Opener{
+				path: {OpenErr: errors.New("injected")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "corrupt",
+			opener: fake.Opener{
+				path: {Data: "\xff\xff"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Read(context.Background(), tc.opener, path)
+			switch {
+			case err != nil:
+				if !tc.wantErr {
+					t.Errorf("Read() got unexpected error: %v", err)
+				}
+			case tc.wantErr:
+				t.Error("Read() failed to return an error")
+			default:
+				if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("Read() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -27,6 +27,9 @@ const analyzerName = "flipanalyzer"
 // FlipAnalyzer implements functions that calculate flakiness as a ratio of failed tests to total tests
 type FlipAnalyzer struct {
 	RelevantStatus map[string][]StatusCategory
+	// Members optionally maps the name of a group to the RelevantStatus keys of its tests.
+	// Groups combine the flips of their members rather than using RelevantStatus.
+	Members map[string][]string
 }
 
 // StatusCategory is a simiplified status that allows only "Pass", "Fail", and "Flaky"
@@ -47,7 +50,11 @@ func (ea *FlipAnalyzer) GetFlakiness(gridMetrics []*common.GridMetrics, minRuns
 	healthinessInfo := ba.GetFlakiness(gridMetrics, minRuns, startDate, endDate, tab)
 	var averageFlakiness float32
 	for _, test := range healthinessInfo.Tests {
-		test.Flakiness = calculateFlipFlakiness(ea.RelevantStatus[test.DisplayName])
+		if members, ok := ea.Members[test.DisplayName]; ok {
+			test.Flakiness = calculateGroupFlipFlakiness(ea.RelevantStatus, members)
+		} else {
+			test.Flakiness = calculateFlipFlakiness(ea.RelevantStatus[test.DisplayName])
+		}
 		averageFlakiness += test.Flakiness
 	}
 	if len(healthinessInfo.Tests) == 0 {
@@ -74,6 +81,29 @@ func consecutiveFailures(statuses []StatusCategory, i int) int {
 // statuses should have already filtered to the correct time horizon and removed infra failures
 // Returns a percentage between 0 and 100
 func calculateFlipFlakiness(statuses []StatusCategory) float32 {
+	flips, considered := countFlips(statuses)
+	if considered == 0 {
+		return 0
+	}
+	return 100 * float32(flips) / float32(considered)
+}
+
+// calculateGroupFlipFlakiness is the percentage of flips across the statuses of every member.
+func calculateGroupFlipFlakiness(statuses map[string][]StatusCategory, members []string) float32 {
+	var flips, considered int
+	for _, m := range members {
+		f, c := countFlips(statuses[m])
+		flips += f
+		considered += c
+	}
+	if considered == 0 {
+		return 0
+	}
+	return 100 * float32(flips) / float32(considered)
+}
+
+// countFlips returns the number of flips to failing along with the number of statuses considered.
+func countFlips(statuses []StatusCategory) (int, int) {
 	var flips int
 	var considered int
 	lastPassing := true // No flakes if we pass 100%
@@ -104,9 +134,5 @@ func calculateFlipFlakiness(statuses []StatusCategory) float32 {
 		}
 		i++
 	}
-	if considered == 0 {
-		return 0
-	}
-	return 100 * float32(flips) / float32(considered)
-
+	return flips, considered
 }
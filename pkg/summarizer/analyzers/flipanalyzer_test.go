@@ -142,3 +142,45 @@ func TestCalculateFlipFlakiness(t *testing.T) {
 		})
 	}
 }
+
+func TestCalculateGroupFlipFlakiness(t *testing.T) {
+	p := StatusPass
+	f := StatusFail
+	cases := []struct {
+		name     string
+		statuses map[string][]StatusCategory
+		members  []string
+		expected float32
+	}{
+		{
+			name:     "No members",
+			expected: 0,
+		},
+		{
+			name: "Single member",
+			statuses: map[string][]StatusCategory{
+				"a": {p, f, p, p},
+			},
+			members:  []string{"a"},
+			expected: 25,
+		},
+		{
+			name: "Members combine flips and runs",
+			statuses: map[string][]StatusCategory{
+				"a": {p, f, p, p},
+				"b": {p, p, p, p, p, p},
+				"c": {f},
+			},
+			members:  []string{"a", "b"},
+			expected: 10,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := calculateGroupFlipFlakiness(tc.statuses, tc.members); !almostEqual(actual, tc.expected) {
+				t.Errorf("calculateGroupFlipFlakiness(%+v, %v)=%v; expected %v", tc.statuses, tc.members, actual, tc.expected)
+			}
+		})
+	}
+}
@@ -34,3 +34,18 @@ func NewGridMetrics(name string) *GridMetrics {
 	gridMetrics := GridMetrics{Name: name, InfraFailures: make(map[string]int, 0)}
 	return &gridMetrics
 }
+
+// Add accumulates the counts of other into gm, for aggregating a group of tests.
+func (gm *GridMetrics) Add(other *GridMetrics) {
+	if other.FlakyCount > 0 {
+		total := gm.AverageFlakiness*float64(gm.FlakyCount) + other.AverageFlakiness*float64(other.FlakyCount)
+		gm.AverageFlakiness = total / float64(gm.FlakyCount+other.FlakyCount)
+	}
+	gm.Passed += other.Passed
+	gm.Failed += other.Failed
+	gm.FlakyCount += other.FlakyCount
+	gm.FailedInfraCount += other.FailedInfraCount
+	for msg, n := range other.InfraFailures {
+		gm.InfraFailures[msg] += n
+	}
+}
@@ -18,6 +18,7 @@ package summarizer
 
 import (
 	"regexp"
+	"sort"
 
 	"github.com/GoogleCloudPlatform/testgrid/internal/result"
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
@@ -25,6 +26,7 @@ import (
 	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer/analyzers"
 	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer/common"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 )
 
 const (
@@ -46,11 +48,79 @@ type flakinessAnalyzer interface {
 // of the Grid and then analyzes it with an implementation of flakinessAnalyzer, which has
 // implementations in the subdir naive and can be injected as needed.
 func CalculateHealthiness(grid *statepb.Grid, startTime int, endTime int, tab string) *summarypb.HealthinessInfo {
+	return calculateHealthiness(grid, startTime, endTime, tab, nil)
+}
+
+// calculateHealthiness works like CalculateHealthiness, additionally populating the Groups
+// field with the tests aggregated by groupingRegex (if any).
+func calculateHealthiness(grid *statepb.Grid, startTime int, endTime int, tab string, groupingRegex *regexp.Regexp) *summarypb.HealthinessInfo {
 	gridMetrics, relevantFilteredStatus := parseGrid(grid, startTime, endTime)
 	analyzer := analyzers.FlipAnalyzer{
 		RelevantStatus: relevantFilteredStatus,
 	}
-	return analyzer.GetFlakiness(gridMetrics, minRuns, startTime, endTime, tab)
+	healthiness := analyzer.GetFlakiness(gridMetrics, minRuns, startTime, endTime, tab)
+	if groupingRegex == nil {
+		return healthiness
+	}
+	groupMetrics, members := groupGridMetrics(gridMetrics, groupingRegex)
+	if len(groupMetrics) == 0 {
+		return healthiness
+	}
+	groupAnalyzer := analyzers.FlipAnalyzer{
+		RelevantStatus: relevantFilteredStatus,
+		Members:        members,
+	}
+	healthiness.Groups = groupAnalyzer.GetFlakiness(groupMetrics, minRuns, startTime, endTime, tab).Tests
+	return healthiness
+}
+
+// groupName returns the name of the group the test belongs to, or false if it is not in a group.
+//
+// The group is the first capture group when the regex has one, and otherwise the test name with
+// the match removed (like group-by-regex-mask).
+func groupName(re *regexp.Regexp, name string) (string, bool) {
+	loc := re.FindStringSubmatchIndex(name)
+	if loc == nil {
+		return "", false
+	}
+	if re.NumSubexp() > 0 {
+		if loc[2] < 0 || loc[2] == loc[3] {
+			return "", false
+		}
+		return name[loc[2]:loc[3]], true
+	}
+	if loc[0] == loc[1] {
+		return "", false
+	}
+	return name[:loc[0]] + name[loc[1]:], true
+}
+
+// groupGridMetrics aggregates the metrics of tests in the same group,
+// along with the names of the tests in each group.
+func groupGridMetrics(gridMetrics []*common.GridMetrics, re *regexp.Regexp) ([]*common.GridMetrics, map[string][]string) {
+	groups := map[string]*common.GridMetrics{}
+	members := map[string][]string{}
+	for _, metric := range gridMetrics {
+		name, ok := groupName(re, metric.Name)
+		if !ok {
+			continue
+		}
+		group, ok := groups[name]
+		if !ok {
+			group = common.NewGridMetrics(name)
+			groups[name] = group
+		}
+		group.Add(metric)
+		members[name] = append(members[name], metric.Name)
+	}
+	out := make([]*common.GridMetrics, 0, len(groups))
+	for _, group := range groups {
+		out = append(out, group)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Name < out[j].Name
+	})
+	return out, members
 }
 
 // CalculateTrend populates the ChangeFromLastInterval fields of each TestInfo by comparing
@@ -71,6 +141,17 @@ func CalculateTrend(currentHealthiness, previousHealthiness *summarypb.Healthine
 			currentHealthiness.Tests[i].PreviousFlakiness = []float32{value}
 		}
 	}
+
+	previousGroupFlakiness := map[string]float32{}
+	for _, group := range previousHealthiness.Groups {
+		previousGroupFlakiness[group.DisplayName] = group.Flakiness
+	}
+	for _, group := range currentHealthiness.Groups {
+		if value, ok := previousGroupFlakiness[group.DisplayName]; ok {
+			group.ChangeFromLastInterval = getTrend(group.Flakiness, value)
+			group.PreviousFlakiness = []float32{value}
+		}
+	}
 }
 
 func getTrend(currentFlakiness, previousFlakiness float32) summarypb.TestInfo_Trend {
@@ -115,6 +196,7 @@ func parseGrid(grid *statepb.Grid, startTime int, endTime int) ([]*common.GridMe
 	// result.Map is written in a way that assumes each test/row name is unique
 	rowResults := result.Map(grid.Rows)
 	failingColumns := failingColumns(len(grid.Columns), grid.Rows)
+	infraColumns := infraColumns(len(grid.Columns), grid.Rows)
 
 	for key, f := range rowResults {
 		if !isValidTestName(key) {
@@ -149,7 +231,10 @@ func parseGrid(grid *statepb.Grid, startTime int, endTime int) ([]*common.GridMe
 				continue
 			case statuspb.TestStatus_FAIL:
 				message := gridRows[key].Messages[rowToMessageIndex]
-				if isInfraFailure(message) {
+				if category := infraColumns[i]; category != gcs.NoFailure {
+					gridMetricsMap[key].FailedInfraCount++
+					gridMetricsMap[key].InfraFailures[string(category)]++
+				} else if isInfraFailure(message) {
 					gridMetricsMap[key].FailedInfraCount++
 					gridMetricsMap[key].InfraFailures[message]++
 				} else {
@@ -203,6 +288,36 @@ func failingColumns(numColumns int, rows []*statepb.Row) []bool {
 	return out
 }
 
+// infraColumns returns the infrastructure failure category of each column,
+// according to the failing cells that the updater classified from the pod.
+//
+// Columns without an infrastructure failure are gcs.NoFailure.
+func infraColumns(numColumns int, rows []*statepb.Row) []gcs.FailureCategory {
+	out := make([]gcs.FailureCategory, numColumns)
+	for _, row := range rows {
+		if len(row.Properties) == 0 {
+			continue
+		}
+		iter := result.Iter(row.Results)
+		var filledIdx int
+		for i := 0; i < numColumns && filledIdx < len(row.Properties); i++ {
+			rr, more := iter()
+			if !more {
+				break
+			}
+			if rr == statuspb.TestStatus_NO_RESULT {
+				continue
+			}
+			category := gcs.FailureCategory(row.Properties[filledIdx].GetProperty()[gcs.FailureCategoryKey])
+			if result.Failing(rr) && category.Infra() {
+				out[i] = category
+			}
+			filledIdx++
+		}
+	}
+	return out
+}
+
 func isInfraFailure(message string) bool {
 	return (message != "" && infraRegex.MatchString(message))
 }
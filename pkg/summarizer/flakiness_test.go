@@ -17,6 +17,7 @@ limitations under the License.
 package summarizer
 
 import (
+	"regexp"
 	"testing"
 
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
@@ -24,6 +25,7 @@ import (
 	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer/analyzers"
 	"github.com/GoogleCloudPlatform/testgrid/pkg/summarizer/common"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 	"github.com/golang/protobuf/proto"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -93,6 +95,43 @@ func TestCalculateTrend(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "groups get trends",
+			currentHealthiness: &summarypb.HealthinessInfo{
+				Groups: []*summarypb.TestInfo{
+					{
+						DisplayName: "group_should_be_UP",
+						Flakiness:   20.0,
+					},
+					{
+						DisplayName: "new_group",
+						Flakiness:   10.0,
+					},
+				},
+			},
+			previousHealthiness: &summarypb.HealthinessInfo{
+				Groups: []*summarypb.TestInfo{
+					{
+						DisplayName: "group_should_be_UP",
+						Flakiness:   10.0,
+					},
+				},
+			},
+			expected: &summarypb.HealthinessInfo{
+				Groups: []*summarypb.TestInfo{
+					{
+						DisplayName:            "group_should_be_UP",
+						Flakiness:              20.0,
+						PreviousFlakiness:      []float32{10.0},
+						ChangeFromLastInterval: summarypb.TestInfo_UP,
+					},
+					{
+						DisplayName: "new_group",
+						Flakiness:   10.0,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -122,6 +161,74 @@ func TestCalculateTrend(t *testing.T) {
 	}
 }
 
+func TestGroupName(t *testing.T) {
+	cases := []struct {
+		name     string
+		regex    string
+		test     string
+		expected string
+		grouped  bool
+	}{
+		{
+			name:     "mask removes the match",
+			regex:    ` - \w+`,
+			test:     "//path/to/test - env",
+			expected: "//path/to/test",
+			grouped:  true,
+		},
+		{
+			name:  "mask without a match is not grouped",
+			regex: ` - \w+`,
+			test:  "//path/to/test",
+		},
+		{
+			name:     "capture group names the group",
+			regex:    `^\[(sig-\w+)\]`,
+			test:     "[sig-node] Pods should work",
+			expected: "sig-node",
+			grouped:  true,
+		},
+		{
+			name:  "empty capture is not grouped",
+			regex: `^(\w*)/`,
+			test:  "/path",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, grouped := groupName(regexp.MustCompile(tc.regex), tc.test)
+			if got != tc.expected || grouped != tc.grouped {
+				t.Errorf("groupName(%q, %q) got (%q, %t), want (%q, %t)", tc.regex, tc.test, got, grouped, tc.expected, tc.grouped)
+			}
+		})
+	}
+}
+
+func TestGroupGridMetrics(t *testing.T) {
+	metrics := []*common.GridMetrics{
+		{Name: "[sig-node] a", Passed: 3, Failed: 1, InfraFailures: map[string]int{"timeout": 1}, FailedInfraCount: 1},
+		{Name: "[sig-apps] b", Passed: 1},
+		{Name: "[sig-node] c", Passed: 1, Failed: 1, FlakyCount: 2, AverageFlakiness: 50, InfraFailures: map[string]int{"timeout": 2}, FailedInfraCount: 2},
+		{Name: "ungrouped", Passed: 5},
+	}
+	gotMetrics, gotMembers := groupGridMetrics(metrics, regexp.MustCompile(`^\[(sig-\w+)\]`))
+	wantMetrics := []*common.GridMetrics{
+		{Name: "sig-apps", Passed: 1, InfraFailures: map[string]int{}},
+		{Name: "sig-node", Passed: 4, Failed: 2, FlakyCount: 2, AverageFlakiness: 50, FailedInfraCount: 3, InfraFailures: map[string]int{"timeout": 3}},
+	}
+	wantMembers := map[string][]string{
+		"sig-apps": {"[sig-apps] b"},
+		"sig-node": {"[sig-node] a", "[sig-node] c"},
+	}
+	if diff := cmp.Diff(wantMetrics, gotMetrics); diff != "" {
+		t.Errorf("groupGridMetrics() got unexpected metrics (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(wantMembers, gotMembers); diff != "" {
+		t.Errorf("groupGridMetrics() got unexpected members (-want +got):\n%s", diff)
+	}
+}
+
 func TestGetTrend(t *testing.T) {
 	cases := []struct {
 		name              string
@@ -480,6 +587,66 @@ func TestParseGrid(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "failures in columns with infra failures are infra failures",
+			grid: &statepb.Grid{
+				Columns: []*statepb.Column{
+					{Started: 0},
+					{Started: 1000},
+				},
+				Rows: []*statepb.Row{
+					{
+						Name: "job.Overall",
+						Results: []int32{
+							statuspb.TestStatus_value["PASS"], 1,
+							statuspb.TestStatus_value["FAIL"], 1,
+						},
+						Messages: []string{"", ""},
+						CellIds:  []string{"", ""},
+						Properties: []*statepb.Property{
+							{},
+							{Property: map[string]string{gcs.FailureCategoryKey: string(gcs.OOMKilled)}},
+						},
+					},
+					{
+						Name: "test_1",
+						Results: []int32{
+							statuspb.TestStatus_value["PASS"], 1,
+							statuspb.TestStatus_value["FAIL"], 1,
+						},
+						Messages: []string{"", "context deadline exceeded"},
+					},
+				},
+			},
+			startTime: 0,
+			endTime:   2,
+			expectedMetrics: []*common.GridMetrics{
+				{
+					Name:             "job.Overall",
+					Passed:           1,
+					FailedInfraCount: 1,
+					InfraFailures: map[string]int{
+						string(gcs.OOMKilled): 1,
+					},
+				},
+				{
+					Name:             "test_1",
+					Passed:           1,
+					FailedInfraCount: 1,
+					InfraFailures: map[string]int{
+						string(gcs.OOMKilled): 1,
+					},
+				},
+			},
+			expectedFilteredStatus: map[string][]analyzers.StatusCategory{
+				"job.Overall": {
+					analyzers.StatusPass,
+				},
+				"test_1": {
+					analyzers.StatusPass,
+				},
+			},
+		},
 	}
 
 	metricsSort := func(x *common.GridMetrics, y *common.GridMetrics) bool {
@@ -641,3 +808,75 @@ func TestFailingColumns(t *testing.T) {
 		})
 	}
 }
+
+func TestInfraColumns(t *testing.T) {
+	p := statuspb.TestStatus_value["PASS"]
+	f := statuspb.TestStatus_value["FAIL"]
+	n := statuspb.TestStatus_value["NO_RESULT"]
+	category := func(fc gcs.FailureCategory) *statepb.Property {
+		return &statepb.Property{
+			Property: map[string]string{gcs.FailureCategoryKey: string(fc)},
+		}
+	}
+	cases := []struct {
+		name       string
+		rows       []*statepb.Row
+		numColumns int
+		expected   []gcs.FailureCategory
+	}{
+		{
+			name:       "basically works",
+			numColumns: 2,
+			expected:   []gcs.FailureCategory{gcs.NoFailure, gcs.NoFailure},
+		},
+		{
+			name: "infra failures",
+			rows: []*statepb.Row{
+				{
+					Name:    "job.Overall",
+					Results: []int32{f, 2, n, 1, f, 1, p, 1},
+					Properties: []*statepb.Property{
+						category(gcs.Evicted),
+						category(gcs.TestFailure),
+						category(gcs.OOMKilled),
+						{},
+					},
+				},
+				{
+					Name:    "//test1 - [env1]",
+					Results: []int32{f, 5},
+				},
+			},
+			numColumns: 5,
+			expected: []gcs.FailureCategory{
+				gcs.Evicted,
+				gcs.NoFailure,
+				gcs.NoFailure,
+				gcs.OOMKilled,
+				gcs.NoFailure,
+			},
+		},
+		{
+			name: "ignore passing cells",
+			rows: []*statepb.Row{
+				{
+					Name:    "job.Pod",
+					Results: []int32{p, 1},
+					Properties: []*statepb.Property{
+						category(gcs.OOMKilled),
+					},
+				},
+			},
+			numColumns: 1,
+			expected:   []gcs.FailureCategory{gcs.NoFailure},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := infraColumns(tc.numColumns, tc.rows)
+			if diff := cmp.Diff(tc.expected, actual); diff != "" {
+				t.Errorf("infraColumns(%v %v) gave unexpected diff (-want +got): %s", tc.numColumns, tc.rows, diff)
+			}
+		})
+	}
+}
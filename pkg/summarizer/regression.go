@@ -0,0 +1,195 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package summarizer
+
+import (
+	"math"
+	"sort"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+)
+
+const (
+	defaultRegressionColumns = 50
+	defaultMinSegment        = 5
+	defaultMinRelativeChange = 0.1
+	defaultMinScore          = 5
+)
+
+// metricValues returns the sparse-encoded values of the metric in the first n columns, by column index.
+func metricValues(metric *statepb.Metric, n int) map[int]float64 {
+	values := map[int]float64{}
+	var v int
+	for i := 0; i+1 < len(metric.Indices); i += 2 {
+		start, count := int(metric.Indices[i]), int(metric.Indices[i+1])
+		for col := start; col < start+count; col++ {
+			if v == len(metric.Values) {
+				return values
+			}
+			if col < n {
+				values[col] = metric.Values[v]
+			}
+			v++
+		}
+	}
+	return values
+}
+
+// changePoint describes the split of a series into values before and after a step change.
+type changePoint struct {
+	index  int     // first value after the change
+	before float64 // mean of values before the change
+	after  float64 // mean of values after the change
+	score  float64 // difference in means divided by its standard error
+}
+
+// findChangePoint returns the split which best separates the means of the values
+// before and after it, leaving at least minSegment values on each side.
+//
+// Splits are scored by their (pooled) two-sample t-statistic.
+// Returns nil when there are too few values to split.
+func findChangePoint(values []float64, minSegment int) *changePoint {
+	if minSegment < 1 {
+		minSegment = 1
+	}
+	n := len(values)
+	if n < 2*minSegment {
+		return nil
+	}
+	// Prefix sums so each split is scored in constant time.
+	sums := make([]float64, n+1)
+	squares := make([]float64, n+1)
+	for i, v := range values {
+		sums[i+1] = sums[i] + v
+		squares[i+1] = squares[i] + v*v
+	}
+
+	var best *changePoint
+	for k := minSegment; k <= n-minSegment; k++ {
+		n1, n2 := float64(k), float64(n-k)
+		before := sums[k] / n1
+		after := (sums[n] - sums[k]) / n2
+		// Sum of squared deviations from each segment's mean.
+		ss := squares[k] - n1*before*before + (squares[n] - squares[k]) - n2*after*after
+		if ss < 0 { // Rounding error
+			ss = 0
+		}
+		diff := math.Abs(after - before)
+		var score float64
+		switch {
+		case diff == 0:
+			score = 0
+		case ss == 0 || n1+n2 <= 2:
+			score = math.Inf(1)
+		default:
+			stddev := math.Sqrt(ss / (n1 + n2 - 2))
+			score = diff / (stddev * math.Sqrt(1/n1+1/n2))
+		}
+		if best == nil || score > best.score {
+			best = &changePoint{
+				index:  k,
+				before: before,
+				after:  after,
+				score:  score,
+			}
+		}
+	}
+	return best
+}
+
+// regressed returns true when the change is in the direction of a regression.
+func regressed(direction configpb.MetricRegressionOptions_Direction, before, after float64) bool {
+	switch direction {
+	case configpb.MetricRegressionOptions_DECREASE:
+		return after < before
+	case configpb.MetricRegressionOptions_ANY:
+		return after != before
+	default:
+		return after > before
+	}
+}
+
+// metricRegressions returns the step changes in each row's metrics over the most recent columns.
+//
+// Regressions are sorted by the magnitude of their relative change.
+func metricRegressions(grid *statepb.Grid, opts *configpb.MetricRegressionOptions) []*summarypb.MetricRegression {
+	cols := firstFilled(opts.GetColumns(), defaultRegressionColumns)
+	if cols > len(grid.Columns) {
+		cols = len(grid.Columns)
+	}
+	minSegment := firstFilled(opts.GetMinSegment(), defaultMinSegment)
+	minChange := float64(opts.GetMinRelativeChange())
+	if minChange == 0 {
+		minChange = defaultMinRelativeChange
+	}
+	minScore := float64(opts.GetMinScore())
+	if minScore == 0 {
+		minScore = defaultMinScore
+	}
+	var wanted map[string]bool
+	if names := opts.GetMetrics(); len(names) > 0 {
+		wanted = make(map[string]bool, len(names))
+		for _, name := range names {
+			wanted[name] = true
+		}
+	}
+
+	var out []*summarypb.MetricRegression
+	for _, row := range grid.Rows {
+		for _, metric := range row.Metrics {
+			if wanted != nil && !wanted[metric.Name] {
+				continue
+			}
+			values := metricValues(metric, cols)
+			// Columns are sorted newest first, so walk backwards to get the series in order.
+			var indices []int
+			var series []float64
+			for col := cols - 1; col >= 0; col-- {
+				if v, ok := values[col]; ok {
+					indices = append(indices, col)
+					series = append(series, v)
+				}
+			}
+			cp := findChangePoint(series, minSegment)
+			if cp == nil || cp.before == 0 || cp.score < minScore || !regressed(opts.GetDirection(), cp.before, cp.after) {
+				continue
+			}
+			change := (cp.after - cp.before) / math.Abs(cp.before)
+			if math.Abs(change) < minChange {
+				continue
+			}
+			first, previous := grid.Columns[indices[cp.index]], grid.Columns[indices[cp.index-1]]
+			out = append(out, &summarypb.MetricRegression{
+				DisplayName:     row.Name,
+				Metric:          metric.Name,
+				BuildId:         first.Build,
+				Timestamp:       first.Started / 1000,
+				PreviousBuildId: previous.Build,
+				PreviousMean:    cp.before,
+				CurrentMean:     cp.after,
+				RelativeChange:  change,
+				Score:           cp.score,
+			})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return math.Abs(out[i].RelativeChange) > math.Abs(out[j].RelativeChange)
+	})
+	return out
+}
@@ -0,0 +1,338 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package summarizer
+
+import (
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	summarypb "github.com/GoogleCloudPlatform/testgrid/pb/summary"
+)
+
+func TestMetricValues(t *testing.T) {
+	cases := []struct {
+		name   string
+		metric *statepb.Metric
+		n      int
+		want   map[int]float64
+	}{
+		{
+			name:   "basically works",
+			metric: &statepb.Metric{},
+			n:      10,
+			want:   map[int]float64{},
+		},
+		{
+			name: "sparse values",
+			metric: &statepb.Metric{
+				Indices: []int32{0, 2, 6, 4},
+				Values:  []float64{0.1, 0.2, 6.1, 6.2, 6.3, 6.4},
+			},
+			n: 12,
+			want: map[int]float64{
+				0: 0.1,
+				1: 0.2,
+				6: 6.1,
+				7: 6.2,
+				8: 6.3,
+				9: 6.4,
+			},
+		},
+		{
+			name: "only first n columns",
+			metric: &statepb.Metric{
+				Indices: []int32{0, 2, 6, 4},
+				Values:  []float64{0.1, 0.2, 6.1, 6.2, 6.3, 6.4},
+			},
+			n: 7,
+			want: map[int]float64{
+				0: 0.1,
+				1: 0.2,
+				6: 6.1,
+			},
+		},
+		{
+			name: "missing values",
+			metric: &statepb.Metric{
+				Indices: []int32{1, 3},
+				Values:  []float64{1.1},
+			},
+			n: 10,
+			want: map[int]float64{
+				1: 1.1,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := metricValues(tc.metric, tc.n)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("metricValues() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFindChangePoint(t *testing.T) {
+	cases := []struct {
+		name       string
+		values     []float64
+		minSegment int
+		want       *changePoint
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name:       "too few values",
+			values:     []float64{1, 1, 1, 2, 2},
+			minSegment: 3,
+		},
+		{
+			name:       "step up",
+			values:     []float64{10, 11, 9, 10, 20, 21, 19, 20},
+			minSegment: 2,
+			want: &changePoint{
+				index:  4,
+				before: 10,
+				after:  20,
+				score:  10 / (math.Sqrt(4.0/6) * math.Sqrt(0.5)),
+			},
+		},
+		{
+			name:       "constant segments",
+			values:     []float64{5, 5, 5, 3, 3, 3},
+			minSegment: 3,
+			want: &changePoint{
+				index:  3,
+				before: 5,
+				after:  3,
+				score:  math.Inf(1),
+			},
+		},
+		{
+			name:       "no change",
+			values:     []float64{4, 4, 4, 4},
+			minSegment: 1,
+			want: &changePoint{
+				index:  1,
+				before: 4,
+				after:  4,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := findChangePoint(tc.values, tc.minSegment)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(changePoint{}), cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+				t.Errorf("findChangePoint() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestMetricRegressions(t *testing.T) {
+	// series returns a metric with the values of each column, oldest first.
+	series := func(name string, values ...float64) *statepb.Metric {
+		metric := statepb.Metric{
+			Name:    name,
+			Indices: []int32{0, int32(len(values))},
+		}
+		for i := len(values) - 1; i >= 0; i-- {
+			metric.Values = append(metric.Values, values[i])
+		}
+		return &metric
+	}
+	// columns returns n columns, newest first, built every minute.
+	columns := func(n int) []*statepb.Column {
+		var cols []*statepb.Column
+		for i := n; i > 0; i-- {
+			cols = append(cols, &statepb.Column{
+				Build:   strconv.Itoa(i),
+				Started: float64(i * 60000),
+			})
+		}
+		return cols
+	}
+	slower := []float64{10, 11, 10, 9, 10, 10, 11, 10, 20, 21, 20, 19, 20, 20}
+	faster := []float64{100, 101, 99, 100, 100, 99, 101, 100, 50, 51, 49, 50, 50, 50}
+	steady := []float64{10, 11, 10, 9, 10, 10, 11, 10, 10, 11, 10, 9, 10, 10}
+
+	cases := []struct {
+		name string
+		rows []*statepb.Row
+		opts *configpb.MetricRegressionOptions
+		want []*summarypb.MetricRegression
+	}{
+		{
+			name: "basically works",
+			opts: &configpb.MetricRegressionOptions{},
+		},
+		{
+			name: "detect increase",
+			rows: []*statepb.Row{
+				{
+					Name: "slower",
+					Metrics: []*statepb.Metric{
+						series("test-duration-minutes", slower...),
+						series("qps", faster...),
+					},
+				},
+				{
+					Name:    "steady",
+					Metrics: []*statepb.Metric{series("test-duration-minutes", steady...)},
+				},
+			},
+			opts: &configpb.MetricRegressionOptions{},
+			want: []*summarypb.MetricRegression{
+				{
+					DisplayName:     "slower",
+					Metric:          "test-duration-minutes",
+					BuildId:         "9",
+					PreviousBuildId: "8",
+					Timestamp:       540,
+					PreviousMean:    10.125,
+					CurrentMean:     20,
+					RelativeChange:  (20 - 10.125) / 10.125,
+				},
+			},
+		},
+		{
+			name: "detect decrease of selected metric",
+			rows: []*statepb.Row{
+				{
+					Name: "slower",
+					Metrics: []*statepb.Metric{
+						series("test-duration-minutes", slower...),
+						series("qps", faster...),
+					},
+				},
+			},
+			opts: &configpb.MetricRegressionOptions{
+				Metrics:   []string{"qps"},
+				Direction: configpb.MetricRegressionOptions_DECREASE,
+			},
+			want: []*summarypb.MetricRegression{
+				{
+					DisplayName:     "slower",
+					Metric:          "qps",
+					BuildId:         "9",
+					PreviousBuildId: "8",
+					Timestamp:       540,
+					PreviousMean:    100,
+					CurrentMean:     50,
+					RelativeChange:  -0.5,
+				},
+			},
+		},
+		{
+			name: "detect any change, largest first",
+			rows: []*statepb.Row{
+				{
+					Name: "slower",
+					Metrics: []*statepb.Metric{
+						series("test-duration-minutes", slower...),
+						series("qps", faster...),
+					},
+				},
+			},
+			opts: &configpb.MetricRegressionOptions{
+				Direction: configpb.MetricRegressionOptions_ANY,
+			},
+			want: []*summarypb.MetricRegression{
+				{
+					DisplayName:     "slower",
+					Metric:          "test-duration-minutes",
+					BuildId:         "9",
+					PreviousBuildId: "8",
+					Timestamp:       540,
+					PreviousMean:    10.125,
+					CurrentMean:     20,
+					RelativeChange:  (20 - 10.125) / 10.125,
+				},
+				{
+					DisplayName:     "slower",
+					Metric:          "qps",
+					BuildId:         "9",
+					PreviousBuildId: "8",
+					Timestamp:       540,
+					PreviousMean:    100,
+					CurrentMean:     50,
+					RelativeChange:  -0.5,
+				},
+			},
+		},
+		{
+			name: "ignore small changes",
+			rows: []*statepb.Row{
+				{
+					Name:    "slower",
+					Metrics: []*statepb.Metric{series("test-duration-minutes", slower...)},
+				},
+			},
+			opts: &configpb.MetricRegressionOptions{
+				MinRelativeChange: 1.5,
+			},
+		},
+		{
+			name: "ignore changes before the analyzed columns",
+			rows: []*statepb.Row{
+				{
+					Name:    "slower",
+					Metrics: []*statepb.Metric{series("test-duration-minutes", slower...)},
+				},
+			},
+			opts: &configpb.MetricRegressionOptions{
+				Columns: 6,
+			},
+		},
+		{
+			name: "require enough values on each side of the change",
+			rows: []*statepb.Row{
+				{
+					Name:    "slower",
+					Metrics: []*statepb.Metric{series("test-duration-minutes", slower...)},
+				},
+			},
+			opts: &configpb.MetricRegressionOptions{
+				MinSegment: 8,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			grid := &statepb.Grid{
+				Columns: columns(14),
+				Rows:    tc.rows,
+			}
+			got := metricRegressions(grid, tc.opts)
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform(), protocmp.IgnoreFields(&summarypb.MetricRegression{}, "score"), cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+				t.Errorf("metricRegressions() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
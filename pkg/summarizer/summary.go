@@ -710,7 +710,13 @@ func updateTab(ctx context.Context, tab *configpb.DashboardTab, group *configpb.
 		if interval <= 0 {
 			interval = DefaultInterval
 		}
-		healthiness = getHealthinessForInterval(grid, tab.Name, time.Now(), interval)
+		var groupingRegex *regexp.Regexp
+		if expr := tab.HealthAnalysisOptions.GroupingRegex; expr != "" {
+			if groupingRegex, err = regexp.Compile(expr); err != nil {
+				return nil, fmt.Errorf("grouping_regex: %v", err)
+			}
+		}
+		healthiness = getHealthinessForInterval(grid, tab.Name, time.Now(), interval, groupingRegex)
 	}
 
 	recent := recentColumns(tab, group)
@@ -718,6 +724,11 @@ func updateTab(ctx context.Context, tab *configpb.DashboardTab, group *configpb.
 
 	grid.Rows = filterMethods(grid.Rows)
 
+	var regressions []*summarypb.MetricRegression
+	if opts := tab.GetMetricRegressionOptions(); opts.GetEnable() {
+		regressions = metricRegressions(grid, opts)
+	}
+
 	latest, latestSeconds := latestRun(grid.Columns)
 	alert := staleAlert(mod, latest, staleHours(tab), len(grid.Rows))
 	failures := failingTestSummaries(grid.Rows)
@@ -737,6 +748,7 @@ func updateTab(ctx context.Context, tab *configpb.DashboardTab, group *configpb.
 		Healthiness:          healthiness,
 		LinkedIssues:         allLinkedIssues(grid.Rows),
 		SummaryMetrics:       metrics,
+		MetricRegressions:    regressions,
 	}, nil
 }
 
@@ -892,7 +904,7 @@ func buildFailLink(testID, target string) string {
 // STALE - called with a stale mstring (typically when most recent column is old)
 // FAIL - there is at least one alert
 // ACCEPTABLE - the ratio of (valid) failing to total columns is less than configured threshold
-// FLAKY - at least one recent column has failing cells
+// FLAKY - at least one recent column has failing cells, other than infrastructure failures
 // PENDING - number of valid columns is less than minimum # of runs required
 // PASS - all recent columns are entirely green
 func overallStatus(grid *statepb.Grid, recent int, stale string, brokenState bool, alerts []*summarypb.FailingTestSummary, features FeatureFlags, colCells gridStats, opts *configpb.DashboardTabStatusCustomizationOptions) summarypb.DashboardTabSummary_TabStatus {
@@ -911,11 +923,14 @@ func overallStatus(grid *statepb.Grid, recent int, stale string, brokenState boo
 	}
 
 	results := result.Map(grid.Rows)
+	infraColumns := infraColumns(len(grid.Columns), grid.Rows)
 	moreCols := true
 	var passing bool
 	var flaky bool
+	col := -1
 	// We want to look at recent columns, skipping over any that are still running.
 	for moreCols && recent > 0 {
+		col++
 		moreCols = false
 		var foundCol bool
 		var running bool
@@ -964,6 +979,14 @@ func overallStatus(grid *statepb.Grid, recent int, stale string, brokenState boo
 			continue
 		}
 
+		// Infrastructure failures (evicted or OOMKilled pods, etc) are not
+		// test failures, so they count as recent without making the tab flaky.
+		if col < len(infraColumns) && infraColumns[col] != gcs.NoFailure {
+			recent--
+			flaky = false
+			continue
+		}
+
 		if flaky {
 			if isAcceptable(colCells, opts, features) {
 				return summarypb.DashboardTabSummary_ACCEPTABLE
@@ -1153,13 +1176,13 @@ func latestGreen(grid *statepb.Grid, useFirstExtra bool) string {
 	return noGreens
 }
 
-func getHealthinessForInterval(grid *statepb.Grid, tabName string, currentTime time.Time, interval int) *summarypb.HealthinessInfo {
+func getHealthinessForInterval(grid *statepb.Grid, tabName string, currentTime time.Time, interval int, groupingRegex *regexp.Regexp) *summarypb.HealthinessInfo {
 	now := goBackDays(0, currentTime)
 	oneInterval := goBackDays(interval, currentTime)
 	twoIntervals := goBackDays(2*interval, currentTime)
 
-	healthiness := CalculateHealthiness(grid, oneInterval, now, tabName)
-	pastHealthiness := CalculateHealthiness(grid, twoIntervals, oneInterval, tabName)
+	healthiness := calculateHealthiness(grid, oneInterval, now, tabName, groupingRegex)
+	pastHealthiness := calculateHealthiness(grid, twoIntervals, oneInterval, tabName, groupingRegex)
 	CalculateTrend(healthiness, pastHealthiness)
 
 	healthiness.PreviousFlakiness = []float32{pastHealthiness.AverageFlakiness}
@@ -1703,6 +1703,7 @@ func TestFailingTestSummaries(t *testing.T) {
 func TestOverallStatus(t *testing.T) {
 	cases := []struct {
 		name     string
+		cols     int
 		rows     []*statepb.Row
 		recent   int
 		stale    string
@@ -2009,6 +2010,56 @@ func TestOverallStatus(t *testing.T) {
 			},
 			expected: summarypb.DashboardTabSummary_FLAKY,
 		},
+		{
+			name:   "infra failures count as recent but not flaky",
+			cols:   3,
+			recent: 2,
+			rows: []*statepb.Row{
+				{
+					Name: "job.Overall",
+					Results: []int32{
+						int32(statuspb.TestStatus_FAIL), 1,
+						int32(statuspb.TestStatus_PASS), 1,
+						int32(statuspb.TestStatus_FAIL), 1,
+					},
+					Properties: []*statepb.Property{
+						{Property: map[string]string{gcs.FailureCategoryKey: string(gcs.Evicted)}},
+						{},
+						{Property: map[string]string{gcs.FailureCategoryKey: string(gcs.TestFailure)}},
+					},
+				},
+				{
+					Name: "test",
+					Results: []int32{
+						int32(statuspb.TestStatus_FAIL), 1,
+						int32(statuspb.TestStatus_PASS), 1,
+						int32(statuspb.TestStatus_FAIL), 1,
+					},
+				},
+			},
+			expected: summarypb.DashboardTabSummary_PASS,
+		},
+		{
+			name:   "test failures after infra failures are flaky",
+			cols:   3,
+			recent: 3,
+			rows: []*statepb.Row{
+				{
+					Name: "job.Overall",
+					Results: []int32{
+						int32(statuspb.TestStatus_FAIL), 1,
+						int32(statuspb.TestStatus_PASS), 1,
+						int32(statuspb.TestStatus_FAIL), 1,
+					},
+					Properties: []*statepb.Property{
+						{Property: map[string]string{gcs.FailureCategoryKey: string(gcs.OOMKilled)}},
+						{},
+						{Property: map[string]string{gcs.FailureCategoryKey: string(gcs.TestFailure)}},
+					},
+				},
+			},
+			expected: summarypb.DashboardTabSummary_FLAKY,
+		},
 	}
 
 	for _, tc := range cases {
@@ -2018,7 +2069,11 @@ func TestOverallStatus(t *testing.T) {
 				alerts = append(alerts, &summarypb.FailingTestSummary{})
 			}
 
-			if actual := overallStatus(&statepb.Grid{Rows: tc.rows}, tc.recent, tc.stale, tc.broken, alerts, tc.features, tc.colCells, tc.opts); actual != tc.expected {
+			grid := &statepb.Grid{
+				Columns: make([]*statepb.Column, tc.cols),
+				Rows:    tc.rows,
+			}
+			if actual := overallStatus(grid, tc.recent, tc.stale, tc.broken, alerts, tc.features, tc.colCells, tc.opts); actual != tc.expected {
 				t.Errorf("%s != expected %s", actual, tc.expected)
 			}
 		})
@@ -3039,7 +3094,7 @@ func TestGetHealthinessForInterval(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			if actual := getHealthinessForInterval(tc.grid, tc.tabName, time.Unix(now, 0), tc.interval); !proto.Equal(actual, tc.expected) {
+			if actual := getHealthinessForInterval(tc.grid, tc.tabName, time.Unix(now, 0), tc.interval, nil); !proto.Equal(actual, tc.expected) {
 				t.Errorf("actual: %+v != expected: %+v", actual, tc.expected)
 			}
 		})
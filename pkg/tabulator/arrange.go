@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabulator
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/fvbommel/sortorder"
+
+	"github.com/GoogleCloudPlatform/testgrid/internal/result"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+const (
+	sortByName       = "sort-by-name"
+	sortByFailures   = "sort-by-failures"
+	sortByFlakiness  = "sort-by-flakiness"
+	groupByRegexMask = "group-by-regex-mask"
+	groupByDirectory = "group-by-directory"
+	groupByTarget    = "group-by-target"
+)
+
+// arrangeRows sorts and groups the rows according to the base options.
+//
+// Rows are sorted first, then each group is moved next to its first member.
+// Returns the rows unchanged when no sort or group option is set.
+func arrangeRows(baseOptions string, rows []*statepb.Row) ([]*statepb.Row, error) {
+	vals, err := url.ParseQuery(baseOptions)
+	if err != nil {
+		return nil, fmt.Errorf("parse %q: %v", baseOptions, err)
+	}
+
+	less, err := rowOrder(vals)
+	if err != nil {
+		return nil, err
+	}
+	if less != nil {
+		sort.SliceStable(rows, func(i, j int) bool {
+			return less(rows[i], rows[j])
+		})
+	}
+
+	group, err := rowGroup(vals)
+	if err != nil {
+		return nil, err
+	}
+	if group != nil {
+		rows = groupRows(rows, group)
+	}
+	return rows, nil
+}
+
+func present(vals url.Values, keys ...string) []string {
+	var out []string
+	for _, k := range keys {
+		if _, ok := vals[k]; ok {
+			out = append(out, k)
+		}
+	}
+	return out
+}
+
+// rowOrder returns how to sort rows, or nil to leave them alone.
+func rowOrder(vals url.Values) (func(a, b *statepb.Row) bool, error) {
+	sorts := present(vals, sortByName, sortByFailures, sortByFlakiness)
+	if len(sorts) > 1 {
+		return nil, fmt.Errorf("at most one sort option allowed, got %s", strings.Join(sorts, ", "))
+	}
+	if len(sorts) == 0 {
+		return nil, nil
+	}
+	switch sorts[0] {
+	case sortByName:
+		return func(a, b *statepb.Row) bool {
+			return sortorder.NaturalLess(a.Name, b.Name)
+		}, nil
+	case sortByFailures:
+		return func(a, b *statepb.Row) bool {
+			return failures(a) > failures(b)
+		}, nil
+	default:
+		return func(a, b *statepb.Row) bool {
+			return flakiness(a) > flakiness(b)
+		}, nil
+	}
+}
+
+// failures returns the number of failing results in the row.
+func failures(row *statepb.Row) int {
+	var n int
+	next := result.Iter(row.Results)
+	for res, ok := next(); ok; res, ok = next() {
+		if result.Failing(res) {
+			n++
+		}
+	}
+	return n
+}
+
+// flakiness returns how many times the row flipped between passing and failing.
+//
+// Flaky results count as a flip.
+func flakiness(row *statepb.Row) int {
+	var n int
+	var last statuspb.TestStatus
+	next := result.Iter(row.Results)
+	for res, ok := next(); ok; res, ok = next() {
+		switch res = result.Coalesce(res, result.IgnoreRunning); res {
+		case statuspb.TestStatus_FLAKY:
+			n++
+		case statuspb.TestStatus_PASS, statuspb.TestStatus_FAIL:
+			if last != statuspb.TestStatus_NO_RESULT && last != res {
+				n++
+			}
+			last = res
+		}
+	}
+	return n
+}
+
+// rowGroup returns the group of a row name (or false when ungrouped), or nil to skip grouping.
+func rowGroup(vals url.Values) (func(string) (string, bool), error) {
+	groups := present(vals, groupByRegexMask, groupByDirectory, groupByTarget)
+	if len(groups) > 1 {
+		return nil, fmt.Errorf("at most one group option allowed, got %s", strings.Join(groups, ", "))
+	}
+	if len(groups) == 0 {
+		return nil, nil
+	}
+	switch groups[0] {
+	case groupByRegexMask:
+		mask := vals.Get(groupByRegexMask)
+		if mask == "" {
+			return nil, errors.New("empty " + groupByRegexMask)
+		}
+		re, err := regexp.Compile(mask)
+		if err != nil {
+			return nil, fmt.Errorf("bad %s=%s: %v", groupByRegexMask, mask, err)
+		}
+		return func(name string) (string, bool) {
+			group := re.ReplaceAllString(name, "")
+			return group, group != "" && group != name
+		}, nil
+	case groupByDirectory:
+		return directory, nil
+	default:
+		return func(name string) (string, bool) {
+			t := target(name)
+			return t, t != "" && t != name
+		}, nil
+	}
+}
+
+// target returns the target of a test name, such as the //foo:bar part of "//foo:bar TestBaz".
+func target(name string) string {
+	fields := strings.Fields(name)
+	if len(fields) == 0 {
+		return ""
+	}
+	return fields[0]
+}
+
+// directory returns the package of the test's target, such as //foo for //foo:bar or foo/bar for foo/bar/baz.
+func directory(name string) (string, bool) {
+	t := target(name)
+	var dir string
+	if idx := strings.LastIndex(t, ":"); strings.HasPrefix(t, "//") && idx >= 0 {
+		dir = t[:idx]
+	} else if idx := strings.LastIndex(t, "/"); idx > 0 {
+		dir = t[:idx]
+	}
+	return dir, dir != ""
+}
+
+// groupRows moves each group of rows next to its first member, recording the group of each row.
+func groupRows(rows []*statepb.Row, group func(string) (string, bool)) []*statepb.Row {
+	var order []string
+	members := map[string][]*statepb.Row{}
+	for _, row := range rows {
+		name, ok := group(row.Name)
+		if !ok {
+			row.Group = ""
+			order = append(order, "")
+			members[""] = append(members[""], row)
+			continue
+		}
+		row.Group = name
+		if _, ok := members[name]; !ok {
+			order = append(order, name)
+		}
+		members[name] = append(members[name], row)
+	}
+
+	out := make([]*statepb.Row, 0, len(rows))
+	var ungrouped int
+	for _, name := range order {
+		if name == "" {
+			out = append(out, members[""][ungrouped])
+			ungrouped++
+			continue
+		}
+		out = append(out, members[name]...)
+	}
+	return out
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabulator
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+func TestArrangeRows(t *testing.T) {
+	const (
+		pass  = int32(statuspb.TestStatus_PASS)
+		fail  = int32(statuspb.TestStatus_FAIL)
+		flaky = int32(statuspb.TestStatus_FLAKY)
+		empty = int32(statuspb.TestStatus_NO_RESULT)
+	)
+	rows := func() []*statepb.Row {
+		return []*statepb.Row{
+			{Name: "//foo:bar TestB", Results: []int32{pass, 1, fail, 2, pass, 1}},
+			{Name: "//foo:bar TestA10", Results: []int32{fail, 1, pass, 1, fail, 1, pass, 1}},
+			{Name: "//baz:qux TestC", Results: []int32{fail, 3}},
+			{Name: "//foo:other", Results: []int32{pass, 1, flaky, 1, empty, 1, pass, 1}},
+			{Name: "//foo:bar TestA9", Results: []int32{pass, 4}},
+		}
+	}
+
+	cases := []struct {
+		name        string
+		baseOptions string
+		want        []string
+		wantGroups  []string
+		err         bool
+	}{
+		{
+			name: "basically works",
+			want: []string{"//foo:bar TestB", "//foo:bar TestA10", "//baz:qux TestC", "//foo:other", "//foo:bar TestA9"},
+		},
+		{
+			name:        "bad options",
+			baseOptions: "%z",
+			err:         true,
+		},
+		{
+			name:        "ignore filters",
+			baseOptions: url.Values{includeFilter: []string{"foo"}}.Encode(),
+			want:        []string{"//foo:bar TestB", "//foo:bar TestA10", "//baz:qux TestC", "//foo:other", "//foo:bar TestA9"},
+		},
+		{
+			name:        "sort by name",
+			baseOptions: sortByName,
+			want:        []string{"//baz:qux TestC", "//foo:bar TestA9", "//foo:bar TestA10", "//foo:bar TestB", "//foo:other"},
+		},
+		{
+			name:        "sort by failures",
+			baseOptions: sortByFailures,
+			want:        []string{"//baz:qux TestC", "//foo:bar TestB", "//foo:bar TestA10", "//foo:other", "//foo:bar TestA9"},
+		},
+		{
+			name:        "sort by flakiness",
+			baseOptions: sortByFlakiness,
+			want:        []string{"//foo:bar TestA10", "//foo:bar TestB", "//foo:other", "//baz:qux TestC", "//foo:bar TestA9"},
+		},
+		{
+			name:        "reject multiple sorts",
+			baseOptions: sortByName + "&" + sortByFailures,
+			err:         true,
+		},
+		{
+			name:        "group by target",
+			baseOptions: groupByTarget,
+			want:        []string{"//foo:bar TestB", "//foo:bar TestA10", "//foo:bar TestA9", "//baz:qux TestC", "//foo:other"},
+			wantGroups:  []string{"//foo:bar", "//foo:bar", "//foo:bar", "//baz:qux", ""},
+		},
+		{
+			name:        "group by directory",
+			baseOptions: groupByDirectory,
+			want:        []string{"//foo:bar TestB", "//foo:bar TestA10", "//foo:other", "//foo:bar TestA9", "//baz:qux TestC"},
+			wantGroups:  []string{"//foo", "//foo", "//foo", "//foo", "//baz"},
+		},
+		{
+			name:        "group by regex mask",
+			baseOptions: url.Values{groupByRegexMask: []string{`\d+$`}}.Encode(),
+			want:        []string{"//foo:bar TestB", "//foo:bar TestA10", "//foo:bar TestA9", "//baz:qux TestC", "//foo:other"},
+			wantGroups:  []string{"", "//foo:bar TestA", "//foo:bar TestA", "", ""},
+		},
+		{
+			name:        "sort before grouping",
+			baseOptions: sortByName + "&" + groupByTarget,
+			want:        []string{"//baz:qux TestC", "//foo:bar TestA9", "//foo:bar TestA10", "//foo:bar TestB", "//foo:other"},
+			wantGroups:  []string{"//baz:qux", "//foo:bar", "//foo:bar", "//foo:bar", ""},
+		},
+		{
+			name:        "reject bad mask",
+			baseOptions: url.Values{groupByRegexMask: []string{"("}}.Encode(),
+			err:         true,
+		},
+		{
+			name:        "reject empty mask",
+			baseOptions: groupByRegexMask + "=",
+			err:         true,
+		},
+		{
+			name:        "reject multiple groups",
+			baseOptions: groupByTarget + "&" + groupByDirectory,
+			err:         true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := arrangeRows(tc.baseOptions, rows())
+			if tc.err {
+				if err == nil {
+					t.Error("arrangeRows() failed to return an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("arrangeRows() got unexpected error: %v", err)
+			}
+			var names, groups []string
+			for _, row := range got {
+				names = append(names, row.Name)
+				groups = append(groups, row.Group)
+			}
+			if diff := cmp.Diff(tc.want, names); diff != "" {
+				t.Errorf("arrangeRows() got unexpected order (-want +got):\n%s", diff)
+			}
+			if tc.wantGroups == nil {
+				tc.wantGroups = make([]string, len(tc.want))
+			}
+			if diff := cmp.Diff(tc.wantGroups, groups); diff != "" {
+				t.Errorf("arrangeRows() got unexpected groups (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
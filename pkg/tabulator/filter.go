@@ -50,10 +50,7 @@ func filterGrid(baseOptions string, rows []*statepb.Row) ([]*statepb.Row, error)
 	}
 
 	// TODO(chases2): drop columns that are now empty due to filters
-	// TODO(fejta): grouping, which is not used by testgrid.k8s.io
-	// TODO(fejta): sorting, unused by testgrid.k8s.io
 	// TODO(fejta): graph, unused by testgrid.k8s.io
-	// TODO(fejta): tabuluar, unused by testgrid.k8s.io
 	return rows, nil
 }
 
@@ -88,3 +85,35 @@ func excludeRows(in []*statepb.Row, exclude string) ([]*statepb.Row, error) {
 	}
 	return rows, nil
 }
+
+// splitTabularNames splits each row name into the named capture groups of the regex.
+//
+// Rows whose name does not match get empty values.
+func splitTabularNames(tabularNamesRegex string, grid *statepb.Grid) error {
+	if tabularNamesRegex == "" {
+		return nil
+	}
+	re, err := regexp.Compile(tabularNamesRegex)
+	if err != nil {
+		return err
+	}
+	var groups []int
+	grid.TabularNames = nil
+	for i, name := range re.SubexpNames() {
+		if name == "" {
+			continue
+		}
+		groups = append(groups, i)
+		grid.TabularNames = append(grid.TabularNames, name)
+	}
+	for _, row := range grid.Rows {
+		values := make([]string, len(groups))
+		if match := re.FindStringSubmatch(row.Name); match != nil {
+			for i, g := range groups {
+				values[i] = match[g]
+			}
+		}
+		row.TabularValues = values
+	}
+	return nil
+}
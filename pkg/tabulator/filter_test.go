@@ -280,3 +280,68 @@ func TestExcludeRows(t *testing.T) {
 	}
 
 }
+
+func TestSplitTabularNames(t *testing.T) {
+	cases := []struct {
+		name     string
+		regex    string
+		rows     []string
+		expected *statepb.Grid
+		err      bool
+	}{
+		{
+			name:     "unset does nothing",
+			rows:     []string{"foo"},
+			expected: &statepb.Grid{Rows: []*statepb.Row{{Name: "foo"}}},
+		},
+		{
+			name:  "bad regex errors",
+			regex: "(?P<test>",
+			err:   true,
+		},
+		{
+			name:  "basically works",
+			regex: `^(?P<test>[^/]+)/(?P<platform>[^/]+)/(?:(?P<arch>\w+))?$`,
+			rows:  []string{"TestFoo/linux/amd64", "TestBar/windows/", "unsplittable"},
+			expected: &statepb.Grid{
+				TabularNames: []string{"test", "platform", "arch"},
+				Rows: []*statepb.Row{
+					{
+						Name:          "TestFoo/linux/amd64",
+						TabularValues: []string{"TestFoo", "linux", "amd64"},
+					},
+					{
+						Name:          "TestBar/windows/",
+						TabularValues: []string{"TestBar", "windows", ""},
+					},
+					{
+						Name:          "unsplittable",
+						TabularValues: []string{"", "", ""},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			grid := &statepb.Grid{}
+			for _, name := range tc.rows {
+				grid.Rows = append(grid.Rows, &statepb.Row{Name: name})
+			}
+			err := splitTabularNames(tc.regex, grid)
+			if tc.err {
+				if err == nil {
+					t.Error("splitTabularNames() failed to return an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("splitTabularNames() got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.expected, grid, protocmp.Transform()); diff != "" {
+				t.Errorf("splitTabularNames() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -402,8 +402,18 @@ func tabulate(ctx context.Context, log logrus.FieldLogger, grid *statepb.Grid, t
 		}
 		inflatedGrid = mergeGrids(existingInflatedGrid, inflatedGrid)
 	}
-	grid = updater.ConstructGrid(log, inflatedGrid, issues, alert, unalert, usesK8sClient, groupCfg.GetUserProperty(), brokenThreshold)
-	return grid, nil
+	tabGrid := updater.ConstructGrid(log, inflatedGrid, issues, alert, unalert, usesK8sClient, groupCfg.GetUserProperty(), brokenThreshold)
+	if groupCfg.GetClusterFailures() {
+		updater.ClusterGrid(grid, tabGrid)
+	}
+	tabGrid.UpdateInfo = grid.UpdateInfo
+	if tabGrid.Rows, err = arrangeRows(tabCfg.BaseOptions, tabGrid.Rows); err != nil {
+		return nil, fmt.Errorf("arrangeRows: %w", err)
+	}
+	if err := splitTabularNames(tabCfg.GetTabularNamesRegex(), tabGrid); err != nil {
+		return nil, fmt.Errorf("tabular_names_regex: %w", err)
+	}
+	return tabGrid, nil
 }
 
 // mergeGrids merges two sorted, inflated grids together.
@@ -413,6 +413,106 @@ func Test_Tabulate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "cluster failures",
+			grid: func() *statepb.Grid {
+				g := buildGrid(t,
+					updater.InflatedColumn{
+						Column: &statepb.Column{Name: "new", Started: 3000},
+						Cells: map[string]updater.Cell{
+							"first": {Result: tspb.TestStatus_FAIL, Message: "boom 2"},
+						},
+					},
+					updater.InflatedColumn{
+						Column: &statepb.Column{Name: "weird", Started: 2000},
+						Cells: map[string]updater.Cell{
+							"bad": {Result: tspb.TestStatus_FAIL, Message: "boom 1"},
+						},
+					},
+					updater.InflatedColumn{
+						Column: &statepb.Column{Name: "old", Started: 1000},
+						Cells: map[string]updater.Cell{
+							"first": {Result: tspb.TestStatus_FAIL, Message: "boom 0"},
+						},
+					})
+				g.Cluster = []*statepb.Cluster{
+					{
+						TestStatus: int32(tspb.TestStatus_FAIL),
+						Message:    "boom <n>",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "bad", Index: []int32{1}},
+							{DisplayName: "first", Index: []int32{2}},
+						},
+					},
+				}
+				g.MostRecentClusterTimestamp = 2000
+				return g
+			}(),
+			dashCfg: &configpb.DashboardTab{
+				Name:        "tab",
+				BaseOptions: "exclude-filter-by-regex=bad",
+			},
+			groupCfg: &configpb.TestGroup{ClusterFailures: true},
+			expected: &statepb.Grid{
+				Columns: []*statepb.Column{
+					{Name: "new", Started: 3000},
+					{Name: "old", Started: 1000},
+				},
+				Rows: []*statepb.Row{
+					{
+						Name:    "first",
+						Id:      "first",
+						Results: []int32{int32(tspb.TestStatus_FAIL), 2},
+					},
+				},
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(tspb.TestStatus_FAIL),
+						Message:    "boom <n>",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "first", Index: []int32{0, 1}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 3000,
+			},
+		},
+		{
+			name: "split tabular names",
+			grid: buildGrid(t,
+				updater.InflatedColumn{
+					Column: &statepb.Column{Name: "only"},
+					Cells: map[string]updater.Cell{
+						"TestFoo [linux]": {Result: tspb.TestStatus_PASS},
+						"other":           {Result: tspb.TestStatus_FAIL},
+					},
+				}),
+			dashCfg: &configpb.DashboardTab{
+				Name:              "tab",
+				TabularNamesRegex: `^(?P<test>\w+) \[(?P<platform>\w+)\]$`,
+			},
+			groupCfg: &configpb.TestGroup{},
+			expected: &statepb.Grid{
+				Columns: []*statepb.Column{
+					{Name: "only"},
+				},
+				Rows: []*statepb.Row{
+					{
+						Name:          "TestFoo [linux]",
+						Id:            "TestFoo [linux]",
+						Results:       []int32{int32(tspb.TestStatus_PASS), 1},
+						TabularValues: []string{"TestFoo", "linux"},
+					},
+					{
+						Name:          "other",
+						Id:            "other",
+						Results:       []int32{int32(tspb.TestStatus_FAIL), 1},
+						TabularValues: []string{"", ""},
+					},
+				},
+				TabularNames: []string{"test", "platform"},
+			},
+		},
 		{
 			name: "calculate alerts using test group",
 			grid: buildGrid(t,
@@ -0,0 +1,183 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/testgrid/internal/result"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+var (
+	uuidRe   = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	pathRe   = regexp.MustCompile(`(?:\b[A-Za-z]:|\B~|\B\.{1,2}|\B)[/\\][\w.-]+(?:[/\\][\w.-]+)*|\b[\w.-]+(?:[/\\][\w.-]+){2,}`)
+	hexRe    = regexp.MustCompile(`(?i)\b(?:0x[0-9a-f]+|[0-9a-f]{8,})\b`)
+	digitsRe = regexp.MustCompile(`^\d+$`)
+	numberRe = regexp.MustCompile(`\d+(?:\.\d+)?`)
+	spaceRe  = regexp.MustCompile(`\s+`)
+)
+
+// normalizeMessage returns the failure message with volatile parts masked out.
+//
+// Replaces UUIDs, file paths, hex values and numbers with placeholders so that
+// failures differing only in these values cluster together.
+func normalizeMessage(msg string) string {
+	msg = uuidRe.ReplaceAllString(msg, "<uuid>")
+	msg = pathRe.ReplaceAllString(msg, "<path>")
+	msg = hexRe.ReplaceAllStringFunc(msg, func(s string) string {
+		if digitsRe.MatchString(s) {
+			return s
+		}
+		return "<hex>"
+	})
+	msg = numberRe.ReplaceAllString(msg, "<n>")
+	return strings.TrimSpace(spaceRe.ReplaceAllString(msg, " "))
+}
+
+type clusterKey struct {
+	status  statuspb.TestStatus
+	message string
+}
+
+type columnKey struct {
+	build   string
+	name    string
+	started float64
+}
+
+func keyOf(col *statepb.Column) columnKey {
+	return columnKey{
+		build:   col.GetBuild(),
+		name:    col.GetName(),
+		started: col.GetStarted(),
+	}
+}
+
+// ClusterGrid groups the failing cells of the grid into clusters.
+//
+// Cells cluster together when they have the same status and normalized message.
+// Clusters from prev carry forward for its columns that remain in the grid,
+// so only columns started after prev's most recent cluster timestamp are processed.
+// Columns with running cells are reprocessed until they finish.
+func ClusterGrid(prev, grid *statepb.Grid) {
+	var since float64
+	if prev != nil {
+		since = prev.MostRecentClusterTimestamp
+	}
+
+	index := make(map[columnKey]int, len(grid.Columns))
+	for i, col := range grid.Columns {
+		index[keyOf(col)] = i
+	}
+	rowNames := make(map[string]bool, len(grid.Rows))
+	for _, row := range grid.Rows {
+		rowNames[row.Name] = true
+	}
+
+	cells := map[clusterKey]map[string][]int32{}
+	add := func(key clusterKey, name string, idx int32) {
+		if cells[key] == nil {
+			cells[key] = map[string][]int32{}
+		}
+		cells[key][name] = append(cells[key][name], idx)
+	}
+
+	if prev != nil {
+		for _, cluster := range prev.Cluster {
+			key := clusterKey{statuspb.TestStatus(cluster.TestStatus), cluster.Message}
+			for _, cr := range cluster.ClusterRow {
+				if !rowNames[cr.DisplayName] {
+					continue
+				}
+				for _, idx := range cr.Index {
+					if int(idx) >= len(prev.Columns) {
+						continue
+					}
+					col := prev.Columns[idx]
+					if col.Started > since {
+						continue
+					}
+					if i, ok := index[keyOf(col)]; ok {
+						add(key, cr.DisplayName, int32(i))
+					}
+				}
+			}
+		}
+	}
+
+	running := -1 // Oldest column with a running cell
+	for _, row := range grid.Rows {
+		nextCell := inflateRow(row)
+		for i, col := range grid.Columns {
+			cell := nextCell()
+			if cell == nil {
+				break
+			}
+			if cell.Result == statuspb.TestStatus_RUNNING && i > running {
+				running = i
+			}
+			if col.Started <= since {
+				continue
+			}
+			if result.Coalesce(cell.Result, result.IgnoreRunning) != statuspb.TestStatus_FAIL {
+				continue
+			}
+			add(clusterKey{cell.Result, normalizeMessage(cell.Message)}, row.Name, int32(i))
+		}
+	}
+
+	grid.MostRecentClusterTimestamp = since
+	if n := running + 1; n < len(grid.Columns) && grid.Columns[n].Started > since {
+		grid.MostRecentClusterTimestamp = grid.Columns[n].Started
+	}
+
+	grid.Cluster = make([]*statepb.Cluster, 0, len(cells))
+	sizes := make(map[*statepb.Cluster]int, len(cells))
+	for key, rows := range cells {
+		cluster := &statepb.Cluster{
+			TestStatus: int32(key.status),
+			Message:    key.message,
+			ClusterRow: make([]*statepb.ClusterRow, 0, len(rows)),
+		}
+		for name, idxs := range rows {
+			sort.Slice(idxs, func(i, j int) bool { return idxs[i] < idxs[j] })
+			cluster.ClusterRow = append(cluster.ClusterRow, &statepb.ClusterRow{
+				DisplayName: name,
+				Index:       idxs,
+			})
+			sizes[cluster] += len(idxs)
+		}
+		sort.Slice(cluster.ClusterRow, func(i, j int) bool {
+			return cluster.ClusterRow[i].DisplayName < cluster.ClusterRow[j].DisplayName
+		})
+		grid.Cluster = append(grid.Cluster, cluster)
+	}
+	sort.Slice(grid.Cluster, func(i, j int) bool {
+		a, b := grid.Cluster[i], grid.Cluster[j]
+		if sizes[a] != sizes[b] {
+			return sizes[a] > sizes[b]
+		}
+		if a.TestStatus != b.TestStatus {
+			return a.TestStatus < b.TestStatus
+		}
+		return a.Message < b.Message
+	})
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+)
+
+func TestNormalizeMessage(t *testing.T) {
+	cases := []struct {
+		msg  string
+		want string
+	}{
+		{
+			msg: "",
+		},
+		{
+			msg:  "lookup foo.svc.cluster.local: no such host",
+			want: "lookup foo.svc.cluster.local: no such host",
+		},
+		{
+			msg:  "timed out after 30s waiting for 3 pods",
+			want: "timed out after <n>s waiting for <n> pods",
+		},
+		{
+			msg:  "pod 0f8fad5b-d9cb-469f-a165-70867728950e failed",
+			want: "pod <uuid> failed",
+		},
+		{
+			msg:  "open /tmp/foo/bar.txt: no such file",
+			want: "open <path>: no such file",
+		},
+		{
+			msg:  "read ./testdata/x.json and pkg/foo/bar.go, not i/o",
+			want: "read <path> and <path>, not i/o",
+		},
+		{
+			msg:  `C:\build\out.log missing`,
+			want: "<path> missing",
+		},
+		{
+			msg:  "panic at 0xc000123abc in deadbeef12",
+			want: "panic at <hex> in <hex>",
+		},
+		{
+			msg:  "expected 12345678\n  got\t1.5",
+			want: "expected <n> got <n>",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.msg, func(t *testing.T) {
+			if got := normalizeMessage(tc.msg); got != tc.want {
+				t.Errorf("normalizeMessage(%q) got %q, want %q", tc.msg, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestClusterGrid(t *testing.T) {
+	fail := func(msg string) Cell {
+		return Cell{Result: statuspb.TestStatus_FAIL, Message: msg}
+	}
+	pass := Cell{Result: statuspb.TestStatus_PASS}
+	grid := func(cols ...InflatedColumn) *statepb.Grid {
+		return ConstructGrid(logrus.New(), cols, nil, 0, 0, false, "", 0)
+	}
+	col := func(build string, started float64, cells map[string]Cell) InflatedColumn {
+		return InflatedColumn{
+			Column: &statepb.Column{Build: build, Started: started},
+			Cells:  cells,
+		}
+	}
+
+	cases := []struct {
+		name string
+		prev *statepb.Grid
+		grid *statepb.Grid
+		want *statepb.Grid
+	}{
+		{
+			name: "empty",
+			grid: &statepb.Grid{},
+			want: &statepb.Grid{},
+		},
+		{
+			name: "basically works",
+			grid: grid(
+				col("3", 3000, map[string]Cell{
+					"a": fail("dial tcp 10.0.0.1:53: i/o timeout"),
+					"b": fail("dial tcp 10.0.0.2:53: i/o timeout"),
+					"c": pass,
+				}),
+				col("2", 2000, map[string]Cell{
+					"a": fail("dial tcp 10.0.0.3:53: i/o timeout"),
+					"b": pass,
+					"c": {Result: statuspb.TestStatus_TIMED_OUT, Message: "killed after 60m"},
+				}),
+				col("1", 1000, map[string]Cell{
+					"a": pass,
+					"b": {Result: statuspb.TestStatus_FLAKY, Message: "ignore flakes"},
+					"c": fail("expected 1 got 2"),
+				}),
+			),
+			want: &statepb.Grid{
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "dial tcp <n>.<n>:<n>: i/o timeout",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0, 1}},
+							{DisplayName: "b", Index: []int32{0}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_TIMED_OUT),
+						Message:    "killed after <n>m",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "c", Index: []int32{1}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "expected <n> got <n>",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "c", Index: []int32{2}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 3000,
+			},
+		},
+		{
+			name: "wait for running columns",
+			grid: grid(
+				col("3", 3000, map[string]Cell{
+					"a": fail("boom"),
+				}),
+				col("2", 2000, map[string]Cell{
+					"a": {Result: statuspb.TestStatus_RUNNING},
+				}),
+				col("1", 1000, map[string]Cell{
+					"a": fail("boom"),
+				}),
+			),
+			want: &statepb.Grid{
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "boom",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0, 2}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 1000,
+			},
+		},
+		{
+			name: "only process new columns",
+			prev: &statepb.Grid{
+				Columns: []*statepb.Column{
+					{Build: "2", Started: 2000},
+					{Build: "1", Started: 1000},
+					{Build: "0", Started: 500},
+				},
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "old message",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{1, 2}},
+							{DisplayName: "deleted", Index: []int32{1}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "boom",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0}}, // newer than the timestamp
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 1000,
+			},
+			grid: grid(
+				col("3", 3000, map[string]Cell{
+					"a": fail("boom 3"),
+				}),
+				col("2", 2000, map[string]Cell{
+					"a": fail("crash"),
+				}),
+				col("1", 1000, map[string]Cell{
+					"a": fail("not reprocessed"),
+				}),
+			),
+			want: &statepb.Grid{
+				Cluster: []*statepb.Cluster{
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "boom <n>",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{0}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "crash",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{1}},
+						},
+					},
+					{
+						TestStatus: int32(statuspb.TestStatus_FAIL),
+						Message:    "old message",
+						ClusterRow: []*statepb.ClusterRow{
+							{DisplayName: "a", Index: []int32{2}},
+						},
+					},
+				},
+				MostRecentClusterTimestamp: 3000,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ClusterGrid(tc.prev, tc.grid)
+			got := &statepb.Grid{
+				Cluster:                    tc.grid.Cluster,
+				MostRecentClusterTimestamp: tc.grid.MostRecentClusterTimestamp,
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("ClusterGrid() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
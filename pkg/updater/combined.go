@@ -0,0 +1,225 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// SourceReader returns the ColumnReader for a group with a single result source.
+//
+// Returns nil when the source is not supported.
+type SourceReader func(tg *configpb.TestGroup) ColumnReader
+
+// Combined returns a GroupUpdater for groups reading from several result_sources.
+//
+// Links the issues from gatherIssues to rows when non-nil.
+func Combined(readerFor SourceReader, groupTimeout time.Duration, write bool, gatherIssues IssueGatherer) GroupUpdater {
+	return func(parent context.Context, log logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path) (bool, error) {
+		if len(tg.GetResultSources()) == 0 {
+			log.Debug("Skipping single source group")
+			return false, nil
+		}
+		ctx, cancel := context.WithTimeout(parent, groupTimeout)
+		defer cancel()
+		reprocess := 20 * time.Minute // allow running columns to be replaced
+		return InflateDropAppend(ctx, log, client, tg, gridPath, write, CombinedColumnReader(readerFor), reprocess, gatherIssues)
+	}
+}
+
+// sourceGroup returns a copy of the group which only reads from src.
+func sourceGroup(tg *configpb.TestGroup, src *configpb.TestGroup_ResultSource) *configpb.TestGroup {
+	sub := proto.Clone(tg).(*configpb.TestGroup)
+	sub.ResultSources = nil
+	sub.ResultSource = src
+	sub.UseKubernetesClient = false
+	return sub
+}
+
+const sourceHintSeparator = ":"
+
+// sourceHint prefixes the hint with the index of the source that read the column.
+func sourceHint(idx int, hint string) string {
+	return strconv.Itoa(idx) + sourceHintSeparator + hint
+}
+
+// splitSourceHint returns the source index and original hint of a column.
+func splitSourceHint(hint string) (int, string, bool) {
+	parts := strings.SplitN(hint, sourceHintSeparator, 2)
+	if len(parts) != 2 {
+		return 0, "", false
+	}
+	idx, err := strconv.Atoi(parts[0])
+	if err != nil || idx < 0 {
+		return 0, "", false
+	}
+	return idx, parts[1], true
+}
+
+// combinedID identifies the same run read from different sources.
+func combinedID(col *statepb.Column) string {
+	return col.Build + columnIDSeparator + strconv.FormatFloat(col.Started, 'f', -1, 64)
+}
+
+// sourceQueue holds the columns a source reads until the combined reader sends them.
+type sourceQueue struct {
+	lock  sync.Mutex
+	cols  []InflatedColumn
+	done  bool
+	ready chan struct{}
+}
+
+func newSourceQueue() *sourceQueue {
+	return &sourceQueue{ready: make(chan struct{}, 1)}
+}
+
+func (q *sourceQueue) signal() {
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+}
+
+func (q *sourceQueue) push(col InflatedColumn) {
+	q.lock.Lock()
+	q.cols = append(q.cols, col)
+	q.lock.Unlock()
+	q.signal()
+}
+
+func (q *sourceQueue) finish() {
+	q.lock.Lock()
+	q.done = true
+	q.lock.Unlock()
+	q.signal()
+}
+
+// take returns the queued columns and whether the source finished reading.
+func (q *sourceQueue) take() ([]InflatedColumn, bool) {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	cols := q.cols
+	q.cols = nil
+	return cols, q.done
+}
+
+// CombinedColumnReader returns a ColumnReader which merges the columns of each result source.
+//
+// Each source receives the old columns it previously read, and columns with
+// the same build and started time are only sent once.
+// Sources read concurrently, but columns are sent in result_sources order,
+// so the first source to read a duplicate column wins regardless of speed.
+func CombinedColumnReader(readerFor SourceReader) ColumnReader {
+	return func(ctx context.Context, parentLog logrus.FieldLogger, tg *configpb.TestGroup, oldCols []InflatedColumn, stop time.Time, receivers chan<- InflatedColumn) error {
+		sources := tg.GetResultSources()
+		groups := make([]*configpb.TestGroup, len(sources))
+		readers := make([]ColumnReader, len(sources))
+		for i, src := range sources {
+			groups[i] = sourceGroup(tg, src)
+			if readers[i] = readerFor(groups[i]); readers[i] == nil {
+				return fmt.Errorf("result source %d: unsupported source", i)
+			}
+		}
+
+		seen := map[string]bool{}
+		sourceCols := make([][]InflatedColumn, len(sources))
+		for _, col := range oldCols {
+			seen[combinedID(col.Column)] = true
+			idx, hint, ok := splitSourceHint(col.Column.Hint)
+			if !ok || idx >= len(sources) {
+				continue
+			}
+			col.Column = proto.Clone(col.Column).(*statepb.Column)
+			col.Column.Hint = hint
+			sourceCols[idx] = append(sourceCols[idx], col)
+		}
+
+		var wg sync.WaitGroup
+		errs := make([]error, len(sources))
+		queues := make([]*sourceQueue, len(sources))
+		wg.Add(len(sources))
+		for i := range sources {
+			queues[i] = newSourceQueue()
+			go func(i int) {
+				defer wg.Done()
+				log := parentLog.WithField("source", i)
+				ch := make(chan InflatedColumn)
+				done := make(chan struct{})
+				go func() {
+					defer close(done)
+					for col := range ch {
+						queues[i].push(col)
+					}
+				}()
+				errs[i] = readers[i](ctx, log, groups[i], sourceCols[i], stop, ch)
+				close(ch)
+				<-done
+				queues[i].finish()
+			}(i)
+		}
+
+	send:
+		for i, q := range queues {
+			log := parentLog.WithField("source", i)
+			for {
+				cols, done := q.take()
+				for _, col := range cols {
+					id := combinedID(col.Column)
+					if seen[id] {
+						log.WithField("build", col.Column.Build).Trace("Skipping duplicate column")
+						continue
+					}
+					seen[id] = true
+					col.Column.Hint = sourceHint(i, col.Column.Hint)
+					select {
+					case <-ctx.Done():
+						break send
+					case receivers <- col:
+					}
+				}
+				if done {
+					break
+				}
+				select {
+				case <-ctx.Done():
+					break send
+				case <-q.ready:
+				}
+			}
+		}
+		wg.Wait()
+
+		for i, err := range errs {
+			if err != nil {
+				return fmt.Errorf("result source %d: %w", i, err)
+			}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+)
+
+func TestSplitSourceHint(t *testing.T) {
+	cases := []struct {
+		hint string
+		idx  int
+		want string
+		ok   bool
+	}{
+		{
+			hint: "",
+		},
+		{
+			hint: "1234",
+		},
+		{
+			hint: "0:1234",
+			want: "1234",
+			ok:   true,
+		},
+		{
+			hint: "2:2023-01-02T03:04:05Z",
+			idx:  2,
+			want: "2023-01-02T03:04:05Z",
+			ok:   true,
+		},
+		{
+			hint: "-1:1234",
+		},
+		{
+			hint: "foo:1234",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.hint, func(t *testing.T) {
+			idx, got, ok := splitSourceHint(tc.hint)
+			if ok != tc.ok || idx != tc.idx || got != tc.want {
+				t.Errorf("splitSourceHint(%q) got (%d, %q, %t), want (%d, %q, %t)", tc.hint, idx, got, ok, tc.idx, tc.want, tc.ok)
+			}
+			if !ok {
+				return
+			}
+			if rt := sourceHint(idx, got); rt != tc.hint {
+				t.Errorf("sourceHint(%d, %q) got %q, want %q", idx, got, rt, tc.hint)
+			}
+		})
+	}
+}
+
+func TestCombinedColumnReader(t *testing.T) {
+	gcsSource := &configpb.TestGroup_ResultSource{
+		ResultSourceConfig: &configpb.TestGroup_ResultSource_GcsConfig{
+			GcsConfig: &configpb.GCSConfig{GcsPrefix: "bucket/old"},
+		},
+	}
+	ingestSource := &configpb.TestGroup_ResultSource{
+		ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+			IngestConfig: &configpb.IngestConfig{GcsPrefix: "bucket/new"},
+		},
+	}
+	column := func(build string, started float64, hint string) InflatedColumn {
+		return InflatedColumn{
+			Column: &statepb.Column{
+				Build:   build,
+				Started: started,
+				Hint:    hint,
+			},
+			Cells: map[string]Cell{},
+		}
+	}
+
+	cases := []struct {
+		name          string
+		sources       []*configpb.TestGroup_ResultSource
+		oldCols       []InflatedColumn
+		gcsCols       []InflatedColumn
+		gcsErr        error
+		slowGCS       bool // gcs waits for ingest to finish reading
+		ingest        []InflatedColumn
+		want          []string   // builds, sorted by started
+		wantHints     [][]string // possible hints for each build
+		wantGCSOld    []string
+		wantIngestOld []string
+		err           bool
+	}{
+		{
+			name:    "merge sources",
+			sources: []*configpb.TestGroup_ResultSource{gcsSource, ingestSource},
+			gcsCols: []InflatedColumn{
+				column("1", 1000, "1"),
+				column("2", 2000, "2"),
+			},
+			ingest: []InflatedColumn{
+				column("2", 2000, "2000-2"),
+				column("3", 3000, "3000-3"),
+			},
+			want: []string{"1", "2", "3"},
+			wantHints: [][]string{
+				{"0:1"},
+				{"0:2"},
+				{"1:3000-3"},
+			},
+		},
+		{
+			name:    "first source wins when slow",
+			sources: []*configpb.TestGroup_ResultSource{gcsSource, ingestSource},
+			slowGCS: true,
+			gcsCols: []InflatedColumn{
+				column("2", 2000, "2"),
+			},
+			ingest: []InflatedColumn{
+				column("2", 2000, "2000-2"),
+				column("3", 3000, "3000-3"),
+			},
+			want: []string{"2", "3"},
+			wantHints: [][]string{
+				{"0:2"},
+				{"1:3000-3"},
+			},
+		},
+		{
+			name:    "pass each source its old columns",
+			sources: []*configpb.TestGroup_ResultSource{gcsSource, ingestSource},
+			oldCols: []InflatedColumn{
+				column("1", 1000, "0:1"),
+				column("2", 2000, "1:2000-2"),
+				column("3", 3000, "5:gone"),
+				column("4", 4000, "legacy"),
+			},
+			gcsCols: []InflatedColumn{
+				column("4", 4000, "4"),
+				column("5", 5000, "5"),
+			},
+			want: []string{"5"},
+			wantHints: [][]string{
+				{"0:5"},
+			},
+			wantGCSOld:    []string{"1"},
+			wantIngestOld: []string{"2000-2"},
+		},
+		{
+			name:    "same build at different times",
+			sources: []*configpb.TestGroup_ResultSource{gcsSource, ingestSource},
+			gcsCols: []InflatedColumn{
+				column("1", 1000, "1"),
+			},
+			ingest: []InflatedColumn{
+				column("1", 5000, "5000-1"),
+			},
+			want: []string{"1", "1"},
+			wantHints: [][]string{
+				{"0:1"},
+				{"1:5000-1"},
+			},
+		},
+		{
+			name:    "source error",
+			sources: []*configpb.TestGroup_ResultSource{gcsSource, ingestSource},
+			gcsErr:  errors.New("injected"),
+			ingest: []InflatedColumn{
+				column("1", 1000, "1000-1"),
+			},
+			err: true,
+		},
+		{
+			name: "unsupported source",
+			sources: []*configpb.TestGroup_ResultSource{
+				gcsSource,
+				{},
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gcsOld, ingestOld []string
+			ingestDone := make(chan struct{})
+			fakeReader := func(name string, old *[]string, cols []InflatedColumn, err error, wait, done chan struct{}) ColumnReader {
+				return func(ctx context.Context, _ logrus.FieldLogger, tg *configpb.TestGroup, oldCols []InflatedColumn, _ time.Time, receivers chan<- InflatedColumn) error {
+					if done != nil {
+						defer close(done)
+					}
+					if wait != nil {
+						<-wait
+					}
+					if n := len(tg.GetResultSources()); n > 0 {
+						t.Errorf("%s reader got %d result_sources, want none", name, n)
+					}
+					for _, col := range oldCols {
+						*old = append(*old, col.Column.Hint)
+					}
+					for _, col := range cols {
+						col.Column = &statepb.Column{
+							Build:   col.Column.Build,
+							Started: col.Column.Started,
+							Hint:    col.Column.Hint,
+						}
+						select {
+						case <-ctx.Done():
+							return ctx.Err()
+						case receivers <- col:
+						}
+					}
+					return err
+				}
+			}
+			readerFor := func(tg *configpb.TestGroup) ColumnReader {
+				switch {
+				case tg.GetResultSource().GetGcsConfig() != nil:
+					var wait chan struct{}
+					if tc.slowGCS {
+						wait = ingestDone
+					}
+					return fakeReader("gcs", &gcsOld, tc.gcsCols, tc.gcsErr, wait, nil)
+				case tg.GetResultSource().GetIngestConfig() != nil:
+					return fakeReader("ingest", &ingestOld, tc.ingest, nil, nil, ingestDone)
+				}
+				return nil
+			}
+			var oldHints []string
+			for _, col := range tc.oldCols {
+				oldHints = append(oldHints, col.Column.Hint)
+			}
+
+			tg := &configpb.TestGroup{
+				Name:          "combined",
+				ResultSources: tc.sources,
+			}
+			ch := make(chan InflatedColumn)
+			var got []InflatedColumn
+			done := make(chan struct{})
+			go func() {
+				for col := range ch {
+					got = append(got, col)
+				}
+				close(done)
+			}()
+			err := CombinedColumnReader(readerFor)(context.Background(), logrus.WithField("case", tc.name), tg, tc.oldCols, time.Now().Add(-time.Hour), ch)
+			close(ch)
+			<-done
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Fatalf("CombinedColumnReader() got unexpected error: %v", err)
+				}
+				return
+			case tc.err:
+				t.Fatal("CombinedColumnReader() failed to receive an error")
+			}
+
+			sort.SliceStable(got, func(i, j int) bool {
+				return got[i].Column.Started < got[j].Column.Started
+			})
+			var builds []string
+			for _, col := range got {
+				builds = append(builds, col.Column.Build)
+			}
+			if diff := cmp.Diff(tc.want, builds); diff != "" {
+				t.Fatalf("CombinedColumnReader() got unexpected builds (-want +got):\n%s", diff)
+			}
+			for i, col := range got {
+				var found bool
+				for _, hint := range tc.wantHints[i] {
+					if col.Column.Hint == hint {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("CombinedColumnReader() got hint %q for build %s, want one of %v", col.Column.Hint, col.Column.Build, tc.wantHints[i])
+				}
+			}
+			if diff := cmp.Diff(tc.wantGCSOld, gcsOld); diff != "" {
+				t.Errorf("CombinedColumnReader() passed unexpected old gcs columns (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantIngestOld, ingestOld); diff != "" {
+				t.Errorf("CombinedColumnReader() passed unexpected old ingest columns (-want +got):\n%s", diff)
+			}
+			for i, col := range tc.oldCols {
+				if col.Column.Hint != oldHints[i] {
+					t.Errorf("CombinedColumnReader() modified old column hint %q to %q", oldHints[i], col.Column.Hint)
+				}
+			}
+		})
+	}
+}
@@ -18,9 +18,11 @@ package updater
 
 import (
 	"regexp"
+	"strconv"
 	"strings"
 	"sync"
 
+	"github.com/GoogleCloudPlatform/testgrid/internal/result"
 	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
 	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
 	tspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
@@ -31,7 +33,8 @@ var (
 	resLock sync.RWMutex
 )
 
-func strReg(val, expr string) bool {
+// cachedRegexp returns the compiled expression, or nil if it is invalid.
+func cachedRegexp(expr string) *regexp.Regexp {
 	resLock.RLock()
 	r, ok := res[expr]
 	resLock.RUnlock()
@@ -44,12 +47,34 @@ func strReg(val, expr string) bool {
 		res[expr] = r
 		resLock.Unlock()
 	}
+	return r
+}
+
+func strReg(val, expr string) bool {
+	r := cachedRegexp(expr)
 	if r == nil {
 		return false
 	}
 	return r.MatchString(val)
 }
 
+// strCapture returns the first capture group of the expression in val (or the
+// entire match when it has no groups), and whether it matched.
+func strCapture(val, expr string) (string, bool) {
+	r := cachedRegexp(expr)
+	if r == nil {
+		return "", false
+	}
+	m := r.FindStringSubmatch(val)
+	switch {
+	case m == nil:
+		return "", false
+	case len(m) > 1:
+		return m[1], true
+	}
+	return m[0], true
+}
+
 func strEQ(a, b string) bool { return a == b }
 func strNE(a, b string) bool { return a != b }
 
@@ -69,6 +94,26 @@ func numNE(a, b float64) bool {
 	return a != b
 }
 
+// numLT returns true when the comparison value b is less than the result value a.
+func numLT(a, b float64) bool {
+	return b < a
+}
+
+// numLE returns true when the comparison value b is less than or equal to the result value a.
+func numLE(a, b float64) bool {
+	return b <= a
+}
+
+// numGT returns true when the comparison value b is greater than the result value a.
+func numGT(a, b float64) bool {
+	return b > a
+}
+
+// numGE returns true when the comparison value b is greater than or equal to the result value a.
+func numGE(a, b float64) bool {
+	return b >= a
+}
+
 func targetStatusEQ(a, b tspb.TestStatus) bool {
 	return a == b
 }
@@ -85,6 +130,8 @@ type TestResult interface {
 	Failures() int
 	// The sequence of exception/error messages in the test case.
 	Exceptions() []string
+	// The message attributes of the exceptions/errors in the test case.
+	Messages() []string
 }
 
 // TargetResult defines the interface for accessing data about the target/suite result.
@@ -96,14 +143,23 @@ type TargetResult interface {
 //
 // Returns nil if no rule matches, otherwise returns the overridden status.
 func CustomStatus(rules []*evalpb.Rule, testResult TestResult) *tspb.TestStatus {
-	for _, rule := range rules {
-		if got := evalProperties(rule, testResult); got != nil {
-			return got
-		}
+	if i := MatchingRule(rules, testResult); i >= 0 {
+		want := rules[i].GetComputedStatus()
+		return &want
 	}
 	return nil
 }
 
+// MatchingRule returns the index of the first rule the result matches, or -1 if none match.
+func MatchingRule(rules []*evalpb.Rule, testResult TestResult) int {
+	for i, rule := range rules {
+		if evalProperties(rule, testResult) != nil {
+			return i
+		}
+	}
+	return -1
+}
+
 // CustomTargetStatus evaluates the result according to the rules.
 //
 // Returns nil if no rule matches, otherwise returns the overridden status.
@@ -120,6 +176,11 @@ type jUnitTestResult struct {
 	Result *junit.Result
 }
 
+// JUnitTestResult returns the TestResult of a junit test case.
+func JUnitTestResult(r *junit.Result) TestResult {
+	return jUnitTestResult{r}
+}
+
 func (jr jUnitTestResult) Properties() map[string][]string {
 	if jr.Result == nil || jr.Result.Properties == nil || jr.Result.Properties.PropertyList == nil {
 		return nil
@@ -160,120 +221,217 @@ func (jr jUnitTestResult) Exceptions() []string {
 	return options
 }
 
-func evalProperties(rule *evalpb.Rule, testResult TestResult) *tspb.TestStatus {
+func (jr jUnitTestResult) Messages() []string {
+	options := make([]string, 0, 2)
+	if e := jr.Result.Errored; e != nil {
+		options = append(options, e.Message)
+	}
+	if f := jr.Result.Failure; f != nil {
+		options = append(options, f.Message)
+	}
+	return options
+}
+
+type cellTestResult struct {
+	name string
+	cell Cell
+}
+
+// CellTestResult returns the TestResult of a cell in the named row.
+//
+// Errors are reported as failures, and the cell message as the exception.
+func CellTestResult(name string, cell Cell) TestResult {
+	return cellTestResult{name, cell}
+}
+
+func (cr cellTestResult) Properties() map[string][]string {
+	if cr.cell.Properties == nil {
+		return nil
+	}
+	out := make(map[string][]string, len(cr.cell.Properties))
+	for k, v := range cr.cell.Properties {
+		out[k] = []string{v}
+	}
+	return out
+}
+
+func (cr cellTestResult) Name() string {
+	return cr.name
+}
+
+func (cr cellTestResult) Errors() int {
+	return 0
+}
+
+func (cr cellTestResult) Failures() int {
+	if result.Failing(cr.cell.Result) {
+		return 1
+	}
+	return 0
+}
+
+func (cr cellTestResult) Exceptions() []string {
+	if cr.cell.Message == "" {
+		return nil
+	}
+	return []string{cr.cell.Message}
+}
+
+func (cr cellTestResult) Messages() []string {
+	return cr.Exceptions()
+}
+
+// matchRule returns true when every test_result_comparison, at least one any_of
+// comparison (if set) and none of the none_of comparisons succeed.
+func matchRule(rule *evalpb.Rule, eval func(*evalpb.TestResultComparison) bool) bool {
 	for _, cmp := range rule.TestResultComparisons {
-		if cmp.Comparison == nil {
-			return nil
-		}
-		var scmp func(string, string) bool
-		var fcmp func(float64, float64) bool
-		sval := cmp.Comparison.GetStringValue()
-		fval := cmp.Comparison.GetNumericalValue()
-		switch cmp.Comparison.Op {
-		case evalpb.Comparison_OP_CONTAINS:
-			scmp = strContains
-		case evalpb.Comparison_OP_REGEX:
-			scmp = strReg
-		case evalpb.Comparison_OP_STARTS_WITH:
-			scmp = strStartsWith
-		case evalpb.Comparison_OP_EQ:
-			scmp = strEQ
-			fcmp = numEQ
-		case evalpb.Comparison_OP_NE:
-			scmp = strNE
-			fcmp = numNE
+		if !eval(cmp) {
+			return false
 		}
-
-		if p := cmp.GetPropertyKey(); p != "" {
-			if scmp == nil {
-				return nil
-			}
-			var good bool
-			props := testResult.Properties()
-			if props == nil {
-				return nil
-			}
-			for _, val := range props[p] {
-				if !scmp(val, sval) {
-					continue
-				}
+	}
+	if len(rule.AnyOf) > 0 {
+		var good bool
+		for _, cmp := range rule.AnyOf {
+			if eval(cmp) {
 				good = true
 				break
 			}
-			if !good {
-				return nil
-			}
-		} else if f := cmp.GetTestResultField(); f != "" {
-			var getNum func() int
-			var getStr func() string
-			switch f {
-			case "name":
-				getStr = testResult.Name
-			case "error_count":
-				getNum = testResult.Errors
-			case "failure_count":
-				getNum = testResult.Failures
-			default: // TODO(fejta): drop or support other fields
-				return nil
-			}
-			switch {
-			case getNum != nil:
-				n := float64(getNum())
-				if !fcmp(n, fval) {
-					return nil
-				}
-			case getStr != nil:
-				if !scmp(getStr(), sval) {
-					return nil
-				}
-			}
-		} else if ef := cmp.GetTestResultErrorField(); ef != "" {
-			if scmp == nil {
-				return nil
-			}
-			if ef != "exception_type" {
-				return nil
-			}
-			var good bool
-			for _, got := range testResult.Exceptions() {
-				if scmp(got, sval) {
-					good = true
-					break
-				}
-			}
-			if !good {
-				return nil
-			}
-		} else {
-			return nil
 		}
+		if !good {
+			return false
+		}
+	}
+	for _, cmp := range rule.NoneOf {
+		if eval(cmp) {
+			return false
+		}
+	}
+	return true
+}
 
+func evalProperties(rule *evalpb.Rule, testResult TestResult) *tspb.TestStatus {
+	match := func(cmp *evalpb.TestResultComparison) bool {
+		return evalComparison(cmp, testResult)
+	}
+	if !matchRule(rule, match) {
+		return nil
 	}
 	want := rule.GetComputedStatus()
 	return &want
 }
 
-func evalTargetProperties(rule *evalpb.Rule, targetResult TargetResult) *tspb.TestStatus {
-	for _, cmp := range rule.TestResultComparisons {
-		if cmp.Comparison == nil {
-			return nil
+// evalComparison returns true when the test result satisfies the comparison.
+func evalComparison(cmp *evalpb.TestResultComparison, testResult TestResult) bool {
+	if cmp.Comparison == nil {
+		return false
+	}
+	var scmp func(string, string) bool
+	var fcmp func(float64, float64) bool
+	sval := cmp.Comparison.GetStringValue()
+	fval := cmp.Comparison.GetNumericalValue()
+	switch cmp.Comparison.Op {
+	case evalpb.Comparison_OP_CONTAINS:
+		scmp = strContains
+	case evalpb.Comparison_OP_REGEX:
+		scmp = strReg
+	case evalpb.Comparison_OP_STARTS_WITH:
+		scmp = strStartsWith
+	case evalpb.Comparison_OP_EQ:
+		scmp = strEQ
+		fcmp = numEQ
+	case evalpb.Comparison_OP_NE:
+		scmp = strNE
+		fcmp = numNE
+	case evalpb.Comparison_OP_LT:
+		fcmp = numLT
+	case evalpb.Comparison_OP_LE:
+		fcmp = numLE
+	case evalpb.Comparison_OP_GT:
+		fcmp = numGT
+	case evalpb.Comparison_OP_GE:
+		fcmp = numGE
+	}
+	_, numeric := cmp.Comparison.ComparisonValue.(*evalpb.Comparison_NumericalValue)
+
+	// match compares a string value, parsing it as a number when comparing to a numerical_value.
+	match := func(val string) bool {
+		if expr := cmp.GetCaptureRegex(); expr != "" {
+			var ok bool
+			if val, ok = strCapture(val, expr); !ok {
+				return false
+			}
+		}
+		if !numeric {
+			return scmp != nil && scmp(val, sval)
 		}
-		// Only EQ is supported
-		if cmp.Comparison.Op != evalpb.Comparison_OP_EQ {
-			return nil
+		if fcmp == nil {
+			return false
 		}
-		// Only target_status is supported
-		if f := cmp.GetTargetStatus(); f == true {
-			getSts := targetResult.TargetStatus
-			stscmp := targetStatusEQ
-			stsval := cmp.Comparison.GetTargetStatusValue()
-			if !stscmp(getSts(), stsval) {
-				return nil
+		n, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+		return err == nil && fcmp(n, fval)
+	}
+	matchAny := func(vals []string) bool {
+		for _, val := range vals {
+			if match(val) {
+				return true
 			}
-		} else {
-			return nil
 		}
+		return false
+	}
+
+	if p := cmp.GetPropertyKey(); p != "" {
+		props := testResult.Properties()
+		if props == nil {
+			return false
+		}
+		return matchAny(props[p])
+	} else if f := cmp.GetTestResultField(); f != "" {
+		var getNum func() int
+		switch f {
+		case "name":
+			return match(testResult.Name())
+		case "error_count":
+			getNum = testResult.Errors
+		case "failure_count":
+			getNum = testResult.Failures
+		default: // TODO(fejta): drop or support other fields
+			return false
+		}
+		return fcmp != nil && fcmp(float64(getNum()), fval)
+	} else if ef := cmp.GetTestResultErrorField(); ef != "" {
+		switch ef {
+		case "exception_type":
+			return matchAny(testResult.Exceptions())
+		case "message":
+			return matchAny(testResult.Messages())
+		}
+	}
+	return false
+}
+
+func evalTargetProperties(rule *evalpb.Rule, targetResult TargetResult) *tspb.TestStatus {
+	match := func(cmp *evalpb.TestResultComparison) bool {
+		return evalTargetComparison(cmp, targetResult)
+	}
+	if !matchRule(rule, match) {
+		return nil
 	}
 	want := rule.GetComputedStatus()
 	return &want
 }
+
+// evalTargetComparison returns true when the target result satisfies the comparison.
+func evalTargetComparison(cmp *evalpb.TestResultComparison, targetResult TargetResult) bool {
+	if cmp.Comparison == nil {
+		return false
+	}
+	// Only EQ is supported
+	if cmp.Comparison.Op != evalpb.Comparison_OP_EQ {
+		return false
+	}
+	// Only target_status is supported
+	if !cmp.GetTargetStatus() {
+		return false
+	}
+	return targetStatusEQ(targetResult.TargetStatus(), cmp.Comparison.GetTargetStatusValue())
+}
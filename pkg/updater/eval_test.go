@@ -20,6 +20,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
 	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
 	tspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 )
@@ -94,6 +95,10 @@ func (r fakeTestResult) Exceptions() []string {
 	panic("boom")
 }
 
+func (r fakeTestResult) Messages() []string {
+	panic("boom")
+}
+
 func makeResult(props map[string][]string) *fakeTestResult {
 	return &fakeTestResult{
 		properties: props,
@@ -351,6 +356,305 @@ func TestCustomStatus(t *testing.T) {
 				"foo": {"goal"},
 			}),
 		},
+		{
+			name: "numeric comparison of a string property",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_TIMED_OUT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+								PropertyKey: "elapsed",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_LE,
+								ComparisonValue: &evalpb.Comparison_NumericalValue{
+									NumericalValue: 300,
+								},
+							},
+						},
+					},
+				},
+			},
+			tr: makeResult(map[string][]string{
+				"elapsed": {"not a number", "300.5"},
+			}),
+			want: &timedOut,
+		},
+		{
+			name: "numeric comparison of a string property, too small",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_TIMED_OUT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+								PropertyKey: "elapsed",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_LT,
+								ComparisonValue: &evalpb.Comparison_NumericalValue{
+									NumericalValue: 300,
+								},
+							},
+						},
+					},
+				},
+			},
+			tr: makeResult(map[string][]string{
+				"elapsed": {"300"},
+			}),
+		},
+		{
+			name: "capture a number from a property",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_TIMED_OUT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+								PropertyKey: "elapsed",
+							},
+							CaptureRegex: `(\d+)s`,
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_GT,
+								ComparisonValue: &evalpb.Comparison_NumericalValue{
+									NumericalValue: 60,
+								},
+							},
+						},
+					},
+				},
+			},
+			tr: makeResult(map[string][]string{
+				"elapsed": {"took 42s"},
+			}),
+			want: &timedOut,
+		},
+		{
+			name: "regex on test name",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_TestResultField{
+								TestResultField: "name",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_REGEX,
+								ComparisonValue: &evalpb.Comparison_StringValue{
+									StringValue: `^TestFoo/.*\[slow\]`,
+								},
+							},
+						},
+					},
+				},
+			},
+			tr:   JUnitTestResult(&junit.Result{Name: "TestFoo/bar [slow]"}),
+			want: &abort,
+		},
+		{
+			name: "numeric comparison on test name",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_TestResultField{
+								TestResultField: "name",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_LT,
+							},
+						},
+					},
+				},
+			},
+			tr: JUnitTestResult(&junit.Result{Name: "TestFoo"}),
+		},
+		{
+			name: "failure count",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_TestResultField{
+								TestResultField: "failure_count",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_LT,
+								ComparisonValue: &evalpb.Comparison_NumericalValue{
+									NumericalValue: 0,
+								},
+							},
+						},
+					},
+				},
+			},
+			tr:   JUnitTestResult(&junit.Result{Failure: &junit.Failure{}}),
+			want: &abort,
+		},
+		{
+			name: "regex capture on exception message",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_TIMED_OUT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_TestResultErrorField{
+								TestResultErrorField: "message",
+							},
+							CaptureRegex: `timed out after (\d+)s`,
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_LT,
+								ComparisonValue: &evalpb.Comparison_NumericalValue{
+									NumericalValue: 300,
+								},
+							},
+						},
+					},
+				},
+			},
+			tr: JUnitTestResult(&junit.Result{
+				Failure: &junit.Failure{
+					Message: "context timed out after 600s",
+					Value:   "stack trace",
+				},
+			}),
+			want: &timedOut,
+		},
+		{
+			name: "regex capture does not match",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_TIMED_OUT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_TestResultErrorField{
+								TestResultErrorField: "exception_type",
+							},
+							CaptureRegex: `timed out after (\d+)s`,
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_LT,
+								ComparisonValue: &evalpb.Comparison_NumericalValue{
+									NumericalValue: 300,
+								},
+							},
+						},
+					},
+				},
+			},
+			tr: JUnitTestResult(&junit.Result{
+				Failure: &junit.Failure{
+					Message: "context timed out after 600s",
+					Value:   "stack trace",
+				},
+			}),
+		},
+		{
+			name: "any of",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_TIMED_OUT,
+					AnyOf: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+								PropertyKey: "foo",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_EQ,
+								ComparisonValue: &evalpb.Comparison_StringValue{
+									StringValue: "goal",
+								},
+							},
+						},
+						{
+							TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+								PropertyKey: "bar",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_EQ,
+								ComparisonValue: &evalpb.Comparison_StringValue{
+									StringValue: "goal",
+								},
+							},
+						},
+					},
+				},
+			},
+			tr: makeResult(map[string][]string{
+				"bar": {"goal"},
+			}),
+			want: &timedOut,
+		},
+		{
+			name: "any of, none match",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_TIMED_OUT,
+					AnyOf: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+								PropertyKey: "foo",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_EQ,
+								ComparisonValue: &evalpb.Comparison_StringValue{
+									StringValue: "goal",
+								},
+							},
+						},
+					},
+				},
+			},
+			tr: makeResult(map[string][]string{
+				"bar": {"goal"},
+			}),
+		},
+		{
+			name: "none of",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_TIMED_OUT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+								PropertyKey: "foo",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_EQ,
+								ComparisonValue: &evalpb.Comparison_StringValue{
+									StringValue: "goal",
+								},
+							},
+						},
+					},
+					NoneOf: []*evalpb.TestResultComparison{
+						{
+							TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+								PropertyKey: "flaky",
+							},
+							Comparison: &evalpb.Comparison{
+								Op: evalpb.Comparison_OP_EQ,
+								ComparisonValue: &evalpb.Comparison_StringValue{
+									StringValue: "true",
+								},
+							},
+						},
+					},
+				},
+				{
+					ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+				},
+			},
+			tr: makeResult(map[string][]string{
+				"foo":   {"goal"},
+				"flaky": {"true"},
+			}),
+			want: &abort,
+		},
 		// TODO(fejta): more test coverage
 	}
 
@@ -386,6 +690,20 @@ func makeTargetResult(status tspb.TestStatus) *fakeTargetResult {
 	}
 }
 
+func targetStatusIs(status tspb.TestStatus) *evalpb.TestResultComparison {
+	return &evalpb.TestResultComparison{
+		TestResultInfo: &evalpb.TestResultComparison_TargetStatus{
+			TargetStatus: true,
+		},
+		Comparison: &evalpb.Comparison{
+			Op: evalpb.Comparison_OP_EQ,
+			ComparisonValue: &evalpb.Comparison_TargetStatusValue{
+				TargetStatusValue: status,
+			},
+		},
+	}
+}
+
 func TestCustomTargetStatus(t *testing.T) {
 	abort := tspb.TestStatus_CATEGORIZED_ABORT
 	cases := []struct {
@@ -466,6 +784,62 @@ func TestCustomTargetStatus(t *testing.T) {
 			tgr:  makeTargetResult(tspb.TestStatus_TOOL_FAIL),
 			want: nil,
 		},
+		{
+			name: "any of",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+					AnyOf: []*evalpb.TestResultComparison{
+						targetStatusIs(tspb.TestStatus_TIMED_OUT),
+						targetStatusIs(tspb.TestStatus_TOOL_FAIL),
+					},
+				},
+			},
+			tgr:  makeTargetResult(tspb.TestStatus_TOOL_FAIL),
+			want: &abort,
+		},
+		{
+			name: "any of without a match",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+					AnyOf: []*evalpb.TestResultComparison{
+						targetStatusIs(tspb.TestStatus_TIMED_OUT),
+						targetStatusIs(tspb.TestStatus_BUILD_FAIL),
+					},
+				},
+			},
+			tgr: makeTargetResult(tspb.TestStatus_TOOL_FAIL),
+		},
+		{
+			name: "none of",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+					NoneOf: []*evalpb.TestResultComparison{
+						targetStatusIs(tspb.TestStatus_TIMED_OUT),
+					},
+				},
+			},
+			tgr:  makeTargetResult(tspb.TestStatus_TOOL_FAIL),
+			want: &abort,
+		},
+		{
+			name: "none of with a match",
+			rules: []*evalpb.Rule{
+				{
+					ComputedStatus: tspb.TestStatus_CATEGORIZED_ABORT,
+					TestResultComparisons: []*evalpb.TestResultComparison{
+						targetStatusIs(tspb.TestStatus_TOOL_FAIL),
+					},
+					NoneOf: []*evalpb.TestResultComparison{
+						targetStatusIs(tspb.TestStatus_TIMED_OUT),
+						targetStatusIs(tspb.TestStatus_TOOL_FAIL),
+					},
+				},
+			},
+			tgr: makeTargetResult(tspb.TestStatus_TOOL_FAIL),
+		},
 	}
 
 	for _, tc := range cases {
@@ -37,13 +37,35 @@ import (
 //
 // The suite results become rows and the job metadata is added to the column.
 type gcsResult struct {
-	podInfo   gcs.PodInfo
-	started   gcs.Started
-	finished  gcs.Finished
-	suites    []gcs.SuitesMeta
-	job       string
-	build     string
-	malformed []string
+	podInfo      gcs.PodInfo
+	started      gcs.Started
+	finished     gcs.Finished
+	prowJob      *metadata.ProwJob
+	cloneRecords []metadata.CloneRecord
+	suites       []gcs.SuitesMeta
+	job          string
+	build        string
+	malformed    []string
+}
+
+// refs returns the job type and refs the build checked out, keyed by metadata.RefsValues keys.
+//
+// Prefers prowjob.json, then clone-records.json, then the pull in started.json.
+func (r gcsResult) refs() map[string]string {
+	var jobType string
+	var refs *metadata.Refs
+	if r.prowJob != nil {
+		jobType = r.prowJob.Spec.Type
+		refs = r.prowJob.PrimaryRefs()
+	}
+	if refs == nil && len(r.cloneRecords) > 0 {
+		refs = &r.cloneRecords[0].Refs
+	}
+	out := metadata.RefsValues(jobType, refs)
+	if _, ok := out[metadata.PullKey]; !ok && r.started.Pull != "" {
+		out[metadata.PullKey] = r.started.Pull
+	}
+	return out
 }
 
 // deadline to collect information (24 hours after the job starts or an hour after finishing).
@@ -57,6 +79,9 @@ func (r gcsResult) deadline() time.Time {
 
 const maxDuplicates = 20
 
+// failedOutsideTests explains a failing build without failing tests.
+const failedOutsideTests = "Build failed outside of test results"
+
 // EmailListKey is the expected metadata key for email addresses.
 const EmailListKey = "EmailAddresses"
 
@@ -125,6 +150,9 @@ const (
 //
 // Includes the message from the "most relevant" cell that includes a message.
 // Where relevance is determined by result.GTE.
+//
+// Flaky cells (which passed after a rerun) count as passes, but their
+// failure message is preferred over messages from passing cells.
 func MergeCells(flaky bool, cells ...Cell) Cell {
 	var out Cell
 	if len(cells) == 0 {
@@ -140,6 +168,7 @@ func MergeCells(flaky bool, cells ...Cell) Cell {
 	var passMsg string
 	var fail int
 	var failMsg string
+	var flakyMsg string
 
 	// determine the status and potential messages
 	// gather all metrics
@@ -156,6 +185,11 @@ func MergeCells(flaky bool, cells ...Cell) Cell {
 			current = c.Result
 		}
 		switch {
+		case c.Result == statuspb.TestStatus_FLAKY:
+			pass++
+			if c.Message != "" && flakyMsg == "" {
+				flakyMsg = c.Message
+			}
 		case result.Passing(c.Result):
 			pass++
 			if c.Message != "" && result.GTE(c.Result, passMessageResult) {
@@ -201,6 +235,8 @@ func MergeCells(flaky bool, cells ...Cell) Cell {
 	var msg string
 	if failMsg != "" {
 		msg = failMsg
+	} else if flakyMsg != "" {
+		msg = flakyMsg
 	} else if passMsg != "" {
 		msg = passMsg
 	}
@@ -285,6 +321,7 @@ func convertResult(log logrus.FieldLogger, nameCfg nameConfig, id string, header
 	}
 
 	meta := result.finished.Metadata.Strings()
+	refs := result.refs()
 	version := metadata.Version(result.started.Started, result.finished.Finished)
 
 	// Append each result into the column
@@ -294,7 +331,12 @@ func convertResult(log logrus.FieldLogger, nameCfg nameConfig, id string, header
 			if r.Skipped != nil && r.Skipped.Value == "" && (r.Skipped.Message == "skipped" || r.Skipped.Message == "") {
 				continue
 			}
-			c := Cell{CellID: cellID}
+			extracted := outputProperties(opt.output, &r)
+			r = withProperties(r, extracted)
+			c := Cell{
+				CellID:     cellID,
+				Properties: cellProperties(extracted),
+			}
 			if elapsed := r.Time; elapsed > 0 {
 				c.Metrics = setElapsed(c.Metrics, elapsed)
 			}
@@ -326,6 +368,14 @@ func convertResult(log logrus.FieldLogger, nameCfg nameConfig, id string, header
 			case r.Skipped != nil:
 				c.Result = statuspb.TestStatus_PASS_WITH_SKIPS
 				c.Icon = "S"
+			case r.Flaky():
+				// Passed only after rerunning, so summarize like merged runs.
+				c.Result = statuspb.TestStatus_FLAKY
+				c.Icon = "1/" + strconv.Itoa(len(r.AllReruns())+1)
+				c.Message = c.Icon + " runs passed"
+				if msg := r.Message(max); msg != "" {
+					c.Message += ": " + msg
+				}
 			default:
 				c.Result = statuspb.TestStatus_PASS
 			}
@@ -355,7 +405,7 @@ func convertResult(log logrus.FieldLogger, nameCfg nameConfig, id string, header
 				c.UserProperty = values[0]
 			}
 
-			name := nameCfg.render(result.job, r.Name, first(props), suite.Metadata, meta)
+			name := nameCfg.render(result.job, r.Name, first(props), suite.Metadata, meta, refs)
 			cells[name] = append(cells[name], c)
 		}
 	}
@@ -376,9 +426,15 @@ func convertResult(log logrus.FieldLogger, nameCfg nameConfig, id string, header
 		}
 		if !found { // Nope, add the F icon and an explanatory Message
 			overall.Icon = "F"
-			overall.Message = "Build failed outside of test results"
+			overall.Message = failedOutsideTests
+		}
+	}
+	if opt.analyzeProwJob && overall.Result == statuspb.TestStatus_FAIL {
+		if category := result.podInfo.Classify(); category != gcs.NoFailure {
+			overall.Properties = map[string]string{gcs.FailureCategoryKey: string(category)}
 		}
 	}
+
 	injectedCells := map[string]Cell{
 		overallRow: overall,
 	}
@@ -434,6 +490,9 @@ func convertResult(log logrus.FieldLogger, nameCfg nameConfig, id string, header
 
 	for _, h := range headers {
 		val, ok := meta[h]
+		if !ok {
+			val, ok = refs[h]
+		}
 		if !ok && h == "Commit" && version != metadata.Missing {
 			val = version
 		} else if !ok && overall.Result != statuspb.TestStatus_RUNNING {
@@ -475,10 +534,16 @@ func podInfoCell(result gcsResult) Cell {
 		icon = "F"
 	}
 
+	var props map[string]string
+	if category := podInfo.Classify(); category != gcs.NoFailure {
+		props = map[string]string{gcs.FailureCategoryKey: string(category)}
+	}
+
 	return Cell{
-		Message: msg,
-		Icon:    icon,
-		Result:  status,
+		Message:    msg,
+		Icon:       icon,
+		Result:     status,
+		Properties: props,
 	}
 }
 
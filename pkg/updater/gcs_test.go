@@ -19,6 +19,7 @@ package updater
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"testing"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/GoogleCloudPlatform/testgrid/metadata"
 	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
 	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
 	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
@@ -205,6 +207,44 @@ func TestMergeCells(t *testing.T) {
 				Message: "1/2 runs passed: boom",
 			},
 		},
+		{
+			name: "flaky cells count as passes with their message",
+			cells: []Cell{
+				{
+					Result:  statuspb.TestStatus_PASS,
+					Message: "yay",
+				},
+				{
+					Result:  statuspb.TestStatus_FLAKY,
+					Icon:    "1/2",
+					Message: "1/2 runs passed: boom",
+				},
+			},
+			expected: Cell{
+				Result:  statuspb.TestStatus_FLAKY,
+				Icon:    "2/2",
+				Message: "2/2 runs passed: 1/2 runs passed: boom",
+			},
+		},
+		{
+			name:  "failure messages take priority over flaky messages",
+			flaky: true,
+			cells: []Cell{
+				{
+					Result:  statuspb.TestStatus_FLAKY,
+					Message: "1/2 runs passed: boom",
+				},
+				{
+					Result:  statuspb.TestStatus_FAIL,
+					Message: "bang",
+				},
+			},
+			expected: Cell{
+				Result:  statuspb.TestStatus_FLAKY,
+				Icon:    "1/2",
+				Message: "1/2 runs passed: bang",
+			},
+		},
 		{
 			name: "mix of passes and failures will fail upon request",
 			cells: []Cell{
@@ -313,6 +353,7 @@ func TestConvertResult(t *testing.T) {
 		return &s
 	}
 	yes := true
+	no := false
 	now := time.Now().Unix()
 	cases := []struct {
 		name     string
@@ -603,6 +644,122 @@ func TestConvertResult(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "prow refs in column headers and names",
+			headers: []string{metadata.PullKey, metadata.PullAuthorKey, metadata.JobTypeKey, metadata.BaseRefKey},
+			id:      "build",
+			nameCfg: nameConfig{
+				format: "%s [%s]",
+				parts:  []string{testsName, metadata.BaseRefKey},
+			},
+			result: gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{
+						Timestamp: now,
+						Pull:      "ignored",
+					},
+				},
+				finished: gcs.Finished{
+					Finished: metadata.Finished{
+						Timestamp: pint(now + 1),
+						Passed:    &yes,
+						Metadata: metadata.Metadata{
+							metadata.BaseRefKey: "overridden",
+						},
+					},
+				},
+				prowJob: &metadata.ProwJob{
+					Spec: metadata.ProwJobSpec{
+						Type: "batch",
+						Refs: &metadata.Refs{
+							BaseRef: "main",
+							Pulls: []metadata.Pull{
+								{Number: 1, Author: "alice"},
+								{Number: 2, Author: "bob"},
+							},
+						},
+					},
+				},
+				suites: []gcs.SuitesMeta{
+					{
+						Suites: &junit.Suites{
+							Suites: []junit.Suite{
+								{
+									Results: []junit.Result{
+										{
+											Name: "that",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				job: "job-name",
+			},
+			expected: InflatedColumn{
+				Column: &statepb.Column{
+					Started: float64(now * 1000),
+					Build:   "build",
+					Hint:    "build",
+					Extra: []string{
+						"1,2",
+						"alice,bob",
+						"batch",
+						"overridden",
+					},
+				},
+				Cells: map[string]Cell{
+					"job-name." + overallRow: {
+						Result:  statuspb.TestStatus_PASS,
+						Metrics: setElapsed(nil, 1),
+					},
+					"that [overridden]": {
+						Result: statuspb.TestStatus_PASS,
+					},
+				},
+			},
+		},
+		{
+			name:    "pull from clone records and started",
+			headers: []string{metadata.PullKey, metadata.RepoKey},
+			id:      "build",
+			result: gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{
+						Timestamp: now,
+						Pull:      "123",
+					},
+				},
+				finished: gcs.Finished{
+					Finished: metadata.Finished{
+						Timestamp: pint(now + 1),
+						Passed:    &yes,
+					},
+				},
+				cloneRecords: []metadata.CloneRecord{
+					{Refs: metadata.Refs{Org: "org", Repo: "repo"}},
+				},
+				job: "job-name",
+			},
+			expected: InflatedColumn{
+				Column: &statepb.Column{
+					Started: float64(now * 1000),
+					Build:   "build",
+					Hint:    "build",
+					Extra: []string{
+						"123",
+						"repo",
+					},
+				},
+				Cells: map[string]Cell{
+					"job-name." + overallRow: {
+						Result:  statuspb.TestStatus_PASS,
+						Metrics: setElapsed(nil, 1),
+					},
+				},
+			},
+		},
 		{
 			name: "include job name upon request",
 			nameCfg: nameConfig{
@@ -770,6 +927,19 @@ func TestConvertResult(t *testing.T) {
 											Name:   "stdout message",
 											Output: pstr("bellybutton"),
 										},
+										{
+											Name: "passed after reruns",
+											FlakyFailures: []junit.Rerun{
+												{Message: "first try"},
+												{Message: "second try"},
+											},
+											Output: pstr("irrelevant message"),
+										},
+										{
+											Name:          "failed every rerun",
+											Failure:       &junit.Failure{Value: *pstr("every time")},
+											RerunFailures: []junit.Rerun{{Message: "again"}},
+										},
 									},
 								},
 							},
@@ -825,6 +995,16 @@ func TestConvertResult(t *testing.T) {
 						Message: "bellybutton",
 						Result:  statuspb.TestStatus_PASS,
 					},
+					"passed after reruns": {
+						Message: "1/3 runs passed: first try",
+						Result:  statuspb.TestStatus_FLAKY,
+						Icon:    "1/3",
+					},
+					"failed every rerun": {
+						Message: "every time",
+						Result:  statuspb.TestStatus_FAIL,
+						Icon:    "F",
+					},
 				},
 			},
 		},
@@ -1235,6 +1415,107 @@ func TestConvertResult(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "output properties",
+			nameCfg: nameConfig{
+				format: "%s",
+				parts:  []string{testsName},
+			},
+			opt: groupOptions{
+				output: outputOptions{
+					systemErrChars: 4,
+					attachments:    true,
+					keyValue:       regexp.MustCompile(`^node$`),
+				},
+				rules: []*evalpb.Rule{
+					{
+						ComputedStatus: statuspb.TestStatus_CATEGORIZED_FAIL,
+						TestResultComparisons: []*evalpb.TestResultComparison{
+							{
+								TestResultInfo: &evalpb.TestResultComparison_PropertyKey{
+									PropertyKey: "node",
+								},
+								Comparison: &evalpb.Comparison{
+									Op: evalpb.Comparison_OP_EQ,
+									ComparisonValue: &evalpb.Comparison_StringValue{
+										StringValue: "bad-node",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			result: gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{
+						Timestamp: now,
+					},
+				},
+				finished: gcs.Finished{
+					Finished: metadata.Finished{
+						Timestamp: pint(now + 1),
+						Passed:    &yes,
+					},
+				},
+				suites: []gcs.SuitesMeta{
+					{
+						Suites: &junit.Suites{
+							Suites: []junit.Suite{
+								{
+									Results: []junit.Result{
+										{
+											Name: "no output",
+										},
+										{
+											Name:   "attachments",
+											Output: pstr("[[ATTACHMENT|a.png]]\nnode=good-node\n[[ATTACHMENT|b.png]]"),
+										},
+										{
+											Name:    "bad node",
+											Failure: &junit.Failure{Value: *pstr("boom")},
+											Output:  pstr("node=bad-node\nother=ignored"),
+											Error:   pstr("stderr output"),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: InflatedColumn{
+				Column: &statepb.Column{
+					Started: float64(now * 1000),
+				},
+				Cells: map[string]Cell{
+					"." + overallRow: {
+						Result:  statuspb.TestStatus_PASS,
+						Metrics: setElapsed(nil, 1),
+					},
+					"no output": {
+						Result: statuspb.TestStatus_PASS,
+					},
+					"attachments": {
+						Result:  statuspb.TestStatus_PASS,
+						Message: "[[ATTACHMENT|a.png]]\nnode=good-node\n[[ATTACHMENT|b.png]]",
+						Properties: map[string]string{
+							AttachmentProperty: "a.png\nb.png",
+							"node":             "good-node",
+						},
+					},
+					"bad node": {
+						Result:  statuspb.TestStatus_CATEGORIZED_FAIL,
+						Message: "boom",
+						Icon:    "F",
+						Properties: map[string]string{
+							SystemErrProperty: "stde",
+							"node":            "bad-node",
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "names formatted correctly",
 			nameCfg: nameConfig{
@@ -1634,6 +1915,57 @@ func TestConvertResult(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "classify infra failures",
+			opt: groupOptions{
+				analyzeProwJob: true,
+			},
+			result: gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{
+						Timestamp: now,
+					},
+				},
+				finished: gcs.Finished{
+					Finished: metadata.Finished{
+						Timestamp: pint(now + 1),
+						Passed:    &no,
+					},
+				},
+				podInfo: gcs.PodInfo{
+					Pod: &core.Pod{
+						Status: core.PodStatus{
+							Phase:  core.PodFailed,
+							Reason: "Evicted",
+						},
+					},
+				},
+			},
+			expected: InflatedColumn{
+				Column: &statepb.Column{
+					Started: float64(now * 1000),
+				},
+				Cells: map[string]Cell{
+					"." + overallRow: {
+						Result:  statuspb.TestStatus_FAIL,
+						Icon:    "F",
+						Message: failedOutsideTests,
+						Metrics: setElapsed(nil, 1),
+						Properties: map[string]string{
+							gcs.FailureCategoryKey: string(gcs.Evicted),
+						},
+					},
+					"." + podInfoRow: {
+						Result:  statuspb.TestStatus_PASS,
+						Icon:    "E",
+						Message: gcs.NoPodUtils,
+						Properties: map[string]string{
+							gcs.FailureCategoryKey: string(gcs.Evicted),
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "do not add missing podinfo when still running",
 			opt: groupOptions{
@@ -2102,6 +2434,9 @@ func TestPodInfoCell(t *testing.T) {
 				Message: "pod did not schedule: hi there",
 				Icon:    "F",
 				Result:  statuspb.TestStatus_FAIL,
+				Properties: map[string]string{
+					gcs.FailureCategoryKey: string(gcs.Unschedulable),
+				},
 			},
 		},
 	}
@@ -0,0 +1,399 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fvbommel/sortorder"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// defaultGitHubURL is the base URL of the public GitHub REST API.
+const defaultGitHubURL = "https://api.github.com"
+
+const (
+	runsPerPage     = 100
+	maxArtifactSize = 100e6 // bytes
+)
+
+// GitHubActionsClient reads workflow runs and their artifacts from the GitHub REST API.
+type GitHubActionsClient struct {
+	token   string
+	baseURL string
+	client  *http.Client
+}
+
+// NewGitHubActionsClient returns a client, which authenticates with token when set.
+//
+// Uses api.github.com unless baseURL is set (such as for GitHub Enterprise).
+func NewGitHubActionsClient(token, baseURL string) *GitHubActionsClient {
+	if baseURL == "" {
+		baseURL = defaultGitHubURL
+	}
+	return &GitHubActionsClient{
+		token:   token,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client:  http.DefaultClient,
+	}
+}
+
+type workflowRun struct {
+	ID           int64     `json:"id"`
+	RunNumber    int64     `json:"run_number"`
+	RunAttempt   int64     `json:"run_attempt"`
+	HeadSHA      string    `json:"head_sha"`
+	HeadBranch   string    `json:"head_branch"`
+	Event        string    `json:"event"`
+	Status       string    `json:"status"`
+	Conclusion   string    `json:"conclusion"`
+	CreatedAt    time.Time `json:"created_at"`
+	RunStartedAt time.Time `json:"run_started_at"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+// started returns when the latest attempt of the run started.
+func (run workflowRun) started() time.Time {
+	if run.RunStartedAt.IsZero() {
+		return run.CreatedAt
+	}
+	return run.RunStartedAt
+}
+
+type workflowArtifact struct {
+	Name               string `json:"name"`
+	ArchiveDownloadURL string `json:"archive_download_url"`
+	Expired            bool   `json:"expired"`
+}
+
+// get returns the response body of the path, or absolute url.
+func (c *GitHubActionsClient) get(ctx context.Context, u string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(u, "http://") && !strings.HasPrefix(u, "https://") {
+		u = c.baseURL + u
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf("request: %w", err)
+	}
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("GET %s: %w", u, err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return nil, fmt.Errorf("GET %s: %s: %s", u, resp.Status, strings.TrimSpace(string(msg)))
+	}
+	return resp.Body, nil
+}
+
+func (c *GitHubActionsClient) getJSON(ctx context.Context, path string, out interface{}) error {
+	body, err := c.get(ctx, path)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	if err := json.NewDecoder(body).Decode(out); err != nil {
+		return fmt.Errorf("decode: %w", err)
+	}
+	return nil
+}
+
+// runs returns a page of the workflow's runs, newest first.
+func (c *GitHubActionsClient) runs(ctx context.Context, cfg *configpb.GitHubActionsConfig, page int) ([]workflowRun, error) {
+	q := url.Values{}
+	q.Set("per_page", strconv.Itoa(runsPerPage))
+	q.Set("page", strconv.Itoa(page))
+	if b := cfg.GetBranch(); b != "" {
+		q.Set("branch", b)
+	}
+	p := fmt.Sprintf("/repos/%s/actions/workflows/%s/runs?%s", cfg.GetRepository(), url.PathEscape(cfg.GetWorkflow()), q.Encode())
+	var out struct {
+		WorkflowRuns []workflowRun `json:"workflow_runs"`
+	}
+	if err := c.getJSON(ctx, p, &out); err != nil {
+		return nil, err
+	}
+	return out.WorkflowRuns, nil
+}
+
+// artifacts returns the artifacts uploaded by the run.
+func (c *GitHubActionsClient) artifacts(ctx context.Context, repo string, runID int64) ([]workflowArtifact, error) {
+	var arts []workflowArtifact
+	for page := 1; ; page++ {
+		p := fmt.Sprintf("/repos/%s/actions/runs/%d/artifacts?per_page=%d&page=%d", repo, runID, runsPerPage, page)
+		var out struct {
+			Artifacts []workflowArtifact `json:"artifacts"`
+		}
+		if err := c.getJSON(ctx, p, &out); err != nil {
+			return nil, err
+		}
+		arts = append(arts, out.Artifacts...)
+		if len(out.Artifacts) < runsPerPage {
+			return arts, nil
+		}
+	}
+}
+
+// download returns the zip archive of the artifact.
+func (c *GitHubActionsClient) download(ctx context.Context, art workflowArtifact) (*zip.Reader, error) {
+	body, err := c.get(ctx, art.ArchiveDownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+	buf, err := ioutil.ReadAll(io.LimitReader(body, maxArtifactSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	if len(buf) > maxArtifactSize {
+		return nil, fmt.Errorf("too large: > %d bytes max", int64(maxArtifactSize))
+	}
+	return zip.NewReader(bytes.NewReader(buf), int64(len(buf)))
+}
+
+// GitHubActions returns a GroupUpdater for workflow runs on GitHub Actions.
+//
+// Links the issues from gatherIssues to rows when non-nil.
+func GitHubActions(actions *GitHubActionsClient, groupTimeout time.Duration, write bool, gatherIssues IssueGatherer) GroupUpdater {
+	return func(parent context.Context, log logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path) (bool, error) {
+		if tg.GetResultSource().GetGithubActionsConfig() == nil {
+			log.Debug("Skipping non-GitHub Actions group")
+			return false, nil
+		}
+		if actions == nil {
+			log.WithField("name", tg.GetName()).Warn("GitHub Actions update requested, but no client found.")
+			return false, nil
+		}
+		ctx, cancel := context.WithTimeout(parent, groupTimeout)
+		defer cancel()
+		reprocess := 20 * time.Minute // allow running columns to be replaced
+		return InflateDropAppend(ctx, log, client, tg, gridPath, write, GitHubActionsColumnReader(actions), reprocess, gatherIssues)
+	}
+}
+
+// GitHubActionsColumnReader returns a ColumnReader for the workflow runs of a GitHub Actions group.
+func GitHubActionsColumnReader(actions *GitHubActionsClient) ColumnReader {
+	return func(ctx context.Context, parentLog logrus.FieldLogger, tg *configpb.TestGroup, oldCols []InflatedColumn, stop time.Time, receivers chan<- InflatedColumn) error {
+		cfg := tg.GetResultSource().GetGithubActionsConfig()
+		var artifactRegex *regexp.Regexp
+		if r := cfg.GetArtifactRegex(); r != "" {
+			var err error
+			if artifactRegex, err = regexp.Compile(r); err != nil {
+				return fmt.Errorf("artifact_regex: %w", err)
+			}
+		}
+
+		since := hintStarted(oldCols)
+		log := parentLog.WithField("since", since)
+		runs, err := listRuns(ctx, actions, cfg, since, stop)
+		if err != nil {
+			return fmt.Errorf("list runs: %w", err)
+		}
+		log.WithField("total", len(runs)).Debug("Listed workflow runs")
+
+		nameCfg := makeNameConfig(tg)
+		parsers, err := artifactParsers(tg)
+		if err != nil {
+			log.WithError(err).Warning("Failed to find artifact parsers, using defaults")
+		}
+		if len(parsers) == 0 {
+			if parsers, err = gcs.ArtifactParsers(); err != nil {
+				return fmt.Errorf("artifact parsers: %w", err)
+			}
+		}
+		var heads []string
+		for _, h := range tg.ColumnHeader {
+			heads = append(heads, h.ConfigurationValue)
+		}
+		opts := makeOptions(tg)
+		opts.analyzeProwJob = false // no pod to analyze
+
+		for _, run := range runs {
+			id := strconv.FormatInt(run.RunNumber, 10)
+			log := log.WithField("run", id)
+			result, err := readRun(ctx, actions, cfg.GetRepository(), run, artifactRegex, parsers)
+			var col InflatedColumn
+			if err == nil {
+				result.job = tg.GetName()
+				col = convertResult(log, nameCfg, id, heads, *result, opts)
+			} else {
+				log.WithError(err).Trace("Failed to read run")
+				msg := fmt.Sprintf("Failed to read run %d: %s", run.ID, err.Error())
+				col = erroredColumn(id, float64(run.started().Unix()*1000), make([]string, len(heads)), msg)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case receivers <- col:
+			}
+		}
+		return nil
+	}
+}
+
+// listRuns returns the runs after since that started after stop, oldest first.
+//
+// Columns are keyed by run number, so a re-run attempt of a run at or before
+// since is not read again: its column keeps the results of the attempt that
+// was read first, unless that column is still being reprocessed.
+func listRuns(ctx context.Context, actions *GitHubActionsClient, cfg *configpb.GitHubActionsConfig, since string, stop time.Time) ([]workflowRun, error) {
+	var out []workflowRun
+	for page := 1; ; page++ {
+		runs, err := actions.runs(ctx, cfg, page)
+		if err != nil {
+			return nil, fmt.Errorf("page %d: %w", page, err)
+		}
+		for _, run := range runs {
+			if run.started().Before(stop) {
+				continue // reruns may start an older run after newer ones
+			}
+			if since != "" && !sortorder.NaturalLess(since, strconv.FormatInt(run.RunNumber, 10)) {
+				continue
+			}
+			out = append(out, run)
+		}
+		// Runs are sorted by creation, so no later page has anything more recent.
+		if len(runs) < runsPerPage || runs[len(runs)-1].CreatedAt.Before(stop) {
+			break
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].RunNumber < out[j].RunNumber
+	})
+	return out, nil
+}
+
+// readRun returns the run's metadata and the test results in its matching artifacts.
+func readRun(ctx context.Context, actions *GitHubActionsClient, repo string, run workflowRun, artifactRegex *regexp.Regexp, parsers []gcs.ArtifactParser) (*gcsResult, error) {
+	meta := metadata.Metadata{
+		"commit":      run.HeadSHA,
+		"branch":      run.HeadBranch,
+		"event":       run.Event,
+		"run_attempt": strconv.FormatInt(run.RunAttempt, 10),
+		"run_number":  strconv.FormatInt(run.RunNumber, 10),
+	}
+	result := gcsResult{
+		started: gcs.Started{
+			Started: metadata.Started{Timestamp: run.started().Unix()},
+		},
+		finished: gcs.Finished{
+			Finished: metadata.Finished{Metadata: meta},
+			Running:  run.Status != "completed",
+		},
+		build: strconv.FormatInt(run.RunNumber, 10),
+	}
+	if result.finished.Running {
+		return &result, nil
+	}
+	when, passed := run.UpdatedAt.Unix(), run.Conclusion == "success"
+	result.finished.Timestamp = &when
+	result.finished.Passed = &passed
+	result.finished.Result = strings.ToUpper(run.Conclusion)
+
+	arts, err := actions.artifacts(ctx, repo, run.ID)
+	if err != nil {
+		return nil, fmt.Errorf("list artifacts: %w", err)
+	}
+	for _, art := range arts {
+		if art.Expired || artifactRegex != nil && !artifactRegex.MatchString(art.Name) {
+			continue
+		}
+		archive, err := actions.download(ctx, art)
+		if err != nil {
+			result.malformed = append(result.malformed, fmt.Sprintf("%s: %s", art.Name, err))
+			continue
+		}
+		suites, problems := readArchive(art.Name, archive, parsers)
+		result.suites = append(result.suites, suites...)
+		result.malformed = append(result.malformed, problems...)
+	}
+	sort.Strings(result.malformed)
+	return &result, nil
+}
+
+// readArchive returns the test results in the files of the artifact, along with any malformed files.
+func readArchive(name string, archive *zip.Reader, parsers []gcs.ArtifactParser) ([]gcs.SuitesMeta, []string) {
+	var suites []gcs.SuitesMeta
+	var problems []string
+	for _, f := range archive.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+		p := path.Join(name, f.Name)
+		var parser gcs.ArtifactParser
+		var meta map[string]string
+		for _, ap := range parsers {
+			if meta = ap.Metadata("/" + p); meta != nil {
+				parser = ap
+				break
+			}
+		}
+		if meta == nil {
+			continue // not a test result file, ignore it
+		}
+		if f.UncompressedSize64 > maxArtifactSize {
+			problems = append(problems, fmt.Sprintf("%s: too large: %d bytes > %d bytes max", p, f.UncompressedSize64, int64(maxArtifactSize)))
+			continue
+		}
+		r, err := f.Open()
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", p, err))
+			continue
+		}
+		// Do not trust the declared size.
+		parsed, err := parser.Parse(io.LimitReader(r, maxArtifactSize))
+		r.Close()
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s: %s", p, err))
+			continue
+		}
+		if parsed == nil {
+			continue // not test results after all
+		}
+		const max = 1000
+		parsed.Truncate(max)
+		suites = append(suites, gcs.SuitesMeta{
+			Suites:   parsed,
+			Metadata: meta,
+			Path:     p,
+		})
+	}
+	return suites, problems
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+func zipFiles(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for name, data := range files {
+		f, err := w.Create(name)
+		if err != nil {
+			t.Fatalf("create %s: %v", name, err)
+		}
+		if _, err := f.Write([]byte(data)); err != nil {
+			t.Fatalf("write %s: %v", name, err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadArchive(t *testing.T) {
+	parsers, err := gcs.ArtifactParsers()
+	if err != nil {
+		t.Fatalf("gcs.ArtifactParsers(): %v", err)
+	}
+	buf := zipFiles(t, map[string]string{
+		"reports/junit_unit.xml": `<testsuite><testcase name="hello"/></testsuite>`,
+		"reports/junit_bad.xml":  `<testsuite><testcase`,
+		"coverage.txt":           "not results",
+	})
+	archive, err := zip.NewReader(bytes.NewReader(buf), int64(len(buf)))
+	if err != nil {
+		t.Fatalf("zip.NewReader(): %v", err)
+	}
+	suites, problems := readArchive("results", archive, parsers)
+	if len(suites) != 1 {
+		t.Fatalf("readArchive() got %d suites, want 1: %v", len(suites), suites)
+	}
+	if got, want := suites[0].Path, "results/reports/junit_unit.xml"; got != want {
+		t.Errorf("readArchive() got path %q, want %q", got, want)
+	}
+	if got, want := suites[0].Metadata["Context"], "unit"; got != want {
+		t.Errorf("readArchive() got context %q, want %q", got, want)
+	}
+	if len(problems) != 1 {
+		t.Errorf("readArchive() got problems %v, want one for junit_bad.xml", problems)
+	}
+}
+
+func TestReadArchiveTooLarge(t *testing.T) {
+	parsers, err := gcs.ArtifactParsers()
+	if err != nil {
+		t.Fatalf("gcs.ArtifactParsers(): %v", err)
+	}
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	data := []byte(`<testsuite><testcase name="hello"/></testsuite>`)
+	f, err := w.CreateRaw(&zip.FileHeader{
+		Name:               "junit_huge.xml",
+		Method:             zip.Store,
+		CompressedSize64:   uint64(len(data)),
+		UncompressedSize64: maxArtifactSize + 1,
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	if _, err := f.Write(data); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("close: %v", err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatalf("zip.NewReader(): %v", err)
+	}
+	suites, problems := readArchive("results", archive, parsers)
+	if len(suites) != 0 {
+		t.Errorf("readArchive() got suites %v, want none", suites)
+	}
+	if len(problems) != 1 {
+		t.Errorf("readArchive() got problems %v, want one for junit_huge.xml", problems)
+	}
+}
+
+func TestGitHubActionsColumnReader(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	runs := []workflowRun{
+		{
+			ID:           30,
+			RunNumber:    3,
+			RunAttempt:   1,
+			HeadSHA:      "ccc",
+			HeadBranch:   "main",
+			Status:       "in_progress",
+			CreatedAt:    now.Add(-time.Minute),
+			RunStartedAt: now.Add(-time.Minute),
+			UpdatedAt:    now,
+		},
+		{
+			ID:           20,
+			RunNumber:    2,
+			RunAttempt:   2,
+			HeadSHA:      "bbb",
+			HeadBranch:   "main",
+			Event:        "push",
+			Status:       "completed",
+			Conclusion:   "failure",
+			CreatedAt:    now.Add(-time.Hour),
+			RunStartedAt: now.Add(-10 * time.Minute),
+			UpdatedAt:    now.Add(-5 * time.Minute),
+		},
+		{
+			ID:           10,
+			RunNumber:    1,
+			HeadSHA:      "aaa",
+			HeadBranch:   "main",
+			Status:       "completed",
+			Conclusion:   "success",
+			CreatedAt:    now.Add(-72 * time.Hour),
+			RunStartedAt: now.Add(-72 * time.Hour),
+			UpdatedAt:    now.Add(-71 * time.Hour),
+		},
+	}
+	results := zipFiles(t, map[string]string{
+		"junit_e2e.xml": `<testsuite><testcase name="good"/><testcase name="bad"><failure message="boom"/></testcase></testsuite>`,
+	})
+
+	var lock sync.Mutex
+	var paths []string
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("/repos/org/repo/actions/workflows/ci.yaml/runs", func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer secret" {
+			http.Error(w, "bad token: "+got, http.StatusUnauthorized)
+			return
+		}
+		if b := r.URL.Query().Get("branch"); b != "main" {
+			http.Error(w, "bad branch: "+b, http.StatusBadRequest)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{"workflow_runs": runs})
+	})
+	mux.HandleFunc("/repos/org/repo/actions/runs/20/artifacts", func(w http.ResponseWriter, r *http.Request) {
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"artifacts": []workflowArtifact{
+				{Name: "test-results", ArchiveDownloadURL: server.URL + "/download/1"},
+				{Name: "binaries", ArchiveDownloadURL: server.URL + "/download/2"},
+				{Name: "old-results", ArchiveDownloadURL: server.URL + "/download/3", Expired: true},
+			},
+		})
+	})
+	mux.HandleFunc("/download/", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		paths = append(paths, r.URL.Path)
+		lock.Unlock()
+		w.Write(results)
+	})
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	group := &configpb.TestGroup{
+		Name: "ci",
+		ColumnHeader: []*configpb.TestGroup_ColumnHeader{
+			{ConfigurationValue: "commit"},
+			{ConfigurationValue: "branch"},
+		},
+		ResultSource: &configpb.TestGroup_ResultSource{
+			ResultSourceConfig: &configpb.TestGroup_ResultSource_GithubActionsConfig{
+				GithubActionsConfig: &configpb.GitHubActionsConfig{
+					Repository:    "org/repo",
+					Workflow:      "ci.yaml",
+					Branch:        "main",
+					ArtifactRegex: "results",
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		name    string
+		oldCols []InflatedColumn
+		want    []InflatedColumn
+	}{
+		{
+			name: "read runs after stop",
+			want: []InflatedColumn{
+				{
+					Column: &statepb.Column{
+						Build:   "2",
+						Hint:    "2",
+						Started: float64(now.Add(-10*time.Minute).Unix() * 1000),
+						Extra:   []string{"bbb", "main"},
+					},
+					Cells: map[string]Cell{
+						"ci." + overallRow: {
+							Result:  statuspb.TestStatus_FAIL,
+							Metrics: setElapsed(nil, 300),
+						},
+						"good": {Result: statuspb.TestStatus_PASS},
+						"bad":  {Result: statuspb.TestStatus_FAIL, Message: "boom", Icon: "F"},
+					},
+				},
+				{
+					Column: &statepb.Column{
+						Build:   "3",
+						Hint:    "3",
+						Started: float64(now.Add(-time.Minute).Unix() * 1000),
+						Extra:   []string{"ccc", "main"},
+					},
+					Cells: map[string]Cell{
+						"ci." + overallRow: {
+							Result:  statuspb.TestStatus_RUNNING,
+							Icon:    "R",
+							Message: "Build still running...",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "read runs after hint",
+			oldCols: []InflatedColumn{
+				{Column: &statepb.Column{Hint: "2"}},
+			},
+			want: []InflatedColumn{
+				{
+					Column: &statepb.Column{
+						Build:   "3",
+						Hint:    "3",
+						Started: float64(now.Add(-time.Minute).Unix() * 1000),
+						Extra:   []string{"ccc", "main"},
+					},
+					Cells: map[string]Cell{
+						"ci." + overallRow: {
+							Result:  statuspb.TestStatus_RUNNING,
+							Icon:    "R",
+							Message: "Build still running...",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			lock.Lock()
+			paths = nil
+			lock.Unlock()
+			ch := make(chan InflatedColumn)
+			var got []InflatedColumn
+			done := make(chan struct{})
+			go func() {
+				for col := range ch {
+					got = append(got, col)
+				}
+				close(done)
+			}()
+			readColumns := GitHubActionsColumnReader(NewGitHubActionsClient("secret", server.URL))
+			err := readColumns(context.Background(), logrus.WithField("case", tc.name), group, tc.oldCols, now.Add(-24*time.Hour), ch)
+			close(ch)
+			<-done
+			if err != nil {
+				t.Fatalf("GitHubActionsColumnReader() got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("GitHubActionsColumnReader() got unexpected diff (-want +got):\n%s", diff)
+			}
+			var wantPaths []string
+			if tc.oldCols == nil {
+				wantPaths = []string{"/download/1"}
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			if diff := cmp.Diff(wantPaths, paths); diff != "" {
+				t.Errorf("GitHubActionsColumnReader() downloaded unexpected artifacts (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGitHubActionsColumnReaderError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "no such workflow", http.StatusNotFound)
+	}))
+	defer server.Close()
+	group := &configpb.TestGroup{
+		ResultSource: &configpb.TestGroup_ResultSource{
+			ResultSourceConfig: &configpb.TestGroup_ResultSource_GithubActionsConfig{
+				GithubActionsConfig: &configpb.GitHubActionsConfig{
+					Repository: "org/repo",
+					Workflow:   "missing.yaml",
+				},
+			},
+		},
+	}
+	readColumns := GitHubActionsColumnReader(NewGitHubActionsClient("", server.URL))
+	err := readColumns(context.Background(), logrus.New(), group, nil, time.Now().Add(-time.Hour), make(chan InflatedColumn))
+	if err == nil {
+		t.Fatal("GitHubActionsColumnReader() failed to receive an error")
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/fvbommel/sortorder"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// IngestedSuffix ends the name of each column stored for an ingest_config group.
+const IngestedSuffix = ".column.pb"
+
+// IngestedColumnID returns the name of the stored column, which becomes its hint.
+//
+// Zero-padding the start time sorts the names chronologically.
+func IngestedColumnID(col *statepb.IngestedColumn) string {
+	return fmt.Sprintf("%010d-%s", col.GetStarted().GetSeconds(), col.GetBuild())
+}
+
+// IngestedColumnPath returns where to store the identified column for the group.
+func IngestedColumnPath(tg *configpb.TestGroup, id string) (*gcs.Path, error) {
+	paths, err := groupPaths(tg)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) != 1 {
+		return nil, fmt.Errorf("want exactly one gcs_prefix, got %d", len(paths))
+	}
+	return paths[0].ResolveReference(&url.URL{Path: id + IngestedSuffix})
+}
+
+// Ingest returns a GroupUpdater for results pushed to the API, which stores them in GCS.
+//
+// Links the issues from gatherIssues to rows when non-nil.
+func Ingest(colClient gcs.Downloader, groupTimeout time.Duration, write bool, gatherIssues IssueGatherer) GroupUpdater {
+	return func(parent context.Context, log logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path) (bool, error) {
+		if tg.GetResultSource().GetIngestConfig() == nil {
+			log.Debug("Skipping non-ingest group")
+			return false, nil
+		}
+		ctx, cancel := context.WithTimeout(parent, groupTimeout)
+		defer cancel()
+		reprocess := 20 * time.Minute // allow running columns to be replaced
+		return InflateDropAppend(ctx, log, client, tg, gridPath, write, IngestColumnReader(colClient), reprocess, gatherIssues)
+	}
+}
+
+type ingestedObject struct {
+	path gcs.Path
+	id   string
+}
+
+// IngestColumnReader returns a ColumnReader for columns pushed to an ingest group.
+func IngestColumnReader(client gcs.Downloader) ColumnReader {
+	return func(ctx context.Context, parentLog logrus.FieldLogger, tg *configpb.TestGroup, oldCols []InflatedColumn, stop time.Time, receivers chan<- InflatedColumn) error {
+		tgPaths, err := groupPaths(tg)
+		if err != nil {
+			return fmt.Errorf("group path: %w", err)
+		}
+
+		since := hintStarted(oldCols)
+		log := parentLog.WithField("since", since)
+		objs, err := listIngested(ctx, client, since, tgPaths...)
+		if errors.Is(err, storage.ErrBucketNotExist) {
+			log.WithError(err).Info("Bucket does not exist")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("list columns: %w", err)
+		}
+		log.WithField("total", len(objs)).Debug("Listed ingested columns")
+
+		nameCfg := makeNameConfig(tg)
+		var heads []string
+		for _, h := range tg.ColumnHeader {
+			heads = append(heads, h.ConfigurationValue)
+		}
+		opts := makeOptions(tg)
+		opts.analyzeProwJob = false // no pod to analyze
+
+		for _, obj := range objs {
+			log := log.WithField("column", obj.path)
+			started, _ := strconv.ParseInt(strings.SplitN(obj.id, "-", 2)[0], 10, 64)
+			if time.Unix(started, 0).Before(stop) {
+				continue
+			}
+			var col InflatedColumn
+			ingested, err := readIngested(ctx, client, obj.path)
+			if err == nil {
+				col, err = convertIngested(log, nameCfg, obj.id, heads, tg.GetName(), ingested, opts)
+			}
+			if err != nil {
+				log.WithError(err).Trace("Failed to read column")
+				msg := fmt.Sprintf("Failed to read %s: %s", obj.path, err.Error())
+				col = erroredColumn(obj.id, float64(started*1000), make([]string, len(heads)), msg)
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case receivers <- col:
+			}
+		}
+		return nil
+	}
+}
+
+// listIngested returns the columns stored after since, oldest first.
+func listIngested(ctx context.Context, client gcs.Lister, since string, paths ...gcs.Path) ([]ingestedObject, error) {
+	var out []ingestedObject
+	for idx, tgPath := range paths {
+		var offset string
+		if since != "" {
+			// Only the zero-padded start time sorts the same lexically and naturally.
+			offset = path.Join(tgPath.Object(), strings.SplitN(since, "-", 2)[0])
+		}
+		it := client.Objects(ctx, tgPath, "/", offset)
+		for {
+			attrs, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%d: %s: %w", idx, tgPath, err)
+			}
+			if attrs.Name == "" || !strings.HasSuffix(attrs.Name, IngestedSuffix) {
+				continue
+			}
+			id := strings.TrimSuffix(path.Base(attrs.Name), IngestedSuffix)
+			if since != "" && !sortorder.NaturalLess(since, id) {
+				continue
+			}
+			p, err := tgPath.ResolveReference(&url.URL{Path: path.Base(attrs.Name)})
+			if err != nil {
+				return nil, fmt.Errorf("%d: %s: %w", idx, attrs.Name, err)
+			}
+			out = append(out, ingestedObject{path: *p, id: id})
+		}
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return sortorder.NaturalLess(out[i].id, out[j].id)
+	})
+	return out, nil
+}
+
+func readIngested(ctx context.Context, client gcs.Opener, p gcs.Path) (*statepb.IngestedColumn, error) {
+	r, _, err := client.Open(ctx, p)
+	if err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+	defer r.Close()
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	var col statepb.IngestedColumn
+	if err := proto.Unmarshal(buf, &col); err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+	return &col, nil
+}
+
+// convertIngested returns an InflatedColumn representation of the pushed column.
+//
+// Junit results become rows the same way as results read from GCS, followed by the structured cells.
+func convertIngested(log logrus.FieldLogger, nameCfg nameConfig, id string, headers []string, job string, col *statepb.IngestedColumn, opt groupOptions) (InflatedColumn, error) {
+	suites, err := junit.Parse(col.GetJunit())
+	if err != nil {
+		return InflatedColumn{}, fmt.Errorf("junit: %w", err)
+	}
+	meta := metadata.Metadata{}
+	for k, v := range col.GetMetadata() {
+		meta[k] = v
+	}
+	result := gcsResult{
+		started: gcs.Started{
+			Started: metadata.Started{Timestamp: col.GetStarted().GetSeconds()},
+		},
+		finished: gcs.Finished{
+			Finished: metadata.Finished{Metadata: meta},
+			Running:  col.GetFinished() == nil,
+		},
+		suites: []gcs.SuitesMeta{{Suites: suites}},
+		job:    job,
+		build:  col.GetBuild(),
+	}
+	if f := col.GetFinished(); f != nil {
+		when, passed := f.GetSeconds(), col.GetPassed()
+		result.finished.Timestamp = &when
+		result.finished.Passed = &passed
+	}
+
+	out := convertResult(log, nameCfg, id, headers, result, opt)
+	if opt.buildKey == "" {
+		out.Column.Build = col.GetBuild()
+	}
+
+	var failed bool
+	for _, c := range col.GetCells() {
+		cell := Cell{
+			Result:     c.GetResult(),
+			Message:    c.GetMessage(),
+			Icon:       c.GetIcon(),
+			Metrics:    c.GetMetrics(),
+			Properties: c.GetProperties(),
+		}
+		if ignoreStatus(opt, cell.Result) {
+			continue
+		}
+		if f, ok := cell.Metrics[opt.metricKey]; ok {
+			cell.Icon = strconv.FormatFloat(f, 'g', 4, 64)
+		}
+		if val, ok := cell.Properties[opt.userKey]; ok {
+			cell.UserProperty = val
+		}
+		failed = failed || cell.Result == statuspb.TestStatus_FAIL
+		existing, ok := out.Cells[c.GetName()]
+		switch {
+		case !ok:
+			out.Cells[c.GetName()] = cell
+		case opt.merge:
+			out.Cells[c.GetName()] = MergeCells(true, existing, cell)
+		default:
+			for n, c := range SplitCells(c.GetName(), existing, cell) {
+				out.Cells[n] = c
+			}
+		}
+	}
+
+	// A failing structured cell explains the failure.
+	if overall, ok := out.Cells[job+"."+overallRow]; failed && ok && overall.Message == failedOutsideTests {
+		overall.Icon, overall.Message = "", ""
+		out.Cells[job+"."+overallRow] = overall
+	}
+	return out, nil
+}
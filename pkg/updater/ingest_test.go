@@ -0,0 +1,453 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+)
+
+func ingestGroup(prefix string) *configpb.TestGroup {
+	return &configpb.TestGroup{
+		Name: "pushed",
+		ResultSource: &configpb.TestGroup_ResultSource{
+			ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+				IngestConfig: &configpb.IngestConfig{
+					GcsPrefix: prefix,
+				},
+			},
+		},
+	}
+}
+
+func TestIngestedColumnPath(t *testing.T) {
+	cases := []struct {
+		name   string
+		prefix string
+		want   string
+		err    bool
+	}{
+		{
+			name:   "basically works",
+			prefix: "bucket/path",
+			want:   "gs://bucket/path/1600000000-10.column.pb",
+		},
+		{
+			name:   "trailing slash",
+			prefix: "bucket/path/",
+			want:   "gs://bucket/path/1600000000-10.column.pb",
+		},
+		{
+			name:   "multiple prefixes",
+			prefix: "bucket/a,bucket/b",
+			err:    true,
+		},
+	}
+
+	col := &statepb.IngestedColumn{
+		Build:   "10",
+		Started: timestamppb.New(time.Unix(1600000000, 0)),
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			id := IngestedColumnID(col)
+			if id != "1600000000-10" {
+				t.Errorf("IngestedColumnID() got %q, want %q", id, "1600000000-10")
+			}
+			got, err := IngestedColumnPath(ingestGroup(tc.prefix), id)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("IngestedColumnPath() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("IngestedColumnPath() failed to receive an error")
+			case got.String() != tc.want:
+				t.Errorf("IngestedColumnPath() got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestListIngested(t *testing.T) {
+	prefix := newPathOrDie("gs://bucket/pushed/")
+	objects := []storage.ObjectAttrs{
+		{Name: "pushed/0000000100-10" + IngestedSuffix},
+		{Name: "pushed/0000000100-9" + IngestedSuffix},
+		{Name: "pushed/0000000200-a" + IngestedSuffix},
+		{Name: "pushed/README.md"},
+		{Prefix: "pushed/subdir/"},
+	}
+	cases := []struct {
+		name   string
+		since  string
+		client fake.Lister
+		want   []ingestedObject
+		err    bool
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "list everything",
+			client: fake.Lister{
+				prefix: fake.Iterator{Objects: objects},
+			},
+			want: []ingestedObject{
+				{path: newPathOrDie("gs://bucket/pushed/0000000100-9" + IngestedSuffix), id: "0000000100-9"},
+				{path: newPathOrDie("gs://bucket/pushed/0000000100-10" + IngestedSuffix), id: "0000000100-10"},
+				{path: newPathOrDie("gs://bucket/pushed/0000000200-a" + IngestedSuffix), id: "0000000200-a"},
+			},
+		},
+		{
+			name:  "list after since",
+			since: "0000000100-9",
+			client: fake.Lister{
+				prefix: fake.Iterator{Objects: objects},
+			},
+			want: []ingestedObject{
+				{path: newPathOrDie("gs://bucket/pushed/0000000100-10" + IngestedSuffix), id: "0000000100-10"},
+				{path: newPathOrDie("gs://bucket/pushed/0000000200-a" + IngestedSuffix), id: "0000000200-a"},
+			},
+		},
+		{
+			name: "list error",
+			client: fake.Lister{
+				prefix: fake.Iterator{Objects: objects, Err: 1},
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var paths []gcs.Path
+			if tc.client != nil {
+				paths = append(paths, prefix)
+			}
+			got, err := listIngested(context.Background(), tc.client, tc.since, paths...)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("listIngested() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("listIngested() failed to receive an error")
+			default:
+				if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(ingestedObject{}, gcs.Path{})); diff != "" {
+					t.Errorf("listIngested() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertIngested(t *testing.T) {
+	now := time.Now().Unix()
+	cases := []struct {
+		name    string
+		group   *configpb.TestGroup
+		col     *statepb.IngestedColumn
+		headers []string
+		want    InflatedColumn
+		err     bool
+	}{
+		{
+			name:  "running",
+			group: &configpb.TestGroup{},
+			col: &statepb.IngestedColumn{
+				Build:   "10",
+				Started: timestamppb.New(time.Unix(now, 0)),
+			},
+			headers: []string{"Commit"},
+			want: InflatedColumn{
+				Column: &statepb.Column{
+					Build:   "10",
+					Started: float64(now * 1000),
+					Hint:    "id",
+					Extra:   []string{""},
+				},
+				Cells: map[string]Cell{
+					"job." + overallRow: {
+						Result:  statuspb.TestStatus_RUNNING,
+						Icon:    "R",
+						Message: "Build still running...",
+					},
+				},
+			},
+		},
+		{
+			name:  "junit and structured cells",
+			group: &configpb.TestGroup{},
+			col: &statepb.IngestedColumn{
+				Build:    "10",
+				Started:  timestamppb.New(time.Unix(100, 0)),
+				Finished: timestamppb.New(time.Unix(160, 0)),
+				Passed:   true,
+				Metadata: map[string]string{"Commit": "abc"},
+				Junit:    []byte(`<testsuite><testcase name="hello"/><testcase name="same"/></testsuite>`),
+				Cells: []*statepb.IngestedColumn_Cell{
+					{
+						Name:       "world",
+						Result:     statuspb.TestStatus_FLAKY,
+						Icon:       "1/2",
+						Message:    "retried",
+						Metrics:    map[string]float64{"score": 1},
+						Properties: map[string]string{"node": "n1"},
+					},
+					{
+						Name:    "same",
+						Result:  statuspb.TestStatus_FAIL,
+						Message: "structured failure",
+					},
+				},
+			},
+			headers: []string{"Commit", "missing"},
+			want: InflatedColumn{
+				Column: &statepb.Column{
+					Build:   "10",
+					Started: 100000,
+					Hint:    "id",
+					Extra:   []string{"abc", "missing"},
+				},
+				Cells: map[string]Cell{
+					"job." + overallRow: {
+						Result:  statuspb.TestStatus_PASS,
+						Metrics: setElapsed(nil, 60),
+					},
+					"hello": {
+						Result: statuspb.TestStatus_PASS,
+					},
+					"world": {
+						Result:     statuspb.TestStatus_FLAKY,
+						Icon:       "1/2",
+						Message:    "retried",
+						Metrics:    map[string]float64{"score": 1},
+						Properties: map[string]string{"node": "n1"},
+					},
+					"same": {
+						Result:  statuspb.TestStatus_FLAKY,
+						Icon:    "1/2",
+						Message: "1/2 runs passed: structured failure",
+					},
+				},
+			},
+		},
+		{
+			name:  "structured failure explains failing build",
+			group: &configpb.TestGroup{},
+			col: &statepb.IngestedColumn{
+				Build:    "10",
+				Started:  timestamppb.New(time.Unix(100, 0)),
+				Finished: timestamppb.New(time.Unix(160, 0)),
+				Cells: []*statepb.IngestedColumn_Cell{
+					{
+						Name:   "world",
+						Result: statuspb.TestStatus_FAIL,
+					},
+				},
+			},
+			want: InflatedColumn{
+				Column: &statepb.Column{
+					Build:   "10",
+					Started: 100000,
+					Hint:    "id",
+				},
+				Cells: map[string]Cell{
+					"job." + overallRow: {
+						Result:  statuspb.TestStatus_FAIL,
+						Metrics: setElapsed(nil, 60),
+					},
+					"world": {
+						Result: statuspb.TestStatus_FAIL,
+					},
+				},
+			},
+		},
+		{
+			name: "build override",
+			group: &configpb.TestGroup{
+				BuildOverrideConfigurationValue: "number",
+			},
+			col: &statepb.IngestedColumn{
+				Build:    "10",
+				Started:  timestamppb.New(time.Unix(100, 0)),
+				Finished: timestamppb.New(time.Unix(160, 0)),
+				Passed:   true,
+				Metadata: map[string]string{"number": "v1"},
+			},
+			want: InflatedColumn{
+				Column: &statepb.Column{
+					Build:   "v1",
+					Started: 100000,
+					Hint:    "id",
+				},
+				Cells: map[string]Cell{
+					"job." + overallRow: {
+						Result:  statuspb.TestStatus_PASS,
+						Metrics: setElapsed(nil, 60),
+					},
+				},
+			},
+		},
+		{
+			name:  "bad junit",
+			group: &configpb.TestGroup{},
+			col: &statepb.IngestedColumn{
+				Build:   "10",
+				Started: timestamppb.New(time.Unix(100, 0)),
+				Junit:   []byte("<testsuite><testcase"),
+			},
+			err: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			opt := makeOptions(tc.group)
+			opt.analyzeProwJob = false
+			got, err := convertIngested(logrus.WithField("case", tc.name), makeNameConfig(tc.group), "id", tc.headers, "job", tc.col, opt)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("convertIngested() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("convertIngested() failed to receive an error")
+			default:
+				if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("convertIngested() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestIngestColumnReader(t *testing.T) {
+	prefix := newPathOrDie("gs://bucket/pushed/")
+	marshal := func(col *statepb.IngestedColumn) string {
+		buf, err := proto.Marshal(col)
+		if err != nil {
+			t.Fatalf("marshal: %v", err)
+		}
+		return string(buf)
+	}
+	now := time.Now().Unix()
+	old := &statepb.IngestedColumn{
+		Build:    "1",
+		Started:  timestamppb.New(time.Unix(now-3600, 0)),
+		Finished: timestamppb.New(time.Unix(now-3540, 0)),
+		Passed:   true,
+	}
+	recent := &statepb.IngestedColumn{
+		Build:    "2",
+		Started:  timestamppb.New(time.Unix(now-60, 0)),
+		Finished: timestamppb.New(time.Unix(now, 0)),
+	}
+	oldID, recentID := IngestedColumnID(old), IngestedColumnID(recent)
+	ancientID := IngestedColumnID(&statepb.IngestedColumn{Build: "0", Started: timestamppb.New(time.Unix(100, 0))})
+	brokenID := IngestedColumnID(&statepb.IngestedColumn{Build: "3", Started: timestamppb.New(time.Unix(now, 0))})
+	name := func(id string) string {
+		return "pushed/" + id + IngestedSuffix
+	}
+	client := fake.Client{
+		Lister: fake.Lister{
+			prefix: fake.Iterator{
+				Objects: []storage.ObjectAttrs{
+					{Name: name(ancientID)},
+					{Name: name(oldID)},
+					{Name: name(recentID)},
+					{Name: name(brokenID)},
+				},
+			},
+		},
+		Opener: fake.Opener{
+			newPathOrDie("gs://bucket/" + name(ancientID)): {Data: marshal(&statepb.IngestedColumn{Build: "0"})},
+			newPathOrDie("gs://bucket/" + name(oldID)):     {Data: marshal(old)},
+			newPathOrDie("gs://bucket/" + name(recentID)):  {Data: marshal(recent)},
+			newPathOrDie("gs://bucket/" + name(brokenID)):  {Data: "garbage"},
+		},
+	}
+
+	cases := []struct {
+		name    string
+		oldCols []InflatedColumn
+		want    []string // hints
+	}{
+		{
+			name: "read everything after stop",
+			want: []string{oldID, recentID, brokenID},
+		},
+		{
+			name: "read after hint",
+			oldCols: []InflatedColumn{
+				{Column: &statepb.Column{Hint: oldID}},
+			},
+			want: []string{recentID, brokenID},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := make(chan InflatedColumn)
+			var got []InflatedColumn
+			done := make(chan struct{})
+			go func() {
+				for col := range ch {
+					got = append(got, col)
+				}
+				close(done)
+			}()
+			readColumns := IngestColumnReader(client)
+			stop := time.Unix(now-86400, 0)
+			err := readColumns(context.Background(), logrus.WithField("case", tc.name), ingestGroup("bucket/pushed"), tc.oldCols, stop, ch)
+			close(ch)
+			<-done
+			if err != nil {
+				t.Fatalf("IngestColumnReader() got unexpected error: %v", err)
+			}
+			var hints []string
+			for _, col := range got {
+				hints = append(hints, col.Column.Hint)
+			}
+			if diff := cmp.Diff(tc.want, hints); diff != "" {
+				t.Fatalf("IngestColumnReader() got unexpected hints (-want +got):\n%s", diff)
+			}
+			last := got[len(got)-1]
+			if res := last.Cells[overallRow].Result; res != statuspb.TestStatus_TOOL_FAIL {
+				t.Errorf("IngestColumnReader() got %s for a broken column, want %s", res, statuspb.TestStatus_TOOL_FAIL)
+			}
+			if build := got[len(got)-2].Column.Build; build != "2" {
+				t.Errorf("IngestColumnReader() got build %q, want %q", build, "2")
+			}
+		})
+	}
+}
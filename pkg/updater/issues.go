@@ -0,0 +1,220 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleCloudPlatform/testgrid/internal/result"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/issuestate"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+)
+
+// maxIssueSearches limits how many rows of a group are searched for in an issue tracker each cycle.
+const maxIssueSearches = 10
+
+// IssueGatherer returns the issues to link to each row name of the columns.
+//
+// Returns nil for groups that do not gather issues.
+type IssueGatherer func(ctx context.Context, log logrus.FieldLogger, tg *configpb.TestGroup, cols []InflatedColumn) (map[string][]string, error)
+
+// IssueSearcher finds issues in an issue tracker.
+type IssueSearcher interface {
+	// SearchIssues returns the open issues that mention the text.
+	SearchIssues(ctx context.Context, text string) ([]*issuepb.IssueInfo, error)
+}
+
+// GatherIssues returns an IssueGatherer for groups that set gather_bugs or issue_gather_options.
+//
+// Links the issues in the IssueState of each group, which is stored under issuePrefix
+// (alongside the config). Also links the open issues the searcher finds for rows failing in the
+// latest column when the group sets issue_gather_options.search_issue_tracker and searcher is non-nil.
+func GatherIssues(opener gcs.Opener, configPath gcs.Path, issuePrefix string, searcher IssueSearcher) IssueGatherer {
+	return func(ctx context.Context, log logrus.FieldLogger, tg *configpb.TestGroup, cols []InflatedColumn) (map[string][]string, error) {
+		if !tg.GetGatherBugs() && tg.GetIssueGatherOptions() == nil {
+			return nil, nil
+		}
+		path, err := issuestate.Path(configPath, issuePrefix, tg.GetName())
+		if err != nil {
+			return nil, fmt.Errorf("issue state path: %w", err)
+		}
+		state, err := issuestate.Read(ctx, opener, *path)
+		if err != nil {
+			return nil, fmt.Errorf("read issue state: %w", err)
+		}
+		infos := state.IssueInfo
+		if searcher != nil && tg.GetIssueGatherOptions().GetSearchIssueTracker() {
+			for _, name := range failingRows(cols, maxIssueSearches) {
+				found, err := searcher.SearchIssues(ctx, name)
+				if err != nil {
+					log.WithError(err).WithField("row", name).Warning("Failed to search for issues")
+					continue
+				}
+				for _, info := range found {
+					info.RowIds = append(info.RowIds, name)
+					infos = append(infos, info)
+				}
+			}
+		}
+		return linkIssues(tg, infos, cols), nil
+	}
+}
+
+// failingRows returns up to max names of the rows failing in the latest column.
+func failingRows(cols []InflatedColumn, max int) []string {
+	if len(cols) == 0 {
+		return nil
+	}
+	var names []string
+	for name, cell := range cols[0].Cells {
+		if result.Coalesce(cell.Result, result.IgnoreRunning) == statuspb.TestStatus_FAIL {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) > max {
+		names = names[:max]
+	}
+	return names
+}
+
+// linkIssues returns the issues to link to each row name.
+//
+// An issue links to a row when:
+//   - the issue's row_ids include the row name (or its unformatted ID),
+//   - one of the issue's targets is the row, or the row is a test method of the target
+//     (the row name starts with the target name followed by a '.', '/' or '['). When link_bugs_by_test_methods is set,
+//     methods must also be listed in the target's method_names.
+//   - link_bugs_by_group is set and the row fails in a column whose build is in the run_ids.
+func linkIssues(tg *configpb.TestGroup, infos []*issuepb.IssueInfo, cols []InflatedColumn) map[string][]string {
+	if len(infos) == 0 {
+		return map[string][]string{}
+	}
+	ids := map[string]string{} // row name => unformatted ID
+	for _, col := range cols {
+		for name, cell := range col.Cells {
+			if _, ok := ids[name]; !ok || ids[name] == "" {
+				ids[name] = cell.ID
+			}
+		}
+	}
+
+	links := map[string]map[string]bool{}
+	link := func(name, issue string) {
+		if links[name] == nil {
+			links[name] = map[string]bool{}
+		}
+		links[name][issue] = true
+	}
+
+	for _, info := range infos {
+		if info.IssueId == "" {
+			continue
+		}
+		rowIDs := make(map[string]bool, len(info.RowIds))
+		for _, id := range info.RowIds {
+			rowIDs[id] = true
+		}
+		for name, id := range ids {
+			if rowIDs[name] || (id != "" && rowIDs[id]) {
+				link(name, info.IssueId)
+				continue
+			}
+			for _, tm := range info.TargetsAndMethods {
+				if linksTarget(tm, name, id, tg.GetLinkBugsByTestMethods()) {
+					link(name, info.IssueId)
+					break
+				}
+			}
+		}
+		if !tg.GetLinkBugsByGroup() || len(info.RunIds) == 0 {
+			continue
+		}
+		runs := make(map[string]bool, len(info.RunIds))
+		for _, id := range info.RunIds {
+			runs[id] = true
+		}
+		for _, col := range cols {
+			if col.Column == nil || (!runs[col.Column.Build] && !runs[col.Column.Name]) {
+				continue
+			}
+			for name, cell := range col.Cells {
+				if result.Coalesce(cell.Result, result.IgnoreRunning) == statuspb.TestStatus_FAIL {
+					link(name, info.IssueId)
+				}
+			}
+		}
+	}
+
+	out := make(map[string][]string, len(links))
+	for name, issues := range links {
+		for issue := range issues {
+			out[name] = append(out[name], issue)
+		}
+		sort.Strings(out[name])
+	}
+	return out
+}
+
+// methodSeparators may follow a target name to start the name of one of its test methods.
+const methodSeparators = "./["
+
+// linksTarget returns true if the row is the target, or one of its linked test methods.
+func linksTarget(tm *issuepb.TargetAndMethods, name, id string, byMethods bool) bool {
+	target := tm.GetTargetName()
+	if target == "" {
+		return false
+	}
+	for _, key := range []string{name, id} {
+		if key == target {
+			return true
+		}
+		if !strings.HasPrefix(key, target) || !strings.ContainsAny(key[len(target):len(target)+1], methodSeparators) {
+			continue
+		}
+		if !byMethods {
+			return true
+		}
+		method := key[len(target):]
+		for _, m := range tm.GetMethodNames() {
+			if m != "" && strings.Contains(method, m) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// updateIssues returns the issues to link to each row.
+//
+// Only links the gathered issues when the group gathers them, so that issues
+// which are closed or no longer match stop linking. Otherwise carries forward
+// the issues of the existing grid.
+func updateIssues(issues, gathered map[string][]string) map[string][]string {
+	if gathered == nil {
+		return issues
+	}
+	return gathered
+}
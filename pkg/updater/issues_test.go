@@ -0,0 +1,426 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	issuepb "github.com/GoogleCloudPlatform/testgrid/pb/issue_state"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+)
+
+type fakeSearcher struct {
+	issues map[string][]*issuepb.IssueInfo
+	err    error
+	texts  []string
+}
+
+func (fs *fakeSearcher) SearchIssues(_ context.Context, text string) ([]*issuepb.IssueInfo, error) {
+	fs.texts = append(fs.texts, text)
+	if fs.err != nil {
+		return nil, fs.err
+	}
+	var out []*issuepb.IssueInfo
+	for _, info := range fs.issues[text] {
+		out = append(out, proto.Clone(info).(*issuepb.IssueInfo))
+	}
+	return out, nil
+}
+
+func TestGatherIssues(t *testing.T) {
+	configPath := newPathOrDie("gs://bucket/config")
+	statePath := newPathOrDie("gs://bucket/issues/group")
+	state := &issuepb.IssueState{
+		IssueInfo: []*issuepb.IssueInfo{
+			{IssueId: "1", RowIds: []string{"hello"}},
+		},
+	}
+	buf, err := proto.Marshal(state)
+	if err != nil {
+		t.Fatalf("Marshal(): %v", err)
+	}
+	cols := []InflatedColumn{
+		{
+			Column: &statepb.Column{Build: "2"},
+			Cells: map[string]Cell{
+				"hello": {Result: statuspb.TestStatus_PASS},
+				"world": {Result: statuspb.TestStatus_FAIL},
+			},
+		},
+	}
+
+	cases := []struct {
+		name      string
+		group     *configpb.TestGroup
+		opener    fake.Opener
+		searcher  *fakeSearcher
+		want      map[string][]string
+		wantTexts []string
+		wantErr   bool
+	}{
+		{
+			name:  "ignore groups that do not gather bugs",
+			group: &configpb.TestGroup{Name: "group"},
+			opener: fake.Opener{
+				statePath: {Data: string(buf)},
+			},
+		},
+		{
+			name:  "basically works",
+			group: &configpb.TestGroup{Name: "group", GatherBugs: true},
+			opener: fake.Opener{
+				statePath: {Data: string(buf)},
+			},
+			want: map[string][]string{
+				"hello": {"1"},
+			},
+		},
+		{
+			name:   "missing state",
+			group:  &configpb.TestGroup{Name: "group", GatherBugs: true},
+			opener: fake.Opener{},
+			want:   map[string][]string{},
+		},
+		{
+			name:  "open error",
+			group: &configpb.TestGroup{Name: "group", GatherBugs: true},
+			opener: fake.Opener{
+				statePath: {OpenErr: errors.New("injected")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "search failing rows",
+			group: &configpb.TestGroup{
+				Name:               "group",
+				IssueGatherOptions: &configpb.IssueGatherOptions{SearchIssueTracker: true},
+			},
+			opener: fake.Opener{
+				statePath: {Data: string(buf)},
+			},
+			searcher: &fakeSearcher{
+				issues: map[string][]*issuepb.IssueInfo{
+					"world": {{IssueId: "2"}},
+				},
+			},
+			want: map[string][]string{
+				"hello": {"1"},
+				"world": {"2"},
+			},
+			wantTexts: []string{"world"},
+		},
+		{
+			name: "only search when configured",
+			group: &configpb.TestGroup{
+				Name:               "group",
+				IssueGatherOptions: &configpb.IssueGatherOptions{},
+			},
+			opener: fake.Opener{},
+			searcher: &fakeSearcher{
+				issues: map[string][]*issuepb.IssueInfo{
+					"world": {{IssueId: "2"}},
+				},
+			},
+			want: map[string][]string{},
+		},
+		{
+			name: "ignore search errors",
+			group: &configpb.TestGroup{
+				Name:               "group",
+				IssueGatherOptions: &configpb.IssueGatherOptions{SearchIssueTracker: true},
+			},
+			opener: fake.Opener{
+				statePath: {Data: string(buf)},
+			},
+			searcher: &fakeSearcher{err: errors.New("injected")},
+			want: map[string][]string{
+				"hello": {"1"},
+			},
+			wantTexts: []string{"world"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var searcher IssueSearcher
+			if tc.searcher != nil {
+				searcher = tc.searcher
+			}
+			gather := GatherIssues(tc.opener, configPath, "issues", searcher)
+			got, err := gather(context.Background(), logrus.WithField("name", tc.name), tc.group, cols)
+			switch {
+			case err != nil:
+				if !tc.wantErr {
+					t.Errorf("GatherIssues() got unexpected error: %v", err)
+				}
+			case tc.wantErr:
+				t.Error("GatherIssues() failed to return an error")
+			default:
+				if diff := cmp.Diff(tc.want, got); diff != "" {
+					t.Errorf("GatherIssues() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+			if tc.searcher != nil {
+				if diff := cmp.Diff(tc.wantTexts, tc.searcher.texts); diff != "" {
+					t.Errorf("GatherIssues() got unexpected searches (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestFailingRows(t *testing.T) {
+	cases := []struct {
+		name string
+		cols []InflatedColumn
+		max  int
+		want []string
+	}{
+		{
+			name: "empty",
+			max:  10,
+		},
+		{
+			name: "basically works",
+			cols: []InflatedColumn{
+				{
+					Cells: map[string]Cell{
+						"pass":    {Result: statuspb.TestStatus_PASS},
+						"fail":    {Result: statuspb.TestStatus_FAIL},
+						"timeout": {Result: statuspb.TestStatus_TIMED_OUT},
+						"running": {Result: statuspb.TestStatus_RUNNING},
+					},
+				},
+				{
+					Cells: map[string]Cell{
+						"pass": {Result: statuspb.TestStatus_FAIL},
+					},
+				},
+			},
+			max:  10,
+			want: []string{"fail", "timeout"},
+		},
+		{
+			name: "limit",
+			cols: []InflatedColumn{
+				{
+					Cells: map[string]Cell{
+						"c": {Result: statuspb.TestStatus_FAIL},
+						"b": {Result: statuspb.TestStatus_FAIL},
+						"a": {Result: statuspb.TestStatus_FAIL},
+					},
+				},
+			},
+			max:  2,
+			want: []string{"a", "b"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := failingRows(tc.cols, tc.max)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("failingRows() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLinkIssues(t *testing.T) {
+	cols := []InflatedColumn{
+		{
+			Column: &statepb.Column{Build: "2", Name: "second"},
+			Cells: map[string]Cell{
+				"//pkg:test": {Result: statuspb.TestStatus_PASS},
+				"//pkg:test.TestFoo": {
+					Result: statuspb.TestStatus_FAIL,
+				},
+				"//pkg:test.TestBar": {Result: statuspb.TestStatus_PASS},
+				"//pkg:test/sub":     {Result: statuspb.TestStatus_PASS},
+				"//pkg:test[shard]":  {Result: statuspb.TestStatus_PASS},
+				"//pkg:testing":      {Result: statuspb.TestStatus_PASS},
+				"pretty name":        {Result: statuspb.TestStatus_FAIL, ID: "//other:test"},
+			},
+		},
+		{
+			Column: &statepb.Column{Build: "1", Name: "first"},
+			Cells: map[string]Cell{
+				"//pkg:test.TestBar": {Result: statuspb.TestStatus_FAIL},
+			},
+		},
+	}
+
+	cases := []struct {
+		name  string
+		group *configpb.TestGroup
+		infos []*issuepb.IssueInfo
+		want  map[string][]string
+	}{
+		{
+			name:  "empty",
+			group: &configpb.TestGroup{},
+			want:  map[string][]string{},
+		},
+		{
+			name:  "row ids",
+			group: &configpb.TestGroup{},
+			infos: []*issuepb.IssueInfo{
+				{IssueId: "1", RowIds: []string{"//pkg:test"}},
+				{IssueId: "2", RowIds: []string{"//other:test"}},
+				{RowIds: []string{"//pkg:test"}},
+			},
+			want: map[string][]string{
+				"//pkg:test":  {"1"},
+				"pretty name": {"2"},
+			},
+		},
+		{
+			name:  "targets link methods",
+			group: &configpb.TestGroup{},
+			infos: []*issuepb.IssueInfo{
+				{
+					IssueId: "3",
+					TargetsAndMethods: []*issuepb.TargetAndMethods{
+						{TargetName: "//pkg:test"},
+					},
+				},
+			},
+			want: map[string][]string{
+				"//pkg:test":         {"3"},
+				"//pkg:test.TestFoo": {"3"},
+				"//pkg:test.TestBar": {"3"},
+				"//pkg:test/sub":     {"3"},
+				"//pkg:test[shard]":  {"3"},
+			},
+		},
+		{
+			name:  "link by test methods",
+			group: &configpb.TestGroup{LinkBugsByTestMethods: true},
+			infos: []*issuepb.IssueInfo{
+				{
+					IssueId: "4",
+					TargetsAndMethods: []*issuepb.TargetAndMethods{
+						{TargetName: "//pkg:test", MethodNames: []string{"TestFoo"}},
+					},
+				},
+			},
+			want: map[string][]string{
+				"//pkg:test":         {"4"},
+				"//pkg:test.TestFoo": {"4"},
+			},
+		},
+		{
+			name:  "ignore run ids by default",
+			group: &configpb.TestGroup{},
+			infos: []*issuepb.IssueInfo{
+				{IssueId: "5", RunIds: []string{"1"}},
+			},
+			want: map[string][]string{},
+		},
+		{
+			name:  "link by group",
+			group: &configpb.TestGroup{LinkBugsByGroup: true},
+			infos: []*issuepb.IssueInfo{
+				{IssueId: "5", RunIds: []string{"1"}},
+				{IssueId: "6", RunIds: []string{"second"}},
+			},
+			want: map[string][]string{
+				"//pkg:test.TestBar": {"5"},
+				"//pkg:test.TestFoo": {"6"},
+				"pretty name":        {"6"},
+			},
+		},
+		{
+			name:  "sort and dedupe",
+			group: &configpb.TestGroup{},
+			infos: []*issuepb.IssueInfo{
+				{IssueId: "8", RowIds: []string{"//pkg:test"}},
+				{IssueId: "7", RowIds: []string{"//pkg:test"}},
+				{
+					IssueId: "7",
+					TargetsAndMethods: []*issuepb.TargetAndMethods{
+						{TargetName: "//pkg:test"},
+					},
+				},
+			},
+			want: map[string][]string{
+				"//pkg:test":         {"7", "8"},
+				"//pkg:test.TestFoo": {"7"},
+				"//pkg:test.TestBar": {"7"},
+				"//pkg:test/sub":     {"7"},
+				"//pkg:test[shard]":  {"7"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := linkIssues(tc.group, tc.infos, cols)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("linkIssues() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestUpdateIssues(t *testing.T) {
+	cases := []struct {
+		name     string
+		issues   map[string][]string
+		gathered map[string][]string
+		want     map[string][]string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:   "carry forward when not gathering",
+			issues: map[string][]string{"a": {"1"}},
+			want:   map[string][]string{"a": {"1"}},
+		},
+		{
+			name:     "drop issues no longer gathered",
+			issues:   map[string][]string{"a": {"1"}},
+			gathered: map[string][]string{},
+			want:     map[string][]string{},
+		},
+		{
+			name:     "basically works",
+			issues:   map[string][]string{"a": {"1", "3"}, "b": {"2"}},
+			gathered: map[string][]string{"a": {"3"}, "c": {"4"}},
+			want:     map[string][]string{"a": {"3"}, "c": {"4"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := updateIssues(tc.issues, tc.gathered)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("updateIssues() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultLeaseDuration is how long a replica holds a group lease between heartbeats.
+const DefaultLeaseDuration = 5 * time.Minute
+
+// errLeaseHeld means another replica holds an unexpired lease on the group.
+var errLeaseHeld = errors.New("lease held by another replica")
+
+// leaseHeldError reports which replica holds the lease, and until when.
+type leaseHeldError struct {
+	holder  string
+	expires time.Time
+}
+
+func (e leaseHeldError) Error() string {
+	return fmt.Sprintf("lease held by %s until %s", e.holder, e.expires)
+}
+
+func (e leaseHeldError) Is(target error) bool {
+	return target == errLeaseHeld
+}
+
+// leaseRecord is the content of a lease object.
+type leaseRecord struct {
+	Holder  string    `json:"holder"`
+	Expires time.Time `json:"expires"`
+}
+
+// A lease grants its holder the exclusive right to update a group until it expires.
+type lease struct {
+	path       gcs.Path
+	generation int64
+	expires    time.Time
+}
+
+// leaser divides groups between updater replicas, using a lease object per group.
+//
+// Every write to a lease object is conditioned on the generation the replica
+// last observed, so GCS only allows one replica to take, renew or release it.
+// Replicas keep the lease after updating until they next update the group,
+// so other replicas do not update it in the meantime.
+// Replicas that stop renewing their leases lose them once they expire,
+// allowing another replica to take over their groups.
+type leaser struct {
+	client   gcs.ConditionalClient
+	holder   string
+	duration time.Duration
+	now      func() time.Time
+}
+
+// newLeaser returns a leaser for the holder, defaulting to the hostname and pid.
+func newLeaser(client gcs.ConditionalClient, holder string, duration time.Duration) *leaser {
+	if holder == "" {
+		host, _ := os.Hostname()
+		holder = fmt.Sprintf("%s-%d", host, os.Getpid())
+	}
+	if duration <= 0 {
+		duration = DefaultLeaseDuration
+	}
+	return &leaser{
+		client:   client,
+		holder:   holder,
+		duration: duration,
+		now:      time.Now,
+	}
+}
+
+// acquire takes the lease at path if it is missing, expired or already ours.
+//
+// Returns a leaseHeldError when another replica holds the lease.
+func (l *leaser) acquire(ctx context.Context, path gcs.Path) (*lease, error) {
+	var cond storage.Conditions
+	r, attrs, err := l.client.Open(ctx, path)
+	switch {
+	case errors.Is(err, storage.ErrObjectNotExist):
+		cond.DoesNotExist = true
+	case err != nil:
+		return nil, fmt.Errorf("open: %w", err)
+	default:
+		var rec leaseRecord
+		err := json.NewDecoder(r).Decode(&rec)
+		r.Close()
+		if err == nil && rec.Holder != l.holder && l.now().Before(rec.Expires) {
+			return nil, leaseHeldError{holder: rec.Holder, expires: rec.Expires}
+		}
+		// Overwrite expired, owned or corrupt leases.
+		cond.GenerationMatch = attrs.Generation
+	}
+
+	ls := lease{path: path}
+	if err := l.write(ctx, &ls, cond, l.now().Add(l.duration)); err != nil {
+		return nil, err
+	}
+	return &ls, nil
+}
+
+// renew extends the lease for another duration.
+func (l *leaser) renew(ctx context.Context, ls *lease) error {
+	return l.write(ctx, ls, storage.Conditions{GenerationMatch: ls.generation}, l.now().Add(l.duration))
+}
+
+// releaseAt keeps the lease until the specified time, after which any replica may acquire it.
+//
+// Releases the lease immediately when that time has passed.
+func (l *leaser) releaseAt(ctx context.Context, ls *lease, until time.Time) error {
+	if now := l.now(); until.Before(now) {
+		until = now
+	}
+	return l.write(ctx, ls, storage.Conditions{GenerationMatch: ls.generation}, until)
+}
+
+func (l *leaser) write(ctx context.Context, ls *lease, cond storage.Conditions, expires time.Time) error {
+	buf, err := json.Marshal(leaseRecord{Holder: l.holder, Expires: expires})
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+	attrs, err := l.client.If(nil, &cond).Upload(ctx, ls.path, buf, gcs.DefaultACL, gcs.NoCache)
+	if gcs.IsPreconditionFailed(err) {
+		return fmt.Errorf("%w: %v", errLeaseHeld, err)
+	}
+	if err != nil {
+		return fmt.Errorf("upload: %w", err)
+	}
+	ls.generation = attrs.Generation
+	ls.expires = expires
+	return nil
+}
+
+// hold renews the lease with a heartbeat until the returned function releases it.
+//
+// The returned function keeps the lease without a heartbeat until the specified time,
+// such as when the holder next updates the group.
+//
+// Cancels the returned context if the lease is lost, either because another
+// replica took it or because it expired before a heartbeat succeeded.
+func (l *leaser) hold(parent context.Context, log logrus.FieldLogger, ls *lease) (context.Context, func(time.Time)) {
+	ctx, cancel := context.WithCancel(parent)
+	done := make(chan struct{})
+	var lost bool
+	go func() {
+		defer close(done)
+		ticker := time.NewTicker(l.duration / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			err := l.renew(ctx, ls)
+			switch {
+			case err == nil:
+				log.Debug("Renewed lease")
+			case ctx.Err() != nil:
+				return
+			case errors.Is(err, errLeaseHeld), !l.now().Before(ls.expires):
+				log.WithError(err).Warning("Lost lease, abandoning update")
+				lost = true
+				cancel()
+				return
+			default:
+				log.WithError(err).Warning("Failed to renew lease, retrying")
+			}
+		}
+	}()
+	return ctx, func(until time.Time) {
+		cancel()
+		<-done
+		if lost {
+			return
+		}
+		if err := l.releaseAt(parent, ls, until); err != nil && parent.Err() == nil {
+			log.WithError(err).Warning("Failed to release lease")
+		}
+	}
+}
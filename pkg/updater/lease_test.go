@@ -0,0 +1,485 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/testgrid/config"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+)
+
+func fakeLeaseClient() *fake.ConditionalClient {
+	return &fake.ConditionalClient{
+		UploadClient: fake.UploadClient{
+			Uploader: fakeUploader{},
+			Client: fakeClient{
+				Lister: fakeLister{},
+				Opener: fakeOpener{},
+			},
+		},
+		Lock: &sync.RWMutex{},
+	}
+}
+
+func leaseUpload(t *testing.T, holder string, expires time.Time, generation int64) fakeUpload {
+	buf, err := json.Marshal(leaseRecord{Holder: holder, Expires: expires})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	return fakeUpload{
+		Buf:          buf,
+		CacheControl: gcs.NoCache,
+		Generation:   generation,
+	}
+}
+
+func readLease(t *testing.T, client *fake.ConditionalClient, path gcs.Path) leaseRecord {
+	t.Helper()
+	var rec leaseRecord
+	if err := json.Unmarshal(client.Uploader[path].Buf, &rec); err != nil {
+		t.Fatalf("unmarshal %s: %v", path, err)
+	}
+	return rec
+}
+
+func TestLeaserAcquire(t *testing.T) {
+	path := newPathOrDie("gs://bucket/leases/group")
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	cases := []struct {
+		name     string
+		existing *fakeUpload
+		held     bool
+		wantGen  int64
+	}{
+		{
+			name:    "missing lease",
+			wantGen: 1,
+		},
+		{
+			name: "held by another replica",
+			existing: func() *fakeUpload {
+				u := leaseUpload(t, "other", now.Add(time.Minute), 3)
+				return &u
+			}(),
+			held: true,
+		},
+		{
+			name: "expired",
+			existing: func() *fakeUpload {
+				u := leaseUpload(t, "other", now.Add(-time.Second), 3)
+				return &u
+			}(),
+			wantGen: 4,
+		},
+		{
+			name: "already ours",
+			existing: func() *fakeUpload {
+				u := leaseUpload(t, "me", now.Add(time.Minute), 3)
+				return &u
+			}(),
+			wantGen: 4,
+		},
+		{
+			name: "corrupt",
+			existing: &fakeUpload{
+				Buf:        []byte("{"),
+				Generation: 7,
+			},
+			wantGen: 8,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := fakeLeaseClient()
+			if tc.existing != nil {
+				client.Uploader[path] = *tc.existing
+			}
+			l := newLeaser(client, "me", time.Minute)
+			l.now = func() time.Time { return now }
+
+			ls, err := l.acquire(context.Background(), path)
+			switch {
+			case tc.held:
+				var held leaseHeldError
+				if !errors.As(err, &held) {
+					t.Fatalf("acquire() got err %v, want %v", err, errLeaseHeld)
+				}
+				if want := now.Add(time.Minute); !held.expires.Equal(want) {
+					t.Errorf("acquire() got expiry %v, want %v", held.expires, want)
+				}
+				return
+			case err != nil:
+				t.Fatalf("acquire() got unexpected error: %v", err)
+			}
+			if ls.generation != tc.wantGen {
+				t.Errorf("acquire() got generation %d, want %d", ls.generation, tc.wantGen)
+			}
+			want := leaseRecord{Holder: "me", Expires: now.Add(time.Minute)}
+			if diff := cmp.Diff(want, readLease(t, client, path)); diff != "" {
+				t.Errorf("acquire() wrote unexpected lease (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLeaserFailover(t *testing.T) {
+	ctx := context.Background()
+	path := newPathOrDie("gs://bucket/leases/group")
+	client := fakeLeaseClient()
+	now := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	alice := newLeaser(client, "alice", time.Minute)
+	alice.now = clock
+	bob := newLeaser(client, "bob", time.Minute)
+	bob.now = clock
+
+	aliceLease, err := alice.acquire(ctx, path)
+	if err != nil {
+		t.Fatalf("alice.acquire() got unexpected error: %v", err)
+	}
+	if _, err := bob.acquire(ctx, path); !errors.Is(err, errLeaseHeld) {
+		t.Fatalf("bob.acquire() got err %v, want %v", err, errLeaseHeld)
+	}
+
+	now = now.Add(30 * time.Second)
+	if err := alice.renew(ctx, aliceLease); err != nil {
+		t.Fatalf("alice.renew() got unexpected error: %v", err)
+	}
+	now = now.Add(45 * time.Second) // Still within the renewed lease.
+	if _, err := bob.acquire(ctx, path); !errors.Is(err, errLeaseHeld) {
+		t.Fatalf("bob.acquire() after renewal got err %v, want %v", err, errLeaseHeld)
+	}
+
+	now = now.Add(time.Minute) // Alice stopped renewing.
+	if _, err := bob.acquire(ctx, path); err != nil {
+		t.Fatalf("bob.acquire() after expiry got unexpected error: %v", err)
+	}
+	if err := alice.renew(ctx, aliceLease); !errors.Is(err, errLeaseHeld) {
+		t.Errorf("alice.renew() after failover got err %v, want %v", err, errLeaseHeld)
+	}
+	if err := alice.releaseAt(ctx, aliceLease, now); !errors.Is(err, errLeaseHeld) {
+		t.Errorf("alice.releaseAt() after failover got err %v, want %v", err, errLeaseHeld)
+	}
+	if got := readLease(t, client, path).Holder; got != "bob" {
+		t.Errorf("lease holder got %q, want bob", got)
+	}
+}
+
+func TestLeaserHold(t *testing.T) {
+	const duration = 30 * time.Millisecond
+	path := newPathOrDie("gs://bucket/leases/group")
+	log := logrus.WithField("test", "TestLeaserHold")
+
+	t.Run("heartbeat and release", func(t *testing.T) {
+		client := fakeLeaseClient()
+		l := newLeaser(client, "me", duration)
+		ls, err := l.acquire(context.Background(), path)
+		if err != nil {
+			t.Fatalf("acquire() got unexpected error: %v", err)
+		}
+		ctx, release := l.hold(context.Background(), log, ls)
+		time.Sleep(3 * duration)
+		if err := ctx.Err(); err != nil {
+			t.Fatalf("hold() context unexpectedly done: %v", err)
+		}
+		attrs, err := client.Stat(ctx, path)
+		if err != nil {
+			t.Fatalf("Stat() got unexpected error: %v", err)
+		}
+		if attrs.Generation < 3 {
+			t.Errorf("hold() failed to renew the lease, generation %d", attrs.Generation)
+		}
+		release(time.Time{})
+		if rec := readLease(t, client, path); rec.Expires.After(time.Now()) {
+			t.Errorf("release() left lease until %v", rec.Expires)
+		}
+		other := newLeaser(client, "other", duration)
+		if _, err := other.acquire(context.Background(), path); err != nil {
+			t.Errorf("acquire() after release got unexpected error: %v", err)
+		}
+	})
+
+	t.Run("keep until next update", func(t *testing.T) {
+		client := fakeLeaseClient()
+		l := newLeaser(client, "me", duration)
+		ls, err := l.acquire(context.Background(), path)
+		if err != nil {
+			t.Fatalf("acquire() got unexpected error: %v", err)
+		}
+		_, release := l.hold(context.Background(), log, ls)
+		next := time.Now().Add(time.Hour)
+		release(next)
+		if rec := readLease(t, client, path); !rec.Expires.Equal(next) {
+			t.Errorf("release() kept lease until %v, want %v", rec.Expires, next)
+		}
+		other := newLeaser(client, "other", duration)
+		if _, err := other.acquire(context.Background(), path); !errors.Is(err, errLeaseHeld) {
+			t.Errorf("acquire() before next update got err %v, want %v", err, errLeaseHeld)
+		}
+	})
+
+	t.Run("lost lease cancels", func(t *testing.T) {
+		client := fakeLeaseClient()
+		l := newLeaser(client, "me", duration)
+		ls, err := l.acquire(context.Background(), path)
+		if err != nil {
+			t.Fatalf("acquire() got unexpected error: %v", err)
+		}
+		ctx, release := l.hold(context.Background(), log, ls)
+		defer release(time.Time{})
+
+		thief := newLeaser(client, "thief", time.Hour)
+		thief.now = func() time.Time { return time.Now().Add(time.Hour) }
+		if _, err := thief.acquire(context.Background(), path); err != nil {
+			t.Fatalf("thief.acquire() got unexpected error: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Fatal("hold() failed to cancel after losing the lease")
+		}
+		release(time.Time{})
+		if got := readLease(t, client, path).Holder; got != "thief" {
+			t.Errorf("release() overwrote the thief's lease: %q", got)
+		}
+	})
+}
+
+func TestUpdateLeases(t *testing.T) {
+	configPath := newPathOrDie("gs://bucket/path/to/config")
+	now := time.Now()
+	cases := []struct {
+		name   string
+		leases map[string]fakeUpload
+		write  bool
+		want   []string
+	}{
+		{
+			name:  "basically works",
+			write: true,
+			want:  []string{"expired", "free", "held"},
+		},
+		{
+			name:  "skip groups leased by another replica",
+			write: true,
+			leases: map[string]fakeUpload{
+				"held":    leaseUpload(t, "other", now.Add(time.Hour), 1),
+				"expired": leaseUpload(t, "other", now.Add(-time.Minute), 1),
+			},
+			want: []string{"expired", "free"},
+		},
+		{
+			name: "ignore leases when not writing",
+			leases: map[string]fakeUpload{
+				"held": leaseUpload(t, "other", now.Add(time.Hour), 1),
+			},
+			want: []string{"expired", "free", "held"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			client := fakeLeaseClient()
+			cfg := &configpb.Configuration{
+				Dashboards: []*configpb.Dashboard{
+					{Name: "dash"},
+				},
+			}
+			for _, name := range []string{"expired", "free", "held"} {
+				cfg.TestGroups = append(cfg.TestGroups, &configpb.TestGroup{
+					Name:             name,
+					GcsPrefix:        "bucket/logs/" + name,
+					DaysOfResults:    7,
+					NumColumnsRecent: 6,
+				})
+				cfg.Dashboards[0].DashboardTab = append(cfg.Dashboards[0].DashboardTab, &configpb.DashboardTab{
+					Name:          name,
+					TestGroupName: name,
+				})
+			}
+			buf, err := config.MarshalBytes(cfg)
+			if err != nil {
+				t.Fatalf("config.MarshalBytes() errored: %v", err)
+			}
+			client.Opener[configPath] = fakeObject{
+				Data:  string(buf),
+				Attrs: &storage.ReaderObjectAttrs{},
+			}
+			for name, u := range tc.leases {
+				client.Uploader[newPathOrDie("gs://bucket/path/to/leases/"+name)] = u
+			}
+
+			var lock sync.Mutex
+			var got []string
+			updateGroup := func(_ context.Context, _ logrus.FieldLogger, _ gcs.Client, tg *configpb.TestGroup, _ gcs.Path) (bool, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				got = append(got, tg.Name)
+				return false, nil
+			}
+			opts := &UpdateOptions{
+				ConfigPath:       configPath,
+				GridPrefix:       "grid",
+				GroupConcurrency: 1,
+				Write:            tc.write,
+				LeasePrefix:      "leases",
+				LeaseHolder:      "me",
+			}
+			if err := Update(ctx, client, nil, updateGroup, opts); err != nil {
+				t.Fatalf("Update() got unexpected error: %v", err)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Update() updated unexpected groups (-want +got):\n%s", diff)
+			}
+			if !tc.write {
+				return
+			}
+			for _, name := range got {
+				rec := readLease(t, client, newPathOrDie("gs://bucket/path/to/leases/"+name))
+				if rec.Holder != "me" || rec.Expires.After(time.Now()) {
+					t.Errorf("Update() left %s leased to %s until %v", name, rec.Holder, rec.Expires)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateLeaseExpiry(t *testing.T) {
+	const freq = 2 * time.Second
+	configPath := newPathOrDie("gs://bucket/path/to/config")
+	leasePath := func(name string) gcs.Path {
+		return newPathOrDie("gs://bucket/path/to/leases/" + name)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client := fakeLeaseClient()
+	cfg := &configpb.Configuration{
+		Dashboards: []*configpb.Dashboard{
+			{Name: "dash"},
+		},
+	}
+	for _, name := range []string{"free", "held", "notified"} {
+		tg := &configpb.TestGroup{
+			Name:             name,
+			GcsPrefix:        "bucket/logs/" + name,
+			DaysOfResults:    7,
+			NumColumnsRecent: 6,
+		}
+		if name == "notified" {
+			tg.GcsPrefix = ""
+			tg.ResultSource = &configpb.TestGroup_ResultSource{
+				ResultSourceConfig: &configpb.TestGroup_ResultSource_GcsConfig{
+					GcsConfig: &configpb.GCSConfig{
+						GcsPrefix:          "bucket/logs/" + name,
+						PubsubProject:      "project",
+						PubsubSubscription: "sub",
+					},
+				},
+			}
+		}
+		cfg.TestGroups = append(cfg.TestGroups, tg)
+		cfg.Dashboards[0].DashboardTab = append(cfg.Dashboards[0].DashboardTab, &configpb.DashboardTab{
+			Name:          name,
+			TestGroupName: name,
+		})
+	}
+	buf, err := config.MarshalBytes(cfg)
+	if err != nil {
+		t.Fatalf("config.MarshalBytes() errored: %v", err)
+	}
+	client.Opener[configPath] = fakeObject{
+		Data:  string(buf),
+		Attrs: &storage.ReaderObjectAttrs{},
+	}
+	start := time.Now()
+	expires := start.Add(100 * time.Millisecond)
+	client.Uploader[leasePath("held")] = leaseUpload(t, "other", expires, 1)
+
+	var lock sync.Mutex
+	got := map[string]time.Time{}
+	updateGroup := func(_ context.Context, _ logrus.FieldLogger, _ gcs.Client, tg *configpb.TestGroup, _ gcs.Path) (bool, error) {
+		lock.Lock()
+		defer lock.Unlock()
+		if _, ok := got[tg.Name]; !ok {
+			got[tg.Name] = time.Now()
+		}
+		if _, ok := got["held"]; ok {
+			cancel()
+		}
+		return false, nil
+	}
+	opts := &UpdateOptions{
+		ConfigPath:       configPath,
+		GridPrefix:       "grid",
+		GroupConcurrency: 1,
+		Write:            true,
+		Freq:             freq,
+		LeasePrefix:      "leases",
+		LeaseHolder:      "me",
+	}
+	Update(ctx, client, nil, updateGroup, opts)
+
+	lock.Lock()
+	defer lock.Unlock()
+	for _, name := range []string{"free", "notified"} {
+		if _, ok := got[name]; !ok {
+			t.Errorf("Update() failed to update %s group", name)
+		}
+	}
+	switch when, ok := got["held"]; {
+	case !ok:
+		t.Error("Update() failed to update held group")
+	case when.Before(expires):
+		t.Errorf("Update() updated held group at %v, before its lease expired at %v", when, expires)
+	case when.After(start.Add(freq / 2)):
+		t.Errorf("Update() updated held group at %v, want soon after its lease expired at %v", when, expires)
+	}
+	client.Lock.RLock()
+	defer client.Lock.RUnlock()
+	for name := range got {
+		rec := readLease(t, client, leasePath(name))
+		if name == "notified" {
+			// Notifications may reach any replica.
+			if rec.Holder != "me" || rec.Expires.After(got[name].Add(freq/2)) {
+				t.Errorf("Update() left %s leased to %s until %v, want released", name, rec.Holder, rec.Expires)
+			}
+			continue
+		}
+		if rec.Holder != "me" || rec.Expires.Before(start.Add(freq)) {
+			t.Errorf("Update() left %s leased to %s until %v, want at least %v", name, rec.Holder, rec.Expires, start.Add(freq))
+		}
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+)
+
+// Properties extracted from junit output.
+const (
+	SystemOutProperty  = "system-out"
+	SystemErrProperty  = "system-err"
+	AttachmentProperty = "attachment"
+)
+
+var (
+	attachmentRe = regexp.MustCompile(`\[\[ATTACHMENT\|([^\]]+)\]\]`)
+	keyValueRe   = regexp.MustCompile(`^\s*([\w.-]+)\s*=\s*(.*?)\s*$`)
+)
+
+// outputOptions determines which parts of the junit output become properties.
+type outputOptions struct {
+	systemOutChars int
+	systemErrChars int
+	attachments    bool
+	keyValue       *regexp.Regexp
+}
+
+func makeOutputOptions(opts *configpb.OutputPropertyOptions) outputOptions {
+	out := outputOptions{
+		systemOutChars: int(opts.GetSystemOutChars()),
+		systemErrChars: int(opts.GetSystemErrChars()),
+		attachments:    opts.GetAttachments(),
+	}
+	if expr := opts.GetKeyValueRegex(); expr != "" {
+		out.keyValue, _ = regexp.Compile(expr) // validated by config
+	}
+	return out
+}
+
+func (o outputOptions) enabled() bool {
+	return o.systemOutChars > 0 || o.systemErrChars > 0 || o.attachments || o.keyValue != nil
+}
+
+// outputProperties extracts properties from the result's output.
+//
+// Returns nil unless the options extract at least one value.
+func outputProperties(opt outputOptions, r *junit.Result) map[string][]string {
+	if !opt.enabled() {
+		return nil
+	}
+	out := map[string][]string{}
+	snippet := func(name string, s *string, max int) {
+		if s == nil || max <= 0 {
+			return
+		}
+		if val := strings.TrimSpace(*s); val != "" {
+			out[name] = append(out[name], firstRunes(val, max))
+		}
+	}
+	snippet(SystemOutProperty, r.Output, opt.systemOutChars)
+	snippet(SystemErrProperty, r.Error, opt.systemErrChars)
+
+	for _, s := range []*string{r.Output, r.Error} {
+		if s == nil {
+			continue
+		}
+		if opt.attachments {
+			for _, m := range attachmentRe.FindAllStringSubmatch(*s, -1) {
+				out[AttachmentProperty] = append(out[AttachmentProperty], strings.TrimSpace(m[1]))
+			}
+		}
+		if opt.keyValue == nil {
+			continue
+		}
+		for _, line := range strings.Split(*s, "\n") {
+			m := keyValueRe.FindStringSubmatch(line)
+			if m == nil || !opt.keyValue.MatchString(m[1]) {
+				continue
+			}
+			out[m[1]] = append(out[m[1]], m[2])
+		}
+	}
+	if len(out) == 0 {
+		return nil
+	}
+	return out
+}
+
+// firstRunes returns up to the first max runes of s.
+func firstRunes(s string, max int) string {
+	var n int
+	for i := range s {
+		if n == max {
+			return s[:i]
+		}
+		n++
+	}
+	return s
+}
+
+// withProperties returns a copy of the result with the extra properties appended.
+func withProperties(r junit.Result, extra map[string][]string) junit.Result {
+	if len(extra) == 0 {
+		return r
+	}
+	props := &junit.Properties{}
+	if r.Properties != nil {
+		props.PropertyList = append(props.PropertyList, r.Properties.PropertyList...)
+	}
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		for _, val := range extra[name] {
+			props.PropertyList = append(props.PropertyList, junit.Property{Name: name, Value: val})
+		}
+	}
+	r.Properties = props
+	return r
+}
+
+// cellProperties joins multiple values of each property with newlines.
+func cellProperties(props map[string][]string) map[string]string {
+	if len(props) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(props))
+	for name, vals := range props {
+		out[name] = strings.Join(vals, "\n")
+	}
+	return out
+}
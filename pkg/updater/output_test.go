@@ -0,0 +1,198 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+)
+
+func TestMakeOutputOptions(t *testing.T) {
+	cases := []struct {
+		name     string
+		opts     *configpb.OutputPropertyOptions
+		expected outputOptions
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "all options",
+			opts: &configpb.OutputPropertyOptions{
+				SystemOutChars: 10,
+				SystemErrChars: 20,
+				Attachments:    true,
+				KeyValueRegex:  "^key$",
+			},
+			expected: outputOptions{
+				systemOutChars: 10,
+				systemErrChars: 20,
+				attachments:    true,
+				keyValue:       regexp.MustCompile("^key$"),
+			},
+		},
+		{
+			name: "ignore invalid regex",
+			opts: &configpb.OutputPropertyOptions{
+				KeyValueRegex: "?bad",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := makeOutputOptions(tc.opts)
+			if diff := cmp.Diff(tc.expected, got, cmp.AllowUnexported(outputOptions{}), cmp.Comparer(func(x, y *regexp.Regexp) bool {
+				if x == nil || y == nil {
+					return x == y
+				}
+				return x.String() == y.String()
+			})); diff != "" {
+				t.Errorf("makeOutputOptions() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestOutputProperties(t *testing.T) {
+	pstr := func(s string) *string {
+		return &s
+	}
+
+	cases := []struct {
+		name     string
+		opt      outputOptions
+		result   junit.Result
+		expected map[string][]string
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "disabled",
+			result: junit.Result{
+				Output: pstr("[[ATTACHMENT|foo.txt]]\nkey=value"),
+			},
+		},
+		{
+			name: "snippets",
+			opt: outputOptions{
+				systemOutChars: 5,
+				systemErrChars: 100,
+			},
+			result: junit.Result{
+				Output: pstr("\n  hello world\n"),
+				Error:  pstr("ouch"),
+			},
+			expected: map[string][]string{
+				SystemOutProperty: {"hello"},
+				SystemErrProperty: {"ouch"},
+			},
+		},
+		{
+			name: "snippets truncate runes",
+			opt: outputOptions{
+				systemOutChars: 2,
+			},
+			result: junit.Result{
+				Output: pstr("héllo"),
+			},
+			expected: map[string][]string{
+				SystemOutProperty: {"hé"},
+			},
+		},
+		{
+			name: "empty snippets",
+			opt: outputOptions{
+				systemOutChars: 5,
+			},
+			result: junit.Result{
+				Output: pstr("   "),
+			},
+		},
+		{
+			name: "attachments",
+			opt: outputOptions{
+				attachments: true,
+			},
+			result: junit.Result{
+				Output: pstr("see [[ATTACHMENT|/tmp/screenshot.png]] and\n[[ATTACHMENT| log.txt ]]"),
+				Error:  pstr("[[ATTACHMENT|core.dump]]"),
+			},
+			expected: map[string][]string{
+				AttachmentProperty: {"/tmp/screenshot.png", "log.txt", "core.dump"},
+			},
+		},
+		{
+			name: "key values",
+			opt: outputOptions{
+				keyValue: regexp.MustCompile(`^(node|zone)$`),
+			},
+			result: junit.Result{
+				Output: pstr("node=n1\n  zone = us-central1-b \nother=ignored\nnot a key value\nnode=n2"),
+			},
+			expected: map[string][]string{
+				"node": {"n1", "n2"},
+				"zone": {"us-central1-b"},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := outputProperties(tc.opt, &tc.result)
+			if diff := cmp.Diff(tc.expected, got); diff != "" {
+				t.Errorf("outputProperties() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWithProperties(t *testing.T) {
+	orig := junit.Result{
+		Name: "hello",
+		Properties: &junit.Properties{
+			PropertyList: []junit.Property{{Name: "existing", Value: "yes"}},
+		},
+	}
+	got := withProperties(orig, map[string][]string{
+		"b": {"2", "3"},
+		"a": {"1"},
+	})
+	expected := junit.Result{
+		Name: "hello",
+		Properties: &junit.Properties{
+			PropertyList: []junit.Property{
+				{Name: "existing", Value: "yes"},
+				{Name: "a", Value: "1"},
+				{Name: "b", Value: "2"},
+				{Name: "b", Value: "3"},
+			},
+		},
+	}
+	if diff := cmp.Diff(expected, got); diff != "" {
+		t.Errorf("withProperties() got unexpected diff (-want +got):\n%s", diff)
+	}
+	if n := len(orig.Properties.PropertyList); n != 1 {
+		t.Errorf("withProperties() modified the original result's properties: got %d, want 1", n)
+	}
+}
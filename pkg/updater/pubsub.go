@@ -36,7 +36,8 @@ import (
 
 // FixGCS listens for changes to GCS files and schedules another update of those groups ~immediately.
 //
-// Limited to test groups with a gcs_config result_source that includes pubsub info.
+// Limited to test groups with a gcs_config or ingest_config result_source that includes pubsub info.
+// Groups with several result_sources listen to the subscription of each source.
 // Returns when the context is canceled or a processing error occurs.
 func FixGCS(subscriber pubsub.Subscriber) Fixer {
 	return func(ctx context.Context, log logrus.FieldLogger, q *config.TestGroupQueue, groups []*configpb.TestGroup) error {
@@ -62,18 +63,25 @@ func gcsSubscribedPaths(tgs []*configpb.TestGroup) (map[gcs.Path][]string, []sub
 	subscriptions := map[subscription]bool{}
 
 	for _, tg := range tgs {
-		sub := groupSubscription(tg)
-		if sub == nil {
-			continue
-		}
-		subscriptions[*sub] = true
 		name := tg.Name
-		gps, err := groupPaths(tg)
-		if err != nil {
-			return nil, nil, fmt.Errorf("%s: %v", name, err)
-		}
-		for _, gp := range gps {
-			paths[gp] = append(paths[gp], name)
+		added := map[gcs.Path]bool{}
+		for _, stg := range sourceGroups(tg) {
+			sub := groupSubscription(stg)
+			if sub == nil {
+				continue
+			}
+			subscriptions[*sub] = true
+			gps, err := groupPaths(stg)
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s: %v", name, err)
+			}
+			for _, gp := range gps {
+				if added[gp] {
+					continue
+				}
+				added[gp] = true
+				paths[gp] = append(paths[gp], name)
+			}
 		}
 	}
 	var subs []subscription
@@ -86,12 +94,38 @@ func gcsSubscribedPaths(tgs []*configpb.TestGroup) (map[gcs.Path][]string, []sub
 	return paths, subs, nil
 }
 
+// sourceGroups returns a group for each result source of tg.
+func sourceGroups(tg *configpb.TestGroup) []*configpb.TestGroup {
+	srcs := tg.GetResultSources()
+	if len(srcs) == 0 {
+		return []*configpb.TestGroup{tg}
+	}
+	out := make([]*configpb.TestGroup, 0, len(srcs))
+	for _, src := range srcs {
+		out = append(out, sourceGroup(tg, src))
+	}
+	return out
+}
+
+// notified returns whether any result source of tg has a subscription.
+func notified(tg *configpb.TestGroup) bool {
+	for _, stg := range sourceGroups(tg) {
+		if groupSubscription(stg) != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func groupSubscription(tg *configpb.TestGroup) *subscription {
-	cfg := tg.GetResultSource().GetGcsConfig()
-	if cfg == nil {
+	var proj, sub string
+	if cfg := tg.GetResultSource().GetGcsConfig(); cfg != nil {
+		proj, sub = cfg.PubsubProject, cfg.PubsubSubscription
+	} else if cfg := tg.GetResultSource().GetIngestConfig(); cfg != nil {
+		proj, sub = cfg.PubsubProject, cfg.PubsubSubscription
+	} else {
 		return manualGroupSubscription(tg)
 	}
-	proj, sub := cfg.PubsubProject, cfg.PubsubSubscription
 	if proj == "" || sub == "" {
 		return nil
 	}
@@ -226,6 +260,8 @@ func processNotification(paths map[gcs.Path][]string, n *pubsub.Notification) ([
 			dur = 5 * time.Minute
 		case strings.HasSuffix(base, ".txt") && strings.Contains(obj, "directory/"): // symlink to actual data
 			dur = 5 * time.Minute
+		case strings.HasSuffix(base, IngestedSuffix): // column pushed to the api
+			dur = 0
 		default:
 			return nil, 0
 		}
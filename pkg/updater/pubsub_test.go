@@ -250,6 +250,72 @@ func TestGCSSubscribedPaths(t *testing.T) {
 				{"super", "duper"},
 			},
 		},
+		{
+			name: "ingest",
+			tgs: []*configpb.TestGroup{
+				{
+					Name: "pushed",
+					ResultSource: &configpb.TestGroup_ResultSource{
+						ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+							IngestConfig: &configpb.IngestConfig{
+								GcsPrefix:          "bucket/pushed",
+								PubsubProject:      "fancy",
+								PubsubSubscription: "cake",
+							},
+						},
+					},
+				},
+			},
+			want: map[gcs.Path][]string{
+				mustPath("gs://bucket/pushed/"): {"pushed"},
+			},
+			wantSubs: []subscription{
+				{"fancy", "cake"},
+			},
+		},
+		{
+			name: "result sources",
+			tgs: []*configpb.TestGroup{
+				{
+					Name: "combined",
+					ResultSources: []*configpb.TestGroup_ResultSource{
+						{
+							ResultSourceConfig: &configpb.TestGroup_ResultSource_GcsConfig{
+								GcsConfig: &configpb.GCSConfig{
+									GcsPrefix:          "bucket/polled",
+									PubsubProject:      "fancy",
+									PubsubSubscription: "cake",
+								},
+							},
+						},
+						{
+							ResultSourceConfig: &configpb.TestGroup_ResultSource_IngestConfig{
+								IngestConfig: &configpb.IngestConfig{
+									GcsPrefix:          "bucket/pushed",
+									PubsubProject:      "super",
+									PubsubSubscription: "duper",
+								},
+							},
+						},
+						{
+							ResultSourceConfig: &configpb.TestGroup_ResultSource_GcsConfig{
+								GcsConfig: &configpb.GCSConfig{
+									GcsPrefix: "bucket/unsubscribed",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: map[gcs.Path][]string{
+				mustPath("gs://bucket/polled/"): {"combined"},
+				mustPath("gs://bucket/pushed/"): {"combined"},
+			},
+			wantSubs: []subscription{
+				{"fancy", "cake"},
+				{"super", "duper"},
+			},
+		},
 		{
 			name: "manually empty",
 			manual: map[string]subscription{
@@ -656,6 +722,17 @@ func TestProcessNotification(t *testing.T) {
 			want:    []string{"hello", "me", "world", "yes"},
 			wantDur: 5 * time.Minute,
 		},
+		{
+			name: "ingested column",
+			paths: map[gcs.Path][]string{
+				mustPath("gs://foo/bar"): {"yes"},
+				mustPath("gs://not/me"):  {"nope"},
+			},
+			n: &pubsub.Notification{
+				Path: mustPath("gs://foo/bar/1600000000-10" + IngestedSuffix),
+			},
+			want: []string{"yes"},
+		},
 	}
 
 	for name, dur := range namedDurations {
@@ -18,6 +18,7 @@ package updater
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -28,6 +29,7 @@ import (
 	"time"
 
 	"cloud.google.com/go/storage"
+	"github.com/GoogleCloudPlatform/testgrid/metadata"
 	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
 	evalpb "github.com/GoogleCloudPlatform/testgrid/pb/custom_evaluator"
 	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
@@ -68,7 +70,9 @@ func gcsColumnReader(client gcs.Client, buildTimeout time.Duration, readResult *
 		if err != nil {
 			return fmt.Errorf("list builds: %w", err)
 		}
-		log.WithField("listBuilds", time.Since(listBuildsStart)).WithField("total", len(builds)).Debug("Listed builds")
+		listBuildsDur := time.Since(listBuildsStart)
+		RecordPhase(ctx, PhaseListBuilds, listBuildsDur)
+		log.WithField("listBuilds", listBuildsDur).WithField("total", len(builds)).Debug("Listed builds")
 
 		readColumns(ctx, client, log, tg, builds, stop, buildTimeout, receivers, readResult, enableIgnoreSkip)
 		return nil
@@ -143,6 +147,19 @@ type resultReader struct {
 	read func(context.Context, gcs.Downloader, gcs.Build, time.Time) func() (*gcsResult, error)
 }
 
+// artifactParsers returns the parsers for the group's artifact formats, or nil for the defaults.
+func artifactParsers(group *configpb.TestGroup) ([]gcs.ArtifactParser, error) {
+	formats := group.GetArtifactFormats()
+	if len(formats) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(formats))
+	for _, f := range formats {
+		names = append(names, strings.ToLower(strings.TrimPrefix(f.String(), "ARTIFACT_FORMAT_")))
+	}
+	return gcs.ArtifactParsers(names...)
+}
+
 // readColumns will list, download and process builds into inflatedColumns.
 func readColumns(ctx context.Context, client gcs.Downloader, log logrus.FieldLogger, group *configpb.TestGroup, builds []gcs.Build, stop time.Time, buildTimeout time.Duration, receivers chan<- InflatedColumn, readResult *resultReader, enableIgnoreSkip bool) {
 	if len(builds) == 0 {
@@ -153,10 +170,15 @@ func readColumns(ctx context.Context, client gcs.Downloader, log logrus.FieldLog
 	defer cancel()
 
 	nameCfg := makeNameConfig(group)
+	parsers, err := artifactParsers(group)
+	if err != nil {
+		log.WithError(err).Warning("Failed to find artifact parsers, using defaults")
+	}
 	var heads []string
 	for _, h := range group.ColumnHeader {
 		heads = append(heads, h.ConfigurationValue)
 	}
+	readRefs := usesRefs(heads, nameCfg)
 
 	type resp struct {
 		build gcs.Build
@@ -224,6 +246,8 @@ func readColumns(ctx context.Context, client gcs.Downloader, log logrus.FieldLog
 	}
 	for i := len(builds) - 1; i >= 0; i-- {
 		b := builds[i]
+		b.Parsers = parsers
+		b.ReadRefs = readRefs
 		r := resp{
 			build: b,
 			res:   readResult.read(ctx, client, b, stop),
@@ -297,6 +321,7 @@ type groupOptions struct {
 	annotations    []*configpb.TestGroup_TestAnnotation
 	rules          []*evalpb.Rule
 	ignoreSkip     bool
+	output         outputOptions
 }
 
 func makeOptions(group *configpb.TestGroup) groupOptions {
@@ -310,6 +335,7 @@ func makeOptions(group *configpb.TestGroup) groupOptions {
 		annotations:    group.TestAnnotations,
 		rules:          group.GetCustomEvaluatorRuleSet().GetRules(),
 		ignoreSkip:     group.GetIgnoreSkip(),
+		output:         makeOutputOptions(group.GetOutputPropertyOptions()),
 	}
 }
 
@@ -359,6 +385,21 @@ func makeNameConfig(group *configpb.TestGroup) nameConfig {
 	return nameCfg
 }
 
+// usesRefs returns whether the column headers or test names use the refs of the build.
+func usesRefs(heads []string, nameCfg nameConfig) bool {
+	for _, h := range heads {
+		if metadata.IsRefsKey(h) {
+			return true
+		}
+	}
+	for _, p := range nameCfg.parts {
+		if metadata.IsRefsKey(p) {
+			return true
+		}
+	}
+	return false
+}
+
 func firstFilled(strs ...string) string {
 	for _, s := range strs {
 		if s != "" {
@@ -411,12 +452,21 @@ func (e *noStartError) Error() string {
 	return "Start timestamp for this job is 0."
 }
 
+// undecodable returns whether the error means a file does not contain the expected json.
+func undecodable(err error) bool {
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.As(err, &syntaxErr) || errors.As(err, &typeErr)
+}
+
 // readResult will download all GCS artifacts in parallel.
 //
 // Specifically download the following files:
 // * started.json
 // * finished.json
-// * any junit.xml files under the artifacts directory.
+// * podinfo.json, when present
+// * prowjob.json and clone-records.json, when present and the build requests refs
+// * any test result files (such as junit.xml) under the artifacts directory.
 func readResult(parent context.Context, client gcs.Downloader, build gcs.Build, stop time.Time) (*gcsResult, error) {
 	ctx, cancel := context.WithCancel(parent) // Allows aborting after first error
 	defer cancel()
@@ -497,6 +547,52 @@ func readResult(parent context.Context, client gcs.Downloader, build gcs.Build,
 		}
 	}()
 
+	if build.ReadRefs {
+		// Download prowjob.json
+		work++
+		go func() {
+			pj, err := build.ProwJob(ctx, client)
+			switch {
+			case errors.Is(err, io.EOF):
+				addMalformed("prowjob.json")
+				err = nil
+			case undecodable(err):
+				addMalformed(fmt.Sprintf("prowjob.json: %s", err))
+				err = nil
+			case err != nil:
+				err = fmt.Errorf("prowjob: %w", err)
+			default:
+				result.prowJob = pj
+			}
+			select {
+			case <-ctx.Done():
+			case ec <- err:
+			}
+		}()
+
+		// Download clone-records.json
+		work++
+		go func() {
+			records, err := build.CloneRecords(ctx, client)
+			switch {
+			case errors.Is(err, io.EOF):
+				addMalformed("clone-records.json")
+				err = nil
+			case undecodable(err):
+				addMalformed(fmt.Sprintf("clone-records.json: %s", err))
+				err = nil
+			case err != nil:
+				err = fmt.Errorf("clone records: %w", err)
+			default:
+				result.cloneRecords = records
+			}
+			select {
+			case <-ctx.Done():
+			case ec <- err:
+			}
+		}()
+	}
+
 	// Download suites
 	work++
 	go func() {
@@ -539,7 +635,7 @@ func readResult(parent context.Context, client gcs.Downloader, build gcs.Build,
 	return &result, nil
 }
 
-// readSuites asynchronously lists and downloads junit.xml files
+// readSuites asynchronously lists and downloads test result files, such as junit.xml
 func readSuites(parent context.Context, client gcs.Downloader, build gcs.Build) ([]gcs.SuitesMeta, error) {
 	ctx, cancel := context.WithCancel(parent)
 	defer cancel()
@@ -1158,6 +1158,66 @@ func TestReadColumns(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "read configured artifact formats",
+			group: &configpb.TestGroup{
+				ArtifactFormats: []configpb.TestGroup_ArtifactFormat{configpb.TestGroup_ARTIFACT_FORMAT_TAP},
+			},
+			builds: []fakeBuild{
+				{
+					id:      "build-1",
+					podInfo: podInfoSuccess,
+					started: &fakeObject{
+						Data: jsonData(metadata.Started{Timestamp: now}),
+					},
+					finished: &fakeObject{
+						Data: jsonData(metadata.Finished{
+							Timestamp: pint64(now + 10),
+							Passed:    &yes,
+						}),
+					},
+					artifacts: map[string]fakeObject{
+						"results.tap": {
+							Data: "ok 1 - works\nnot ok 2 - broken\n  ---\n  message: 'boom'\n  ...\n",
+						},
+						"junit_ignored.xml": {
+							Data: xmlData(
+								junit.Suite{
+									Results: []junit.Result{
+										{Name: "ignored"},
+									},
+								}),
+						},
+					},
+				},
+			},
+			expected: []InflatedColumn{
+				{
+					Column: &statepb.Column{
+						Build:   "build-1",
+						Started: float64(now * 1000),
+						Hint:    "build-1",
+					},
+					Cells: map[string]Cell{
+						".." + overallRow: {
+							Result: statuspb.TestStatus_PASS,
+							Metrics: map[string]float64{
+								"test-duration-minutes": 10 / 60.0,
+							},
+						},
+						".." + podInfoRow: podInfoPassCell,
+						"works": {
+							Result: statuspb.TestStatus_PASS,
+						},
+						"broken": {
+							Result:  statuspb.TestStatus_FAIL,
+							Icon:    "F",
+							Message: "boom\nmessage: 'boom'",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	poolCtx, poolCancel := context.WithCancel(context.Background())
@@ -1443,14 +1503,53 @@ func TestMakeNameConfig(t *testing.T) {
 	}
 }
 
+func TestUsesRefs(t *testing.T) {
+	cases := []struct {
+		name    string
+		heads   []string
+		nameCfg nameConfig
+		want    bool
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name:  "other headers",
+			heads: []string{"Commit", "node"},
+		},
+		{
+			name:  "refs header",
+			heads: []string{"Commit", metadata.BaseSHAKey},
+			want:  true,
+		},
+		{
+			name: "refs in test name",
+			nameCfg: nameConfig{
+				format: "%s [%s]",
+				parts:  []string{testsName, metadata.PullKey},
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := usesRefs(tc.heads, tc.nameCfg); got != tc.want {
+				t.Errorf("usesRefs() got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestReadResult(t *testing.T) {
 	path := newPathOrDie("gs://bucket/path/to/some/build/")
 	yes := true
 	cases := []struct {
-		name string
-		ctx  context.Context
-		data map[string]fakeObject
-		stop time.Time
+		name     string
+		ctx      context.Context
+		data     map[string]fakeObject
+		stop     time.Time
+		readRefs bool
 
 		expected *gcsResult
 	}{
@@ -1518,16 +1617,94 @@ func TestReadResult(t *testing.T) {
 			},
 		},
 		{
-			name: "empty files report missing",
+			name:     "prow job and clone records",
+			readRefs: true,
+			data: map[string]fakeObject{
+				"started.json":       {Data: `{"node": "fun"}`},
+				"finished.json":      {Data: `{"passed": true}`},
+				"prowjob.json":       {Data: `{"spec": {"type": "presubmit", "refs": {"org": "org", "repo": "repo", "pulls": [{"number": 1, "author": "alice"}]}}}`},
+				"clone-records.json": {Data: `[{"refs": {"org": "org", "repo": "repo"}, "final_sha": "abc"}]`},
+			},
+			expected: &gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{Node: "fun"},
+				},
+				finished: gcs.Finished{
+					Finished: metadata.Finished{Passed: &yes},
+				},
+				prowJob: &metadata.ProwJob{
+					Spec: metadata.ProwJobSpec{
+						Type: "presubmit",
+						Refs: &metadata.Refs{
+							Org:   "org",
+							Repo:  "repo",
+							Pulls: []metadata.Pull{{Number: 1, Author: "alice"}},
+						},
+					},
+				},
+				cloneRecords: []metadata.CloneRecord{
+					{
+						Refs:     metadata.Refs{Org: "org", Repo: "repo"},
+						FinalSHA: "abc",
+					},
+				},
+			},
+		},
+		{
+			name: "ignore refs unless requested",
 			data: map[string]fakeObject{
-				"finished.json": {Data: ""},
-				"started.json":  {Data: ""},
-				"podinfo.json":  {Data: ""},
+				"started.json":       {Data: `{"node": "fun"}`},
+				"finished.json":      {Data: `{"passed": true}`},
+				"prowjob.json":       {Data: `{"spec": {"type": "presubmit"}}`},
+				"clone-records.json": {Data: `{"refs"`},
+			},
+			expected: &gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{Node: "fun"},
+				},
+				finished: gcs.Finished{
+					Finished: metadata.Finished{Passed: &yes},
+				},
+			},
+		},
+		{
+			name:     "undecodable refs report malformed",
+			readRefs: true,
+			data: map[string]fakeObject{
+				"started.json":       {Data: `{"node": "fun"}`},
+				"finished.json":      {Data: `{"passed": true}`},
+				"prowjob.json":       {Data: `{"spec": }`},
+				"clone-records.json": {Data: `[{"refs"`},
 			},
 			expected: &gcsResult{
+				started: gcs.Started{
+					Started: metadata.Started{Node: "fun"},
+				},
+				finished: gcs.Finished{
+					Finished: metadata.Finished{Passed: &yes},
+				},
 				malformed: []string{
+					"clone-records.json: read: decode: unexpected EOF",
+					"prowjob.json: read: decode: invalid character '}' looking for beginning of value",
+				},
+			},
+		},
+		{
+			name:     "empty files report missing",
+			readRefs: true,
+			data: map[string]fakeObject{
+				"finished.json":      {Data: ""},
+				"started.json":       {Data: ""},
+				"podinfo.json":       {Data: ""},
+				"prowjob.json":       {Data: ""},
+				"clone-records.json": {Data: ""},
+			},
+			expected: &gcsResult{
+				malformed: []string{
+					"clone-records.json",
 					"finished.json",
 					"podinfo.json",
+					"prowjob.json",
 					"started.json",
 				},
 			},
@@ -1685,7 +1862,8 @@ func TestReadResult(t *testing.T) {
 			client.Lister[path] = fi
 
 			build := gcs.Build{
-				Path: path,
+				Path:     path,
+				ReadRefs: tc.readRefs,
 			}
 			actual, err := readResult(ctx, client, build, tc.stop)
 			switch {
@@ -0,0 +1,488 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resultstore
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/api/iterator"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	beppb "github.com/GoogleCloudPlatform/testgrid/pb/bep"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/updater"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	resultstorepb "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+)
+
+// Suffixes of the build event protocol files in a bep_config prefix.
+const (
+	bepJSONSuffix   = ".json" // --build_event_json_file
+	bepBinarySuffix = ".pb"   // --build_event_binary_file
+)
+
+// testXML is the test action output holding junit results.
+const testXML = "test.xml"
+
+// BEPUpdater returns a GroupUpdater for Bazel build event protocol files stored in GCS.
+//
+// Links the issues from gatherIssues to rows when non-nil.
+func BEPUpdater(bepClient gcs.Downloader, groupTimeout time.Duration, write bool, gatherIssues updater.IssueGatherer) updater.GroupUpdater {
+	return func(parent context.Context, log logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path) (bool, error) {
+		if tg.GetResultSource().GetBepConfig() == nil {
+			log.Debug("Skipping non-BEP group")
+			return false, nil
+		}
+		ctx, cancel := context.WithTimeout(parent, groupTimeout)
+		defer cancel()
+		columnReader := BEPColumnReader(bepClient, 0)
+		reprocess := 20 * time.Minute // allow 20m for bazel to finish uploading outputs
+		return updater.InflateDropAppend(ctx, log, client, tg, gridPath, write, columnReader, reprocess, gatherIssues)
+	}
+}
+
+// BEPColumnReader returns a ColumnReader that converts each BEP file into an invocation.
+//
+// Invocations become columns the same way as those fetched from ResultStore.
+func BEPColumnReader(client gcs.Downloader, reprocess time.Duration) updater.ColumnReader {
+	return func(ctx context.Context, log logrus.FieldLogger, tg *configpb.TestGroup, oldCols []updater.InflatedColumn, defaultStop time.Time, receivers chan<- updater.InflatedColumn) error {
+		stop := updateStop(log, tg, time.Now(), oldCols, defaultStop, reprocess)
+		paths, err := bepPaths(tg)
+		if err != nil {
+			return fmt.Errorf("bep path: %w", err)
+		}
+		files, err := listBEP(ctx, client, stop, paths...)
+		if errors.Is(err, storage.ErrBucketNotExist) {
+			log.WithError(err).Info("Bucket does not exist")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("list bep files: %w", err)
+		}
+		log.WithField("total", len(files)).Debug("Listed BEP files")
+
+		var results []*FetchResult
+		for _, path := range files {
+			log := log.WithField("path", path)
+			events, err := readBEP(ctx, client, path)
+			if err != nil {
+				log.WithError(err).Warning("Failed to read BEP file")
+				continue
+			}
+			result := bepResult(ctx, log, client, path, events)
+			if result.Invocation.GetTiming().GetStartTime().AsTime().Before(stop) {
+				continue
+			}
+			results = append(results, result)
+		}
+		sendResults(log, tg, results, receivers)
+		return nil
+	}
+}
+
+// bepPaths returns the comma-separated prefixes of the group's bep_config.
+func bepPaths(tg *configpb.TestGroup) ([]gcs.Path, error) {
+	var out []gcs.Path
+	for idx, prefix := range strings.Split(tg.GetResultSource().GetBepConfig().GetGcsPrefix(), ",") {
+		prefix := strings.TrimSpace(prefix)
+		if prefix == "" {
+			continue
+		}
+		if !strings.HasSuffix(prefix, "/") {
+			prefix += "/"
+		}
+		if !strings.Contains(prefix, "://") {
+			prefix = "gs://" + prefix
+		}
+		p, err := gcs.NewPath(prefix)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %s: %w", idx, prefix, err)
+		}
+		out = append(out, *p)
+	}
+	return out, nil
+}
+
+// listBEP returns the BEP files under the prefixes written after stop.
+func listBEP(ctx context.Context, client gcs.Lister, stop time.Time, paths ...gcs.Path) ([]gcs.Path, error) {
+	var out []gcs.Path
+	for idx, prefix := range paths {
+		it := client.Objects(ctx, prefix, "", "")
+		for {
+			attrs, err := it.Next()
+			if errors.Is(err, iterator.Done) {
+				break
+			}
+			if err != nil {
+				return nil, fmt.Errorf("%d: %s: %w", idx, prefix, err)
+			}
+			if !strings.HasSuffix(attrs.Name, bepJSONSuffix) && !strings.HasSuffix(attrs.Name, bepBinarySuffix) {
+				continue
+			}
+			// Bazel writes the file after the invocation starts.
+			if !attrs.Updated.IsZero() && attrs.Updated.Before(stop) {
+				continue
+			}
+			p, err := prefix.ResolveReference(&url.URL{Path: "/" + attrs.Name})
+			if err != nil {
+				return nil, fmt.Errorf("%d: %s: %w", idx, attrs.Name, err)
+			}
+			out = append(out, *p)
+		}
+	}
+	return out, nil
+}
+
+// readBEP returns the events in a newline-delimited json or varint-delimited binary BEP file.
+func readBEP(ctx context.Context, client gcs.Opener, path gcs.Path) ([]*beppb.BuildEvent, error) {
+	r, _, err := client.Open(ctx, path)
+	if err != nil {
+		return nil, fmt.Errorf("open: %w", err)
+	}
+	defer r.Close()
+	if strings.HasSuffix(path.Object(), bepBinarySuffix) {
+		return parseBinaryBEP(r)
+	}
+	return parseJSONBEP(r)
+}
+
+func parseJSONBEP(r io.Reader) ([]*beppb.BuildEvent, error) {
+	var events []*beppb.BuildEvent
+	br := bufio.NewReader(r)
+	opt := protojson.UnmarshalOptions{DiscardUnknown: true}
+	for line := 1; ; line++ {
+		buf, err := br.ReadBytes('\n')
+		if err != nil && err != io.EOF {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		if buf = bytes.TrimSpace(buf); len(buf) > 0 {
+			var ev beppb.BuildEvent
+			if err := opt.Unmarshal(buf, &ev); err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+			events = append(events, &ev)
+		}
+		if err == io.EOF {
+			return events, nil
+		}
+	}
+}
+
+func parseBinaryBEP(r io.Reader) ([]*beppb.BuildEvent, error) {
+	var events []*beppb.BuildEvent
+	br := bufio.NewReader(r)
+	opt := protodelim.UnmarshalOptions{MaxSize: -1}
+	for {
+		var ev beppb.BuildEvent
+		err := opt.UnmarshalFrom(br, &ev)
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("event %d: %w", len(events)+1, err)
+		}
+		events = append(events, &ev)
+	}
+}
+
+// bepTime prefers the timestamp over the deprecated millis field.
+func bepTime(ts *timestamppb.Timestamp, millis int64) *timestamppb.Timestamp {
+	if ts != nil {
+		return ts
+	}
+	if millis == 0 {
+		return nil
+	}
+	return timestamppb.New(time.Unix(0, millis*int64(time.Millisecond)))
+}
+
+var convertBEPStatus = map[beppb.TestStatus]resultstorepb.Status{
+	beppb.TestStatus_PASSED:                     resultstorepb.Status_PASSED,
+	beppb.TestStatus_FLAKY:                      resultstorepb.Status_FLAKY,
+	beppb.TestStatus_TIMEOUT:                    resultstorepb.Status_TIMED_OUT,
+	beppb.TestStatus_FAILED:                     resultstorepb.Status_FAILED,
+	beppb.TestStatus_INCOMPLETE:                 resultstorepb.Status_INCOMPLETE,
+	beppb.TestStatus_REMOTE_FAILURE:             resultstorepb.Status_TOOL_FAILED,
+	beppb.TestStatus_FAILED_TO_BUILD:            resultstorepb.Status_FAILED_TO_BUILD,
+	beppb.TestStatus_TOOL_HALTED_BEFORE_TESTING: resultstorepb.Status_CANCELLED,
+}
+
+func statusAttributes(status resultstorepb.Status) *resultstorepb.StatusAttributes {
+	return &resultstorepb.StatusAttributes{Status: status}
+}
+
+// bepResult converts the events of one invocation into the protos ResultStore would return.
+//
+// Each target's status comes from its test summary, or whether it built when it has no tests.
+// The final attempt of each test shard and run becomes an action, whose test suite comes from test.xml.
+func bepResult(ctx context.Context, log logrus.FieldLogger, client gcs.Opener, path gcs.Path, events []*beppb.BuildEvent) *FetchResult {
+	inv := &resultstorepb.Invocation{
+		Id:     &resultstorepb.Invocation_Id{},
+		Timing: &resultstorepb.Timing{},
+	}
+	var labels []string
+	targets := map[string]*resultstorepb.Target{}
+	configured := map[string]*resultstorepb.ConfiguredTarget{}
+	ensureTarget := func(label string) *resultstorepb.ConfiguredTarget {
+		if ct, ok := configured[label]; ok {
+			return ct
+		}
+		labels = append(labels, label)
+		targets[label] = &resultstorepb.Target{
+			Id: &resultstorepb.Target_Id{TargetId: label},
+		}
+		ct := &resultstorepb.ConfiguredTarget{
+			Id:               &resultstorepb.ConfiguredTarget_Id{TargetId: label},
+			StatusAttributes: statusAttributes(resultstorepb.Status_BUILDING),
+		}
+		configured[label] = ct
+		return ct
+	}
+	type attemptKey struct {
+		label      string
+		shard, run int32
+	}
+	var attemptKeys []attemptKey
+	attempts := map[attemptKey]*beppb.BuildEvent{}
+
+	for _, ev := range events {
+		switch {
+		case ev.GetStarted() != nil:
+			started := ev.GetStarted()
+			inv.Id.InvocationId = started.GetUuid()
+			inv.Timing.StartTime = bepTime(started.GetStartTime(), started.GetStartTimeMillis())
+		case ev.GetWorkspaceStatus() != nil:
+			for _, item := range ev.GetWorkspaceStatus().GetItem() {
+				inv.Properties = append(inv.Properties, &resultstorepb.Property{Key: item.GetKey(), Value: item.GetValue()})
+			}
+		case ev.GetBuildMetadata() != nil:
+			meta := ev.GetBuildMetadata().GetMetadata()
+			keys := make([]string, 0, len(meta))
+			for k := range meta {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				inv.Properties = append(inv.Properties, &resultstorepb.Property{Key: k, Value: meta[k]})
+			}
+		case ev.GetConfigured() != nil:
+			label := ev.GetId().GetTargetConfigured().GetLabel()
+			ensureTarget(label)
+			targets[label].TargetAttributes = &resultstorepb.TargetAttributes{Tags: ev.GetConfigured().GetTag()}
+		case ev.GetCompleted() != nil:
+			ct := ensureTarget(ev.GetId().GetTargetCompleted().GetLabel())
+			switch {
+			case !ev.GetCompleted().GetSuccess():
+				ct.StatusAttributes = statusAttributes(resultstorepb.Status_FAILED_TO_BUILD)
+			case ct.GetStatusAttributes().GetStatus() == resultstorepb.Status_BUILDING:
+				ct.StatusAttributes = statusAttributes(resultstorepb.Status_BUILT)
+			}
+		case ev.GetTestResult() != nil:
+			id := ev.GetId().GetTestResult()
+			ct := ensureTarget(id.GetLabel())
+			if s := ct.GetStatusAttributes().GetStatus(); s == resultstorepb.Status_BUILDING || s == resultstorepb.Status_BUILT {
+				ct.StatusAttributes = statusAttributes(resultstorepb.Status_TESTING)
+			}
+			key := attemptKey{id.GetLabel(), id.GetShard(), id.GetRun()}
+			prev, ok := attempts[key]
+			if !ok {
+				attemptKeys = append(attemptKeys, key)
+			}
+			if !ok || prev.GetId().GetTestResult().GetAttempt() <= id.GetAttempt() {
+				attempts[key] = ev
+			}
+		case ev.GetTestSummary() != nil:
+			ct := ensureTarget(ev.GetId().GetTestSummary().GetLabel())
+			if status, ok := convertBEPStatus[ev.GetTestSummary().GetOverallStatus()]; ok {
+				ct.StatusAttributes = statusAttributes(status)
+			}
+		case ev.GetFinished() != nil:
+			finished := ev.GetFinished()
+			if end := bepTime(finished.GetFinishTime(), finished.GetFinishTimeMillis()); end != nil && inv.Timing.StartTime != nil {
+				inv.Timing.Duration = durationpb.New(end.AsTime().Sub(inv.Timing.StartTime.AsTime()))
+			}
+			status := resultstorepb.Status_FAILED
+			if code := finished.GetExitCode(); code != nil && code.GetCode() == 0 || code == nil && finished.GetOverallSuccess() {
+				status = resultstorepb.Status_PASSED
+			}
+			inv.StatusAttributes = statusAttributes(status)
+		}
+	}
+
+	if inv.Id.InvocationId == "" {
+		inv.Id.InvocationId = path.Object()
+	}
+	if inv.StatusAttributes == nil {
+		inv.StatusAttributes = statusAttributes(resultstorepb.Status_BUILDING)
+	}
+	invID := inv.Id.InvocationId
+
+	result := FetchResult{Invocation: inv}
+	for _, label := range labels {
+		targets[label].Id.InvocationId = invID
+		configured[label].Id.InvocationId = invID
+		result.Targets = append(result.Targets, targets[label])
+		result.ConfiguredTargets = append(result.ConfiguredTargets, configured[label])
+	}
+	for _, key := range attemptKeys {
+		ev := attempts[key]
+		id := ev.GetId().GetTestResult()
+		action := &resultstorepb.Action{
+			Id: &resultstorepb.Action_Id{
+				InvocationId: invID,
+				TargetId:     key.label,
+				ActionId:     fmt.Sprintf("test_shard%d_run%d_attempt%d", id.GetShard(), id.GetRun(), id.GetAttempt()),
+			},
+		}
+		tr := ev.GetTestResult()
+		if start := bepTime(tr.GetTestAttemptStart(), tr.GetTestAttemptStartMillisEpoch()); start != nil {
+			action.Timing = &resultstorepb.Timing{StartTime: start}
+			if d := tr.GetTestAttemptDuration(); d != nil {
+				action.Timing.Duration = d
+			} else {
+				action.Timing.Duration = durationpb.New(time.Duration(tr.GetTestAttemptDurationMillis()) * time.Millisecond)
+			}
+		}
+		testAction := &resultstorepb.TestAction{}
+		for _, f := range tr.GetTestActionOutput() {
+			if f.GetName() != testXML {
+				continue
+			}
+			suite, err := readTestXML(ctx, client, path, f)
+			if err != nil {
+				log.WithError(err).WithField("target", key.label).Warning("Failed to read test.xml")
+				break
+			}
+			testAction.TestSuite = suite
+			break
+		}
+		action.ActionType = &resultstorepb.Action_TestAction{TestAction: testAction}
+		result.Actions = append(result.Actions, action)
+	}
+	return &result
+}
+
+// readTestXML returns the junit results of a test.xml included inline or uploaded alongside the BEP file.
+//
+// Only reads uris in the same kind of storage as the BEP file at bepPath.
+func readTestXML(ctx context.Context, client gcs.Opener, bepPath gcs.Path, f *beppb.File) (*resultstorepb.TestSuite, error) {
+	buf := f.GetContents()
+	if uri := f.GetUri(); uri != "" {
+		path, err := gcs.NewPath(uri)
+		if err != nil {
+			return nil, fmt.Errorf("bad uri: %w", err)
+		}
+		if storageScheme(*path) != storageScheme(bepPath) {
+			return nil, fmt.Errorf("unsupported uri: %s", uri)
+		}
+		r, _, err := client.Open(ctx, *path)
+		if err != nil {
+			return nil, fmt.Errorf("open %s: %w", path, err)
+		}
+		defer r.Close()
+		if buf, err = ioutil.ReadAll(r); err != nil {
+			return nil, fmt.Errorf("read %s: %w", path, err)
+		}
+	}
+	suites, err := junit.Parse(buf)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %w", err)
+	}
+	out := &resultstorepb.TestSuite{}
+	for _, suite := range suites.Suites {
+		out.Tests = append(out.Tests, &resultstorepb.Test{
+			TestType: &resultstorepb.Test_TestSuite{TestSuite: convertJUnitSuite(suite)},
+		})
+	}
+	return out, nil
+}
+
+// storageScheme returns the scheme of the storage holding path, where file:// is the local filesystem.
+func storageScheme(path gcs.Path) string {
+	if u := path.URL(); u.Scheme != "file" {
+		return u.Scheme
+	}
+	return ""
+}
+
+func convertJUnitProperties(props *junit.Properties) []*resultstorepb.Property {
+	if props == nil {
+		return nil
+	}
+	var out []*resultstorepb.Property
+	for _, p := range props.PropertyList {
+		out = append(out, &resultstorepb.Property{Key: p.Name, Value: p.Value})
+	}
+	return out
+}
+
+func convertJUnitSuite(suite junit.Suite) *resultstorepb.TestSuite {
+	out := &resultstorepb.TestSuite{
+		SuiteName:  suite.Name,
+		Properties: convertJUnitProperties(suite.Properties),
+	}
+	for _, s := range suite.Suites {
+		out.Tests = append(out.Tests, &resultstorepb.Test{
+			TestType: &resultstorepb.Test_TestSuite{TestSuite: convertJUnitSuite(s)},
+		})
+	}
+	for _, r := range suite.Results {
+		tc := &resultstorepb.TestCase{
+			CaseName:   r.Name,
+			ClassName:  r.ClassName,
+			Result:     resultstorepb.TestCase_COMPLETED,
+			Properties: convertJUnitProperties(r.Properties),
+		}
+		if r.Skipped != nil {
+			tc.Result = resultstorepb.TestCase_SKIPPED
+		}
+		if f := r.Failure; f != nil {
+			msg := f.Message
+			if msg == "" {
+				msg = f.Value
+			}
+			tc.Failures = append(tc.Failures, &resultstorepb.TestFailure{FailureMessage: msg, ExceptionType: f.Type})
+		}
+		if e := r.Errored; e != nil {
+			msg := e.Message
+			if msg == "" {
+				msg = e.Value
+			}
+			tc.Errors = append(tc.Errors, &resultstorepb.TestError{ErrorMessage: msg, ExceptionType: e.Type})
+		}
+		out.Tests = append(out.Tests, &resultstorepb.Test{
+			TestType: &resultstorepb.Test_TestCase{TestCase: tc},
+		})
+	}
+	return out
+}
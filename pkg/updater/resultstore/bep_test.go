@@ -0,0 +1,559 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resultstore
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protodelim"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	beppb "github.com/GoogleCloudPlatform/testgrid/pb/bep"
+	configpb "github.com/GoogleCloudPlatform/testgrid/pb/config"
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+	statuspb "github.com/GoogleCloudPlatform/testgrid/pb/test_status"
+	"github.com/GoogleCloudPlatform/testgrid/pkg/updater"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
+	"github.com/GoogleCloudPlatform/testgrid/util/gcs/fake"
+	resultstorepb "google.golang.org/genproto/googleapis/devtools/resultstore/v2"
+)
+
+func mustPath(t *testing.T, s string) gcs.Path {
+	t.Helper()
+	p, err := gcs.NewPath(s)
+	if err != nil {
+		t.Fatalf("gcs.NewPath(%q): %v", s, err)
+	}
+	return *p
+}
+
+// bepEvents returns the events of a bazel test invocation of the labelled targets.
+func bepEvents(uuid string, started time.Time, build string, testXMLs map[string]string) []*beppb.BuildEvent {
+	events := []*beppb.BuildEvent{
+		{
+			Id: &beppb.BuildEventId{Id: &beppb.BuildEventId_Started{Started: &beppb.BuildEventId_BuildStartedId{}}},
+			Payload: &beppb.BuildEvent_Started{Started: &beppb.BuildStarted{
+				Uuid:      uuid,
+				StartTime: timestamppb.New(started),
+			}},
+		},
+		{
+			Id: &beppb.BuildEventId{Id: &beppb.BuildEventId_BuildMetadata{BuildMetadata: &beppb.BuildEventId_BuildMetadataId{}}},
+			Payload: &beppb.BuildEvent_BuildMetadata{BuildMetadata: &beppb.BuildMetadata{
+				Metadata: map[string]string{"build": build},
+			}},
+		},
+	}
+	var labels []string
+	for label := range testXMLs {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+	for _, label := range labels {
+		events = append(events,
+			&beppb.BuildEvent{
+				Id: &beppb.BuildEventId{Id: &beppb.BuildEventId_TestResult{TestResult: &beppb.BuildEventId_TestResultId{Label: label}}},
+				Payload: &beppb.BuildEvent_TestResult{TestResult: &beppb.TestResult{
+					Status: beppb.TestStatus_PASSED,
+					TestActionOutput: []*beppb.File{
+						{Name: testXML, File: &beppb.File_Contents{Contents: []byte(testXMLs[label])}},
+					},
+				}},
+			},
+			&beppb.BuildEvent{
+				Id: &beppb.BuildEventId{Id: &beppb.BuildEventId_TestSummary{TestSummary: &beppb.BuildEventId_TestSummaryId{Label: label}}},
+				Payload: &beppb.BuildEvent_TestSummary{TestSummary: &beppb.TestSummary{
+					OverallStatus: beppb.TestStatus_PASSED,
+				}},
+			},
+		)
+	}
+	return append(events, &beppb.BuildEvent{
+		Id: &beppb.BuildEventId{Id: &beppb.BuildEventId_BuildFinished{BuildFinished: &beppb.BuildEventId_BuildFinishedId{}}},
+		Payload: &beppb.BuildEvent_Finished{Finished: &beppb.BuildFinished{
+			ExitCode:   &beppb.BuildFinished_ExitCode{Name: "SUCCESS"},
+			FinishTime: timestamppb.New(started.Add(time.Minute)),
+		}},
+		LastMessage: true,
+	})
+}
+
+func jsonBEP(t *testing.T, events []*beppb.BuildEvent) string {
+	t.Helper()
+	var lines []string
+	for _, ev := range events {
+		buf, err := protojson.Marshal(ev)
+		if err != nil {
+			t.Fatalf("protojson.Marshal(): %v", err)
+		}
+		lines = append(lines, string(buf))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func binaryBEP(t *testing.T, events []*beppb.BuildEvent) string {
+	t.Helper()
+	var buf bytes.Buffer
+	for _, ev := range events {
+		if _, err := protodelim.MarshalTo(&buf, ev); err != nil {
+			t.Fatalf("protodelim.MarshalTo(): %v", err)
+		}
+	}
+	return buf.String()
+}
+
+func TestReadBEP(t *testing.T) {
+	events := bepEvents("inv-1", time.Unix(1000, 0), "42", map[string]string{
+		"//foo:test": `<testsuite><testcase name="hello"/></testsuite>`,
+	})
+	jsonPath := mustPath(t, "gs://bucket/bep/inv-1.json")
+	binaryPath := mustPath(t, "gs://bucket/bep/inv-1.pb")
+	badPath := mustPath(t, "gs://bucket/bep/bad.json")
+	client := fake.Opener{
+		jsonPath:   {Data: jsonBEP(t, events)},
+		binaryPath: {Data: binaryBEP(t, events)},
+		badPath:    {Data: "{\"id\": {}}\nnot json\n"},
+	}
+	cases := []struct {
+		name string
+		path gcs.Path
+		want []*beppb.BuildEvent
+		err  bool
+	}{
+		{
+			name: "json",
+			path: jsonPath,
+			want: events,
+		},
+		{
+			name: "binary",
+			path: binaryPath,
+			want: events,
+		},
+		{
+			name: "malformed json",
+			path: badPath,
+			err:  true,
+		},
+		{
+			name: "missing",
+			path: mustPath(t, "gs://bucket/bep/missing.pb"),
+			err:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := readBEP(context.Background(), client, tc.path)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("readBEP() got unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("readBEP() failed to receive an error")
+			default:
+				if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("readBEP() got unexpected diff (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBEPResult(t *testing.T) {
+	path := mustPath(t, "gs://bucket/bep/inv.json")
+	client := fake.Opener{
+		mustPath(t, "gs://bucket/outputs/test.xml"): {
+			Data: `<testsuites><testsuite name="suite"><testcase name="bad" classname="pkg.Class"><failure message="boom"/></testcase><testcase name="skip"><skipped/></testcase></testsuite></testsuites>`,
+		},
+	}
+	cases := []struct {
+		name   string
+		events []*beppb.BuildEvent
+		want   *FetchResult
+	}{
+		{
+			name: "empty",
+			want: &FetchResult{
+				Invocation: &resultstorepb.Invocation{
+					Id:               &resultstorepb.Invocation_Id{InvocationId: "bep/inv.json"},
+					Timing:           &resultstorepb.Timing{},
+					StatusAttributes: statusAttributes(resultstorepb.Status_BUILDING),
+				},
+			},
+		},
+		{
+			name: "targets and tests",
+			events: []*beppb.BuildEvent{
+				{
+					Payload: &beppb.BuildEvent_Started{Started: &beppb.BuildStarted{
+						Uuid:            "inv",
+						StartTimeMillis: 100000,
+					}},
+				},
+				{
+					Payload: &beppb.BuildEvent_WorkspaceStatus{WorkspaceStatus: &beppb.WorkspaceStatus{
+						Item: []*beppb.WorkspaceStatus_Item{{Key: "BUILD_SCM_REVISION", Value: "abc"}},
+					}},
+				},
+				{
+					Payload: &beppb.BuildEvent_BuildMetadata{BuildMetadata: &beppb.BuildMetadata{
+						Metadata: map[string]string{"b": "2", "a": "1"},
+					}},
+				},
+				{
+					Id:      &beppb.BuildEventId{Id: &beppb.BuildEventId_TargetConfigured{TargetConfigured: &beppb.BuildEventId_TargetConfiguredId{Label: "//:lib"}}},
+					Payload: &beppb.BuildEvent_Configured{Configured: &beppb.TargetConfigured{Tag: []string{"manual"}}},
+				},
+				{
+					Id:      &beppb.BuildEventId{Id: &beppb.BuildEventId_TargetCompleted{TargetCompleted: &beppb.BuildEventId_TargetCompletedId{Label: "//:lib"}}},
+					Payload: &beppb.BuildEvent_Completed{Completed: &beppb.TargetComplete{Success: true}},
+				},
+				{
+					Id:      &beppb.BuildEventId{Id: &beppb.BuildEventId_TargetCompleted{TargetCompleted: &beppb.BuildEventId_TargetCompletedId{Label: "//:broken"}}},
+					Payload: &beppb.BuildEvent_Completed{Completed: &beppb.TargetComplete{}},
+				},
+				{
+					Id: &beppb.BuildEventId{Id: &beppb.BuildEventId_TestResult{TestResult: &beppb.BuildEventId_TestResultId{Label: "//:test", Attempt: 1}}},
+					Payload: &beppb.BuildEvent_TestResult{TestResult: &beppb.TestResult{
+						Status:                      beppb.TestStatus_FAILED,
+						TestAttemptStartMillisEpoch: 101000,
+						TestAttemptDurationMillis:   2000,
+						TestActionOutput:            []*beppb.File{{Name: testXML, File: &beppb.File_Uri{Uri: "gs://bucket/outputs/test.xml"}}},
+					}},
+				},
+				{
+					Id: &beppb.BuildEventId{Id: &beppb.BuildEventId_TestResult{TestResult: &beppb.BuildEventId_TestResultId{Label: "//:test", Attempt: 2}}},
+					Payload: &beppb.BuildEvent_TestResult{TestResult: &beppb.TestResult{
+						Status:              beppb.TestStatus_PASSED,
+						TestAttemptStart:    timestamppb.New(time.Unix(110, 0)),
+						TestAttemptDuration: durationpb.New(time.Second),
+						TestActionOutput: []*beppb.File{
+							{Name: "test.log", File: &beppb.File_Uri{Uri: "bytestream://somewhere/test.log"}},
+							{Name: testXML, File: &beppb.File_Uri{Uri: "gs://bucket/outputs/test.xml"}},
+						},
+					}},
+				},
+				{
+					Id: &beppb.BuildEventId{Id: &beppb.BuildEventId_TestResult{TestResult: &beppb.BuildEventId_TestResultId{Label: "//:test", Shard: 1, Attempt: 1}}},
+					Payload: &beppb.BuildEvent_TestResult{TestResult: &beppb.TestResult{
+						Status:           beppb.TestStatus_PASSED,
+						TestActionOutput: []*beppb.File{{Name: testXML, File: &beppb.File_Uri{Uri: "file:///tmp/test.xml"}}},
+					}},
+				},
+				{
+					Id:      &beppb.BuildEventId{Id: &beppb.BuildEventId_TestSummary{TestSummary: &beppb.BuildEventId_TestSummaryId{Label: "//:test"}}},
+					Payload: &beppb.BuildEvent_TestSummary{TestSummary: &beppb.TestSummary{OverallStatus: beppb.TestStatus_FLAKY}},
+				},
+				{
+					Payload: &beppb.BuildEvent_Finished{Finished: &beppb.BuildFinished{
+						ExitCode:         &beppb.BuildFinished_ExitCode{Name: "BUILD_FAILURE", Code: 1},
+						FinishTimeMillis: 160000,
+					}},
+				},
+			},
+			want: &FetchResult{
+				Invocation: &resultstorepb.Invocation{
+					Id: &resultstorepb.Invocation_Id{InvocationId: "inv"},
+					Timing: &resultstorepb.Timing{
+						StartTime: timestamppb.New(time.Unix(100, 0)),
+						Duration:  durationpb.New(time.Minute),
+					},
+					StatusAttributes: statusAttributes(resultstorepb.Status_FAILED),
+					Properties: []*resultstorepb.Property{
+						{Key: "BUILD_SCM_REVISION", Value: "abc"},
+						{Key: "a", Value: "1"},
+						{Key: "b", Value: "2"},
+					},
+				},
+				Targets: []*resultstorepb.Target{
+					{
+						Id:               &resultstorepb.Target_Id{InvocationId: "inv", TargetId: "//:lib"},
+						TargetAttributes: &resultstorepb.TargetAttributes{Tags: []string{"manual"}},
+					},
+					{Id: &resultstorepb.Target_Id{InvocationId: "inv", TargetId: "//:broken"}},
+					{Id: &resultstorepb.Target_Id{InvocationId: "inv", TargetId: "//:test"}},
+				},
+				ConfiguredTargets: []*resultstorepb.ConfiguredTarget{
+					{
+						Id:               &resultstorepb.ConfiguredTarget_Id{InvocationId: "inv", TargetId: "//:lib"},
+						StatusAttributes: statusAttributes(resultstorepb.Status_BUILT),
+					},
+					{
+						Id:               &resultstorepb.ConfiguredTarget_Id{InvocationId: "inv", TargetId: "//:broken"},
+						StatusAttributes: statusAttributes(resultstorepb.Status_FAILED_TO_BUILD),
+					},
+					{
+						Id:               &resultstorepb.ConfiguredTarget_Id{InvocationId: "inv", TargetId: "//:test"},
+						StatusAttributes: statusAttributes(resultstorepb.Status_FLAKY),
+					},
+				},
+				Actions: []*resultstorepb.Action{
+					{
+						Id: &resultstorepb.Action_Id{InvocationId: "inv", TargetId: "//:test", ActionId: "test_shard0_run0_attempt2"},
+						Timing: &resultstorepb.Timing{
+							StartTime: timestamppb.New(time.Unix(110, 0)),
+							Duration:  durationpb.New(time.Second),
+						},
+						ActionType: &resultstorepb.Action_TestAction{TestAction: &resultstorepb.TestAction{
+							TestSuite: &resultstorepb.TestSuite{
+								Tests: []*resultstorepb.Test{
+									{TestType: &resultstorepb.Test_TestSuite{TestSuite: &resultstorepb.TestSuite{
+										SuiteName: "suite",
+										Tests: []*resultstorepb.Test{
+											{TestType: &resultstorepb.Test_TestCase{TestCase: &resultstorepb.TestCase{
+												CaseName:  "bad",
+												ClassName: "pkg.Class",
+												Result:    resultstorepb.TestCase_COMPLETED,
+												Failures:  []*resultstorepb.TestFailure{{FailureMessage: "boom"}},
+											}}},
+											{TestType: &resultstorepb.Test_TestCase{TestCase: &resultstorepb.TestCase{
+												CaseName: "skip",
+												Result:   resultstorepb.TestCase_SKIPPED,
+											}}},
+										},
+									}}},
+								},
+							},
+						}},
+					},
+					{
+						Id:         &resultstorepb.Action_Id{InvocationId: "inv", TargetId: "//:test", ActionId: "test_shard1_run0_attempt1"},
+						ActionType: &resultstorepb.Action_TestAction{TestAction: &resultstorepb.TestAction{}},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := bepResult(context.Background(), logrus.WithField("case", tc.name), client, path, tc.events)
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("bepResult() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReadTestXML(t *testing.T) {
+	const xml = `<testsuite><testcase name="TestYes"/></testsuite>`
+	client := fake.Opener{
+		mustPath(t, "gs://bucket/outputs/test.xml"): {Data: xml},
+		mustPath(t, "s3://bucket/outputs/test.xml"): {Data: xml},
+		mustPath(t, "file:///tmp/test.xml"):         {Data: xml},
+	}
+	cases := []struct {
+		name    string
+		bep     string
+		uri     string
+		wantErr bool
+	}{
+		{
+			name: "gcs",
+			bep:  "gs://bucket/bep/inv.json",
+			uri:  "gs://bucket/outputs/test.xml",
+		},
+		{
+			name: "s3",
+			bep:  "s3://bucket/bep/inv.json",
+			uri:  "s3://bucket/outputs/test.xml",
+		},
+		{
+			name: "local",
+			bep:  "/tmp/bep/inv.json",
+			uri:  "file:///tmp/test.xml",
+		},
+		{
+			name:    "other storage",
+			bep:     "s3://bucket/bep/inv.json",
+			uri:     "gs://bucket/outputs/test.xml",
+			wantErr: true,
+		},
+		{
+			name:    "local file of remote invocation",
+			bep:     "gs://bucket/bep/inv.json",
+			uri:     "file:///tmp/test.xml",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := &beppb.File{Name: testXML, File: &beppb.File_Uri{Uri: tc.uri}}
+			got, err := readTestXML(context.Background(), client, mustPath(t, tc.bep), f)
+			switch {
+			case err != nil:
+				if !tc.wantErr {
+					t.Errorf("readTestXML() got unexpected error: %v", err)
+				}
+			case tc.wantErr:
+				t.Error("readTestXML() failed to return an error")
+			case len(got.GetTests()) != 1:
+				t.Errorf("readTestXML() got %d suites, want 1", len(got.GetTests()))
+			}
+		})
+	}
+}
+
+func bepColumn(name, build string, started, hint time.Time) *statepb.Column {
+	return &statepb.Column{
+		Name:    name,
+		Build:   build,
+		Started: float64(started.Unix() * 1000),
+		Hint:    hint.UTC().Format(time.RFC3339),
+	}
+}
+
+func TestBEPColumnReader(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	prefix := mustPath(t, "gs://bucket/bep/")
+	s3Prefix := mustPath(t, "s3://bucket/bep/")
+	const methods = `<testsuite><testcase name="TestYes"/><testcase name="TestNo"><failure message="nope"/></testcase></testsuite>`
+	client := fake.Client{
+		Lister: fake.Lister{
+			prefix: fake.Iterator{
+				Objects: []storage.ObjectAttrs{
+					{Name: "bep/a/inv-a.json", Updated: now},
+					{Name: "bep/b/inv-b.pb", Updated: now},
+					{Name: "bep/c/inv-c.json", Updated: now.Add(-72 * time.Hour)},
+					{Name: "bep/README.md", Updated: now},
+				},
+			},
+			s3Prefix: fake.Iterator{
+				Objects: []storage.ObjectAttrs{
+					{Name: "bep/s/inv-s.json", Updated: now},
+				},
+			},
+		},
+		Opener: fake.Opener{
+			mustPath(t, "gs://bucket/bep/a/inv-a.json"): {Data: jsonBEP(t, bepEvents("inv-a", now.Add(-2*time.Minute), "42", map[string]string{"//:a": methods}))},
+			mustPath(t, "gs://bucket/bep/b/inv-b.pb"):   {Data: binaryBEP(t, bepEvents("inv-b", now.Add(-time.Minute), "42", map[string]string{"//:b": methods}))},
+			mustPath(t, "s3://bucket/bep/s/inv-s.json"): {Data: jsonBEP(t, bepEvents("inv-s", now.Add(-time.Minute), "43", map[string]string{"//:s": methods}))},
+		},
+	}
+
+	cases := []struct {
+		name  string
+		group *configpb.TestGroup
+		want  []updater.InflatedColumn
+	}{
+		{
+			name: "column per invocation",
+			group: &configpb.TestGroup{
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_BepConfig{
+						BepConfig: &configpb.BEPConfig{GcsPrefix: "bucket/bep"},
+					},
+				},
+			},
+			want: []updater.InflatedColumn{
+				{
+					Column: bepColumn("inv-b", "inv-b", now.Add(-time.Minute), now.Add(-time.Minute)),
+					Cells: map[string]updater.Cell{
+						"//:b": {ID: "//:b", CellID: "inv-b", Result: statuspb.TestStatus_PASS},
+					},
+				},
+				{
+					Column: bepColumn("inv-a", "inv-a", now.Add(-2*time.Minute), now.Add(-2*time.Minute)),
+					Cells: map[string]updater.Cell{
+						"//:a": {ID: "//:a", CellID: "inv-a", Result: statuspb.TestStatus_PASS},
+					},
+				},
+			},
+		},
+		{
+			name: "s3 prefix",
+			group: &configpb.TestGroup{
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_BepConfig{
+						BepConfig: &configpb.BEPConfig{GcsPrefix: "s3://bucket/bep"},
+					},
+				},
+			},
+			want: []updater.InflatedColumn{
+				{
+					Column: bepColumn("inv-s", "inv-s", now.Add(-time.Minute), now.Add(-time.Minute)),
+					Cells: map[string]updater.Cell{
+						"//:s": {ID: "//:s", CellID: "inv-s", Result: statuspb.TestStatus_PASS},
+					},
+				},
+			},
+		},
+		{
+			name: "group by build and match test methods",
+			group: &configpb.TestGroup{
+				PrimaryGrouping:                 configpb.TestGroup_PRIMARY_GROUPING_BUILD,
+				BuildOverrideConfigurationValue: "build",
+				EnableTestMethods:               true,
+				TestMethodMatchRegex:            "Yes",
+				ResultSource: &configpb.TestGroup_ResultSource{
+					ResultSourceConfig: &configpb.TestGroup_ResultSource_BepConfig{
+						BepConfig: &configpb.BEPConfig{GcsPrefix: "bucket/bep/"},
+					},
+				},
+			},
+			want: []updater.InflatedColumn{
+				{
+					Column: bepColumn("42", "42", now.Add(-2*time.Minute), now.Add(-time.Minute)),
+					Cells: map[string]updater.Cell{
+						"//:a":                  {ID: "//:a", CellID: "inv-a", Result: statuspb.TestStatus_PASS},
+						"//:a@TESTGRID@TestYes": {ID: "//:a", CellID: "inv-a", Result: statuspb.TestStatus_PASS},
+						"//:b":                  {ID: "//:b", CellID: "inv-b", Result: statuspb.TestStatus_PASS},
+						"//:b@TESTGRID@TestYes": {ID: "//:b", CellID: "inv-b", Result: statuspb.TestStatus_PASS},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ch := make(chan updater.InflatedColumn)
+			var got []updater.InflatedColumn
+			done := make(chan struct{})
+			go func() {
+				for col := range ch {
+					got = append(got, col)
+				}
+				close(done)
+			}()
+			readColumns := BEPColumnReader(client, 0)
+			err := readColumns(context.Background(), logrus.WithField("case", tc.name), tc.group, nil, now.Add(-time.Hour), ch)
+			close(ch)
+			<-done
+			if err != nil {
+				t.Fatalf("BEPColumnReader() got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("BEPColumnReader() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
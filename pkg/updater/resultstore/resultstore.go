@@ -45,7 +45,9 @@ var _ updater.TargetResult = &singleActionResult{}
 type TestResultStatus int64
 
 // Updater returns a ResultStore-based GroupUpdater, which knows how to process result data stored in ResultStore.
-func Updater(resultStoreClient *DownloadClient, gcsClient gcs.Client, groupTimeout time.Duration, write bool) updater.GroupUpdater {
+//
+// Links the issues from gatherIssues to rows when non-nil.
+func Updater(resultStoreClient *DownloadClient, gcsClient gcs.Client, groupTimeout time.Duration, write bool, gatherIssues updater.IssueGatherer) updater.GroupUpdater {
 	return func(parent context.Context, log logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path) (bool, error) {
 		if !tg.UseKubernetesClient && (tg.ResultSource == nil || tg.ResultSource.GetGcsConfig() == nil) {
 			log.Debug("Skipping non-kubernetes client group")
@@ -59,7 +61,7 @@ func Updater(resultStoreClient *DownloadClient, gcsClient gcs.Client, groupTimeo
 		defer cancel()
 		columnReader := ColumnReader(resultStoreClient, 0)
 		reprocess := 20 * time.Minute // allow 20m for prow to finish uploading artifacts
-		return updater.InflateDropAppend(ctx, log, gcsClient, tg, gridPath, write, columnReader, reprocess)
+		return updater.InflateDropAppend(ctx, log, gcsClient, tg, gridPath, write, columnReader, reprocess, gatherIssues)
 	}
 }
 
@@ -162,20 +164,24 @@ func ColumnReader(client *DownloadClient, reprocess time.Duration) updater.Colum
 			}
 			results = append(results, result)
 		}
+		sendResults(log, tg, results, receivers)
+		return nil
+	}
+}
 
-		invocations := processRawResults(log, results)
+// sendResults groups the results into columns and sends them to receivers, most recent first.
+func sendResults(log logrus.FieldLogger, tg *configpb.TestGroup, results []*FetchResult, receivers chan<- updater.InflatedColumn) {
+	invocations := processRawResults(log, results)
 
-		// Reverse-sort invocations by start time.
-		sort.SliceStable(invocations, func(i, j int) bool {
-			return invocations[i].InvocationProto.GetTiming().GetStartTime().GetSeconds() > invocations[j].InvocationProto.GetTiming().GetStartTime().GetSeconds()
-		})
+	// Reverse-sort invocations by start time.
+	sort.SliceStable(invocations, func(i, j int) bool {
+		return invocations[i].InvocationProto.GetTiming().GetStartTime().GetSeconds() > invocations[j].InvocationProto.GetTiming().GetStartTime().GetSeconds()
+	})
 
-		groups := groupInvocations(log, tg, invocations)
-		for _, group := range groups {
-			inflatedCol := processGroup(tg, group)
-			receivers <- *inflatedCol
-		}
-		return nil
+	groups := groupInvocations(log, tg, invocations)
+	for _, group := range groups {
+		inflatedCol := processGroup(tg, group)
+		receivers <- *inflatedCol
 	}
 }
 
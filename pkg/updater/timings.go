@@ -0,0 +1,158 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+)
+
+// Names of the phases of an update cycle recorded in the grid's update_info.
+const (
+	PhaseInflate     = "inflate"
+	PhaseListBuilds  = "list_builds"
+	PhaseReadColumns = "read_columns"
+	PhaseConstruct   = "construct"
+	PhaseShrink      = "shrink"
+	PhaseUpload      = "upload"
+)
+
+// maxUpdateInfo limits how many updates of history the grid holds.
+const maxUpdateInfo = 10
+
+type phasesKey struct{}
+
+// phaseTimings accumulates the time spent in each phase of an update.
+type phaseTimings struct {
+	lock  sync.Mutex
+	names []string
+	times map[string]time.Duration
+}
+
+func withPhaseTimings(ctx context.Context, pt *phaseTimings) context.Context {
+	return context.WithValue(ctx, phasesKey{}, pt)
+}
+
+// RecordPhase adds dur to the time spent in the named phase of the current update.
+//
+// Does nothing unless ctx belongs to an update.
+func RecordPhase(ctx context.Context, name string, dur time.Duration) {
+	pt, ok := ctx.Value(phasesKey{}).(*phaseTimings)
+	if !ok || pt == nil {
+		return
+	}
+	pt.add(name, dur)
+}
+
+func (pt *phaseTimings) add(name string, dur time.Duration) {
+	pt.lock.Lock()
+	defer pt.lock.Unlock()
+	if pt.times == nil {
+		pt.times = map[string]time.Duration{}
+	}
+	if _, ok := pt.times[name]; !ok {
+		pt.names = append(pt.names, name)
+	}
+	pt.times[name] += dur
+}
+
+func (pt *phaseTimings) get(name string) time.Duration {
+	pt.lock.Lock()
+	defer pt.lock.Unlock()
+	return pt.times[name]
+}
+
+// info returns the recorded phases, in the order first recorded.
+func (pt *phaseTimings) info(when time.Time) *statepb.UpdateInfo {
+	pt.lock.Lock()
+	defer pt.lock.Unlock()
+	info := statepb.UpdateInfo{
+		UpdateTime: &timestamp.Timestamp{
+			Seconds: when.Unix(),
+			Nanos:   int32(when.Nanosecond()),
+		},
+	}
+	for _, name := range pt.names {
+		info.UpdatePhaseData = append(info.UpdatePhaseData, &statepb.UpdatePhaseData{
+			PhaseName:    name,
+			PhaseSeconds: pt.times[name].Seconds(),
+		})
+	}
+	return &info
+}
+
+// updateInfo returns the update history of the grid, starting with the update of ctx.
+//
+// The shrink phase covers the time since shrinkStart outside of grid construction.
+// Returns nil when ctx does not belong to an update.
+func updateInfo(ctx context.Context, shrinkStart time.Time, old *statepb.Grid) []*statepb.UpdateInfo {
+	pt, ok := ctx.Value(phasesKey{}).(*phaseTimings)
+	if !ok || pt == nil {
+		return nil
+	}
+	now := time.Now()
+	info := pt.info(now)
+	info.UpdatePhaseData = append(info.UpdatePhaseData, &statepb.UpdatePhaseData{
+		PhaseName:    PhaseShrink,
+		PhaseSeconds: (now.Sub(shrinkStart) - pt.get(PhaseConstruct)).Seconds(),
+	})
+	return appendUpdateInfo(info, old.GetUpdateInfo())
+}
+
+// recordUpload adds the upload phase to the most recent update in history.
+//
+// A grid cannot hold the time taken to upload itself, so the following update records it
+// as the time between the update and when the grid was written.
+// Does nothing when the phase is already recorded or the times are inconsistent.
+func recordUpload(history []*statepb.UpdateInfo, written time.Time) {
+	if len(history) == 0 || history[0].GetUpdateTime() == nil || written.IsZero() {
+		return
+	}
+	info := history[0]
+	for _, p := range info.UpdatePhaseData {
+		if p.PhaseName == PhaseUpload {
+			return
+		}
+	}
+	ts := info.GetUpdateTime()
+	dur := written.Sub(time.Unix(ts.Seconds, int64(ts.Nanos)))
+	if dur < 0 {
+		return
+	}
+	info.UpdatePhaseData = append(info.UpdatePhaseData, &statepb.UpdatePhaseData{
+		PhaseName:    PhaseUpload,
+		PhaseSeconds: dur.Seconds(),
+	})
+}
+
+// appendUpdateInfo returns the history with info first, capped at maxUpdateInfo entries.
+func appendUpdateInfo(info *statepb.UpdateInfo, history []*statepb.UpdateInfo) []*statepb.UpdateInfo {
+	out := make([]*statepb.UpdateInfo, 0, maxUpdateInfo)
+	out = append(out, info)
+	for _, h := range history {
+		if len(out) == maxUpdateInfo {
+			break
+		}
+		out = append(out, h)
+	}
+	return out
+}
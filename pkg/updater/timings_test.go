@@ -0,0 +1,197 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package updater
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	statepb "github.com/GoogleCloudPlatform/testgrid/pb/state"
+)
+
+func TestRecordPhase(t *testing.T) {
+	RecordPhase(context.Background(), PhaseInflate, time.Second) // ignored
+
+	var pt phaseTimings
+	ctx := withPhaseTimings(context.Background(), &pt)
+	RecordPhase(ctx, PhaseReadColumns, time.Second)
+	RecordPhase(ctx, PhaseInflate, 2*time.Second)
+	RecordPhase(ctx, PhaseReadColumns, 3*time.Second)
+
+	when := time.Unix(1000, 500)
+	want := &statepb.UpdateInfo{
+		UpdatePhaseData: []*statepb.UpdatePhaseData{
+			{PhaseName: PhaseReadColumns, PhaseSeconds: 4},
+			{PhaseName: PhaseInflate, PhaseSeconds: 2},
+		},
+		UpdateTime: &timestamp.Timestamp{Seconds: 1000, Nanos: 500},
+	}
+	if diff := cmp.Diff(want, pt.info(when), protocmp.Transform()); diff != "" {
+		t.Errorf("RecordPhase() got unexpected diff (-want +got):\n%s", diff)
+	}
+}
+
+func TestUpdateInfo(t *testing.T) {
+	if got := updateInfo(context.Background(), time.Now(), nil); got != nil {
+		t.Errorf("updateInfo() got %v for a context without an update, want nil", got)
+	}
+
+	var pt phaseTimings
+	pt.add(PhaseInflate, time.Second)
+	pt.add(PhaseConstruct, time.Hour)
+	ctx := withPhaseTimings(context.Background(), &pt)
+	old := &statepb.Grid{
+		UpdateInfo: []*statepb.UpdateInfo{{UpdateTime: &timestamp.Timestamp{Seconds: 1}}},
+	}
+	got := updateInfo(ctx, time.Now().Add(-90*time.Minute), old)
+	if len(got) != 2 {
+		t.Fatalf("updateInfo() got %d entries, want 2", len(got))
+	}
+	if diff := cmp.Diff(old.UpdateInfo[0], got[1], protocmp.Transform()); diff != "" {
+		t.Errorf("updateInfo() got unexpected history (-want +got):\n%s", diff)
+	}
+	var names []string
+	for _, p := range got[0].UpdatePhaseData {
+		names = append(names, p.PhaseName)
+		if p.PhaseName == PhaseShrink && (p.PhaseSeconds < 30*60 || p.PhaseSeconds > 31*60) {
+			t.Errorf("updateInfo() got %f shrink seconds, want the 30m outside of construct", p.PhaseSeconds)
+		}
+	}
+	if diff := cmp.Diff([]string{PhaseInflate, PhaseConstruct, PhaseShrink}, names); diff != "" {
+		t.Errorf("updateInfo() got unexpected phases (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecordUpload(t *testing.T) {
+	phase := func(name string, secs float64) *statepb.UpdatePhaseData {
+		return &statepb.UpdatePhaseData{PhaseName: name, PhaseSeconds: secs}
+	}
+	when := &timestamp.Timestamp{Seconds: 1000}
+	cases := []struct {
+		name    string
+		history []*statepb.UpdateInfo
+		written time.Time
+		want    []*statepb.UpdateInfo
+	}{
+		{
+			name:    "empty",
+			written: time.Unix(1000, 0),
+		},
+		{
+			name: "record",
+			history: []*statepb.UpdateInfo{
+				{
+					UpdatePhaseData: []*statepb.UpdatePhaseData{phase(PhaseShrink, 1)},
+					UpdateTime:      when,
+				},
+				{UpdateTime: &timestamp.Timestamp{Seconds: 500}},
+			},
+			written: time.Unix(1002, 500*int64(time.Millisecond)),
+			want: []*statepb.UpdateInfo{
+				{
+					UpdatePhaseData: []*statepb.UpdatePhaseData{phase(PhaseShrink, 1), phase(PhaseUpload, 2.5)},
+					UpdateTime:      when,
+				},
+				{UpdateTime: &timestamp.Timestamp{Seconds: 500}},
+			},
+		},
+		{
+			name: "already recorded",
+			history: []*statepb.UpdateInfo{
+				{
+					UpdatePhaseData: []*statepb.UpdatePhaseData{phase(PhaseUpload, 7)},
+					UpdateTime:      when,
+				},
+			},
+			written: time.Unix(1002, 0),
+			want: []*statepb.UpdateInfo{
+				{
+					UpdatePhaseData: []*statepb.UpdatePhaseData{phase(PhaseUpload, 7)},
+					UpdateTime:      when,
+				},
+			},
+		},
+		{
+			name:    "written before update",
+			history: []*statepb.UpdateInfo{{UpdateTime: when}},
+			written: time.Unix(999, 0),
+			want:    []*statepb.UpdateInfo{{UpdateTime: when}},
+		},
+		{
+			name:    "unknown write time",
+			history: []*statepb.UpdateInfo{{UpdateTime: when}},
+			want:    []*statepb.UpdateInfo{{UpdateTime: when}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			recordUpload(tc.history, tc.written)
+			if diff := cmp.Diff(tc.want, tc.history, protocmp.Transform()); diff != "" {
+				t.Errorf("recordUpload() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAppendUpdateInfo(t *testing.T) {
+	info := func(n int) *statepb.UpdateInfo {
+		return &statepb.UpdateInfo{
+			UpdatePhaseData: []*statepb.UpdatePhaseData{{PhaseName: fmt.Sprint(n)}},
+		}
+	}
+	var history []*statepb.UpdateInfo
+	for i := 1; i <= maxUpdateInfo; i++ {
+		history = append(history, info(i))
+	}
+
+	cases := []struct {
+		name    string
+		history []*statepb.UpdateInfo
+		want    []*statepb.UpdateInfo
+	}{
+		{
+			name: "empty",
+			want: []*statepb.UpdateInfo{info(0)},
+		},
+		{
+			name:    "prepend",
+			history: history[:2],
+			want:    []*statepb.UpdateInfo{info(0), info(1), info(2)},
+		},
+		{
+			name:    "bounded",
+			history: history,
+			want:    append([]*statepb.UpdateInfo{info(0)}, history[:maxUpdateInfo-1]...),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := appendUpdateInfo(info(0), tc.history)
+			if diff := cmp.Diff(tc.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("appendUpdateInfo() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
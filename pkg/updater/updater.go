@@ -88,14 +88,10 @@ func (mets *Metrics) start() *metrics.CycleReporter {
 type GroupUpdater func(parent context.Context, log logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path) (bool, error)
 
 // GCS returns a GCS-based GroupUpdater, which knows how to process result data stored in GCS.
-func GCS(poolCtx context.Context, colClient gcs.Client, groupTimeout, buildTimeout time.Duration, concurrency int, write bool, enableIgnoreSkip bool) GroupUpdater {
-	var readResult *resultReader
-	if poolCtx == nil {
-		// TODO(fejta): remove check soon
-		panic("Context must be non-nil")
-	}
-	readResult = resultReaderPool(poolCtx, logrus.WithField("pool", "readResult"), concurrency)
-
+//
+// Links the issues from gatherIssues to rows when non-nil.
+func GCS(poolCtx context.Context, colClient gcs.Client, groupTimeout, buildTimeout time.Duration, concurrency int, write bool, enableIgnoreSkip bool, gatherIssues IssueGatherer) GroupUpdater {
+	gcsColReader := GCSColumnReader(poolCtx, colClient, buildTimeout, concurrency, enableIgnoreSkip)
 	return func(parent context.Context, log logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path) (bool, error) {
 		if !tg.UseKubernetesClient && (tg.ResultSource == nil || tg.ResultSource.GetGcsConfig() == nil) {
 			log.Debug("Skipping non-kubernetes client group")
@@ -103,10 +99,19 @@ func GCS(poolCtx context.Context, colClient gcs.Client, groupTimeout, buildTimeo
 		}
 		ctx, cancel := context.WithTimeout(parent, groupTimeout)
 		defer cancel()
-		gcsColReader := gcsColumnReader(colClient, buildTimeout, readResult, enableIgnoreSkip)
 		reprocess := 20 * time.Minute // allow 20m for prow to finish uploading artifacts
-		return InflateDropAppend(ctx, log, client, tg, gridPath, write, gcsColReader, reprocess)
+		return InflateDropAppend(ctx, log, client, tg, gridPath, write, gcsColReader, reprocess, gatherIssues)
+	}
+}
+
+// GCSColumnReader returns a ColumnReader for result data stored in GCS, reading up to concurrency builds at a time.
+func GCSColumnReader(poolCtx context.Context, colClient gcs.Client, buildTimeout time.Duration, concurrency int, enableIgnoreSkip bool) ColumnReader {
+	if poolCtx == nil {
+		// TODO(fejta): remove check soon
+		panic("Context must be non-nil")
 	}
+	readResult := resultReaderPool(poolCtx, logrus.WithField("pool", "readResult"), concurrency)
+	return gcsColumnReader(colClient, buildTimeout, readResult, enableIgnoreSkip)
 }
 
 func gridPaths(configPath gcs.Path, gridPrefix string, groups []*configpb.TestGroup) ([]gcs.Path, error) {
@@ -245,6 +250,14 @@ type UpdateOptions struct {
 	GroupNames       []string
 	Write            bool
 	Freq             time.Duration
+
+	// LeasePrefix divides groups between replicas when set, by requiring a
+	// replica to hold the group's lease object under this prefix to update it.
+	LeasePrefix string
+	// LeaseHolder identifies this replica, defaulting to its hostname and pid.
+	LeaseHolder string
+	// LeaseDuration is how long a lease lasts without a heartbeat, defaulting to DefaultLeaseDuration.
+	LeaseDuration time.Duration
 }
 
 // Update test groups with the specified freq.
@@ -253,6 +266,10 @@ type UpdateOptions struct {
 //
 // Filters down to a single group when set.
 // Returns after all groups updated once if freq is zero.
+//
+// Replicas sharing a LeasePrefix skip groups another replica is updating or
+// next updates, until its lease expires, and take over the groups of replicas
+// that stop renewing their leases.
 func Update(parent context.Context, client gcs.ConditionalClient, mets *Metrics, updateGroup GroupUpdater, opts *UpdateOptions, fixers ...Fixer) error {
 	ctx, cancel := context.WithCancel(parent)
 	defer cancel()
@@ -360,6 +377,12 @@ func Update(parent context.Context, client gcs.ConditionalClient, mets *Metrics,
 		}
 	}()
 
+	var leases *leaser
+	if opts.LeasePrefix != "" && opts.Write {
+		leases = newLeaser(client, opts.LeaseHolder, opts.LeaseDuration)
+		log.WithField("holder", leases.holder).Info("Dividing groups between replicas with leases")
+	}
+
 	active := map[string]bool{}
 	var lock sync.RWMutex
 	var wg sync.WaitGroup
@@ -398,6 +421,43 @@ func Update(parent context.Context, client gcs.ConditionalClient, mets *Metrics,
 			active[name] = false
 			lock.Unlock()
 		}()
+		ctx := ctx
+		var next time.Time // When this replica next updates the group.
+		if leases != nil {
+			lp, err := TestGroupPath(opts.ConfigPath, opts.LeasePrefix, name)
+			if err != nil {
+				fin.Fail()
+				log.WithError(err).Error("Bad lease path")
+				return
+			}
+			ls, err := leases.acquire(ctx, *lp)
+			if errors.Is(err, errLeaseHeld) {
+				fin.Skip()
+				var held leaseHeldError
+				if !errors.As(err, &held) {
+					held.expires = time.Now().Add(leases.duration)
+				}
+				log.WithField("expires", held.expires).Debug("Another replica is updating...")
+				if opts.Freq > 0 {
+					q.Fix(name, held.expires, true)
+				}
+				return
+			}
+			if err != nil {
+				fin.Fail()
+				log.WithError(err).Error("Failed to acquire lease")
+				return
+			}
+			var release func(time.Time)
+			ctx, release = leases.hold(ctx, log, ls)
+			defer func() {
+				if notified(tg) {
+					// Release now so whichever replica receives the next notification can update the group.
+					next = time.Time{}
+				}
+				release(next)
+			}()
+		}
 		start := time.Now()
 		unprocessed, err := updateGroup(ctx, log, client, tg, *tgp)
 		log.WithField("duration", time.Since(start)).Info("Finished processing group.")
@@ -414,11 +474,15 @@ func Update(parent context.Context, client gcs.ConditionalClient, mets *Metrics,
 			if opts.Freq > 0 {
 				delay = opts.Freq/4 + time.Duration(rand.Int63n(int64(opts.Freq/4))) // Int63n() panics if freq <= 0
 				log = log.WithField("delay", delay.Seconds())
-				q.Fix(tg.Name, time.Now().Add(delay), true)
+				next = time.Now().Add(delay)
+				q.Fix(tg.Name, next, true)
 			}
 			return
 		}
 		fin.Success()
+		if opts.Freq > 0 {
+			next = time.Now().Add(opts.Freq)
+		}
 		if unprocessed { // process another chunk ASAP
 			q.Fix(name, time.Now(), false)
 		}
@@ -461,6 +525,9 @@ func gcsPrefix(tg *configpb.TestGroup) string {
 	if gcsCfg := tg.ResultSource.GetGcsConfig(); gcsCfg != nil {
 		return gcsCfg.GcsPrefix
 	}
+	if ingestCfg := tg.ResultSource.GetIngestConfig(); ingestCfg != nil {
+		return ingestCfg.GcsPrefix
+	}
 	return tg.GcsPrefix
 }
 
@@ -472,7 +539,10 @@ func groupPaths(tg *configpb.TestGroup) ([]gcs.Path, error) {
 		if prefix == "" {
 			continue
 		}
-		u, err := url.Parse("gs://" + prefix)
+		if !strings.Contains(prefix, "://") {
+			prefix = "gs://" + prefix
+		}
+		u, err := url.Parse(prefix)
 		if err != nil {
 			return nil, fmt.Errorf("parse: %w", err)
 		}
@@ -567,10 +637,15 @@ func SortStarted(cols []InflatedColumn) {
 const byteCeiling = 2e6 // 2 megabytes
 
 // InflateDropAppend updates groups by downloading the existing grid, dropping old rows and appending new ones.
-func InflateDropAppend(ctx context.Context, alog logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path, write bool, readCols ColumnReader, reprocess time.Duration) (bool, error) {
+//
+// Links the issues from gatherIssues when non-nil and the group gathers them,
+// otherwise issues from the existing grid carry forward.
+func InflateDropAppend(ctx context.Context, alog logrus.FieldLogger, client gcs.Client, tg *configpb.TestGroup, gridPath gcs.Path, write bool, readCols ColumnReader, reprocess time.Duration, gatherIssues IssueGatherer) (bool, error) {
 	log := alog.(logrus.Ext1FieldLogger) // Add trace method
 	ctx, cancel := context.WithCancel(ctx)
 	defer cancel()
+	var phases phaseTimings
+	ctx = withPhaseTimings(ctx, &phases)
 
 	// Grace period to read additional column.
 	var grace context.Context
@@ -592,6 +667,7 @@ func InflateDropAppend(ctx context.Context, alog logrus.FieldLogger, client gcs.
 	} else {
 		shrinkGrace = context.Background()
 	}
+	shrinkGrace = withPhaseTimings(shrinkGrace, &phases)
 
 	var dur time.Duration
 	if tg.DaysOfResults > 0 {
@@ -611,6 +687,9 @@ func InflateDropAppend(ctx context.Context, alog logrus.FieldLogger, client gcs.
 	if err != nil {
 		log.WithField("path", gridPath).WithError(err).Error("Failed to download existing grid")
 	}
+	if old != nil && attrs != nil {
+		recordUpload(old.UpdateInfo, attrs.LastModified)
+	}
 	inflateStart := time.Now()
 	if old != nil {
 		var cols []InflatedColumn
@@ -629,6 +708,7 @@ func InflateDropAppend(ctx context.Context, alog logrus.FieldLogger, client gcs.
 		oldCols = truncateRunning(cols, floor)
 	}
 	inflateDur := time.Since(inflateStart)
+	phases.add(PhaseInflate, inflateDur)
 	readColsStart := time.Now()
 	var cols []InflatedColumn
 	var unreadColumns bool
@@ -709,14 +789,25 @@ func InflateDropAppend(ctx context.Context, alog logrus.FieldLogger, client gcs.
 		cols = groupColumns(tg, cols)
 	}
 	readColsDur := time.Since(readColsStart)
+	phases.add(PhaseReadColumns, readColsDur-phases.get(PhaseListBuilds))
 
 	SortStarted(cols)
 
+	if gatherIssues != nil {
+		log.Trace("Gathering issues...")
+		gathered, err := gatherIssues(ctx, log, tg, cols)
+		if err != nil {
+			log.WithError(err).Warning("Failed to gather issues")
+		} else {
+			issues = updateIssues(issues, gathered)
+		}
+	}
+
 	shrinkStart := time.Now()
 	cols = truncateGrid(cols, byteCeiling) // Assume each cell is at least 1 byte
 	var grid *statepb.Grid
 	var buf []byte
-	grid, buf, err = shrinkGridInline(shrinkGrace, log, tg, cols, issues, byteCeiling)
+	grid, buf, err = shrinkGridInline(shrinkGrace, log, tg, cols, issues, byteCeiling, old)
 	if err != nil {
 		return false, fmt.Errorf("shrink grid inline: %v", err)
 	}
@@ -729,10 +820,12 @@ func InflateDropAppend(ctx context.Context, alog logrus.FieldLogger, client gcs.
 		log = log.WithField("dryrun", true)
 	} else {
 		log.Debug("Writing grid...")
+		uploadStart := time.Now()
 		// TODO(fejta): configurable cache value
 		if _, err := client.Upload(ctx, gridPath, buf, gcs.DefaultACL, gcs.NoCache); err != nil {
 			return false, fmt.Errorf("upload %d bytes: %w", len(buf), err)
 		}
+		log = log.WithField("upload", time.Since(uploadStart))
 	}
 	if unreadColumns {
 		log = log.WithField("more", true)
@@ -782,16 +875,50 @@ func reprocessColumn(log logrus.FieldLogger, old *statepb.Grid, currentCfg *conf
 	}
 }
 
-func shrinkGridInline(ctx context.Context, log logrus.FieldLogger, tg *configpb.TestGroup, cols []InflatedColumn, issues map[string][]string, byteCeiling int) (*statepb.Grid, []byte, error) {
+// shrinkGridInline constructs a grid from cols, dropping old columns until it fits within byteCeiling.
+//
+// Clusters failures of the final grid when the group is configured to, carrying forward those of the old grid.
+// Also records the timings of the update in ctx (if any) ahead of the old grid's update history.
+func shrinkGridInline(ctx context.Context, log logrus.FieldLogger, tg *configpb.TestGroup, cols []InflatedColumn, issues map[string][]string, byteCeiling int, old *statepb.Grid) (*statepb.Grid, []byte, error) {
+	start := time.Now()
+	construct := func(cols []InflatedColumn, issues map[string][]string) *statepb.Grid {
+		constructStart := time.Now()
+		grid := constructGridFromGroupConfig(log, tg, cols, issues)
+		RecordPhase(ctx, PhaseConstruct, time.Since(constructStart))
+		grid.UpdateInfo = updateInfo(ctx, start, old)
+		return grid
+	}
+	// Clustering is expensive, so only cluster the grid we keep rather than each attempt.
+	// Drops the clusters when they would push the grid over the ceiling.
+	finish := func(grid *statepb.Grid, buf []byte) (*statepb.Grid, []byte, error) {
+		if !tg.GetClusterFailures() {
+			return grid, buf, nil
+		}
+		ClusterGrid(old, grid)
+		clustered, err := gcs.MarshalGrid(grid)
+		if err != nil {
+			return nil, nil, fmt.Errorf("marshal grid: %w", err)
+		}
+		if byteCeiling > 0 && len(clustered) >= byteCeiling {
+			log.WithFields(logrus.Fields{
+				"size":      len(buf),
+				"clustered": len(clustered),
+			}).Warning("Dropping failure clusters that exceed the size limit")
+			grid.Cluster = nil
+			grid.MostRecentClusterTimestamp = 0
+			return grid, buf, nil
+		}
+		return grid, clustered, nil
+	}
 	// Hopefully the grid is small enough...
-	grid := constructGridFromGroupConfig(log, tg, cols, issues)
+	grid := construct(cols, issues)
 	buf, err := gcs.MarshalGrid(grid)
 	if err != nil {
 		return nil, nil, fmt.Errorf("marshal grid: %w", err)
 	}
 	orig := len(buf)
 	if byteCeiling == 0 || orig < byteCeiling {
-		return grid, buf, nil
+		return finish(grid, buf)
 	}
 
 	// Nope, let's drop old data...
@@ -802,7 +929,7 @@ func shrinkGridInline(ctx context.Context, log logrus.FieldLogger, tg *configpb.
 		select {
 		case <-ctx.Done():
 			log.WithField("size", len(buf)).Info("Timeout shrinking row data")
-			return grid, buf, nil
+			return finish(grid, buf)
 		default:
 		}
 
@@ -811,7 +938,7 @@ func shrinkGridInline(ctx context.Context, log logrus.FieldLogger, tg *configpb.
 		// shrink cols to half and cap
 		truncateLastColumn(cols[0:i], orig, byteCeiling, "byte")
 
-		grid = constructGridFromGroupConfig(log, tg, cols[0:i], issues)
+		grid = construct(cols[0:i], issues)
 		buf, err = gcs.MarshalGrid(grid)
 		if err != nil {
 			return nil, nil, fmt.Errorf("marshal grid: %w", err)
@@ -819,16 +946,19 @@ func shrinkGridInline(ctx context.Context, log logrus.FieldLogger, tg *configpb.
 
 		if len(buf) < newCeiling {
 			log.WithField("size", len(buf)).Info("Shrunk row data")
-			return grid, buf, nil
+			return finish(grid, buf)
 		}
 
 	}
 
 	// One column isn't small enough. Return a single-cell grid.
-	grid = constructGridFromGroupConfig(log, tg, deletedColumn(cols[0]), nil)
+	grid = construct(deletedColumn(cols[0]), nil)
 	buf, err = gcs.MarshalGrid(grid)
+	if err != nil {
+		return nil, nil, fmt.Errorf("marshal grid: %w", err)
+	}
 	log.WithField("size", len(buf)).Info("Shrunk to minimum; storing metadata only")
-	return grid, buf, err
+	return finish(grid, buf)
 }
 
 // Legacy row name to report data truncation
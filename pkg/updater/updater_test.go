@@ -95,7 +95,7 @@ func TestGCS(t *testing.T) {
 					}
 				}
 			}()
-			updater := GCS(tc.ctx, nil, 0, 0, 0, false, false)
+			updater := GCS(tc.ctx, nil, 0, 0, 0, false, false, nil)
 			_, err := updater(ctx, logrus.WithField("case", tc.name), nil, tc.group, gcs.Path{})
 			switch {
 			case err != nil:
@@ -422,10 +422,11 @@ func TestUpdate(t *testing.T) {
 				client.Lister[buildsPath] = fi
 			}
 
-			if tc.groupUpdater == nil {
+			inflate := tc.groupUpdater == nil
+			if inflate {
 				poolCtx, poolCancel := context.WithCancel(context.Background())
 				defer poolCancel()
-				tc.groupUpdater = GCS(poolCtx, client, *tc.groupTimeout, *tc.buildTimeout, tc.buildConcurrency, !tc.skipConfirm, false)
+				tc.groupUpdater = GCS(poolCtx, client, *tc.groupTimeout, *tc.buildTimeout, tc.buildConcurrency, !tc.skipConfirm, false, nil)
 			}
 			opts := &UpdateOptions{
 				ConfigPath:       configPath,
@@ -451,6 +452,9 @@ func TestUpdate(t *testing.T) {
 				t.Error("Update() failed to receive an error")
 			default:
 				actual := client.Uploader
+				if inflate {
+					stripUpdateInfo(t, actual)
+				}
 				if diff := cmp.Diff(tc.expected, actual, cmp.AllowUnexported(fakeUpload{})); diff != "" {
 					t.Errorf("Update() uploaded files got unexpected diff (-want, +got):\n%s", diff)
 				}
@@ -555,6 +559,27 @@ func jsonPodInfo(podInfo gcs.PodInfo) *fakeObject {
 	return &fakeObject{Data: jsonData(podInfo)}
 }
 
+// stripUpdateInfo clears the timing-dependent update info of each uploaded grid.
+//
+// Returns the number of grids without any update info.
+func stripUpdateInfo(t *testing.T, uploader fakeUploader) int {
+	t.Helper()
+	var missing int
+	for path, up := range uploader {
+		grid, _, err := gcs.DownloadGrid(context.Background(), fakeOpener{path: {Data: string(up.Buf)}}, path)
+		if err != nil {
+			t.Fatalf("gcs.DownloadGrid(%s) got unexpected error: %v", path, err)
+		}
+		if len(grid.UpdateInfo) == 0 {
+			missing++
+		}
+		grid.UpdateInfo = nil
+		up.Buf = mustGrid(grid)
+		uploader[path] = up
+	}
+	return missing
+}
+
 func mustGrid(grid *statepb.Grid) []byte {
 	buf, err := gcs.MarshalGrid(grid)
 	if err != nil {
@@ -2059,6 +2084,7 @@ func TestInflateDropAppend(t *testing.T) {
 				!tc.skipWrite,
 				colReader,
 				tc.reprocess,
+				nil,
 			)
 			switch {
 			case err != nil:
@@ -2073,6 +2099,9 @@ func TestInflateDropAppend(t *testing.T) {
 					expected[uploadPath] = *tc.expected
 				}
 				actual := client.Uploader
+				if missing := stripUpdateInfo(t, actual); missing > 0 {
+					t.Errorf("InflateDropAppend() wrote %d grids without update info", missing)
+				}
 				diff := cmp.Diff(expected, actual, cmp.AllowUnexported(gcs.Path{}, fakeUpload{}), protocmp.Transform())
 				if diff == "" {
 					return
@@ -2300,6 +2329,27 @@ func TestReprocessColumn(t *testing.T) {
 	}
 }
 
+// distinctFailures returns a column where each of n rows fails with a different message.
+func distinctFailures(n int) []InflatedColumn {
+	cells := map[string]Cell{}
+	for i := 0; i < n; i++ {
+		cells[fmt.Sprintf("cell-%d", i)] = Cell{
+			Result:  statuspb.TestStatus_FAIL,
+			Message: fmt.Sprintf("failure %d", i),
+		}
+	}
+	return []InflatedColumn{
+		{
+			Column: &statepb.Column{
+				Name:    "hi",
+				Build:   "there",
+				Started: 1000,
+			},
+			Cells: cells,
+		},
+	}
+}
+
 func Test_ShrinkGridInline(t *testing.T) {
 	cases := []struct {
 		name    string
@@ -2428,6 +2478,119 @@ func Test_ShrinkGridInline(t *testing.T) {
 				return constructGridFromGroupConfig(logger, tg, expect, issues)
 			},
 		},
+		{
+			name: "cluster truncated grid",
+			tg:   &configpb.TestGroup{ClusterFailures: true},
+			cols: []InflatedColumn{
+				{
+					Column: &statepb.Column{
+						Name:  "hi",
+						Build: "there",
+					},
+					Cells: map[string]Cell{
+						"cell": {
+							Result:  statuspb.TestStatus_FAIL,
+							Message: "yo",
+						},
+					},
+				},
+				{
+					Column: &statepb.Column{
+						Name:  "two-name",
+						Build: "two-build",
+					},
+					Cells: map[string]Cell{
+						"cell": {
+							Result:  statuspb.TestStatus_FAIL,
+							Message: "yo",
+						},
+					},
+				},
+				{
+					Column: &statepb.Column{
+						Name:  "three-name",
+						Build: "three-build",
+					},
+					Cells: func() map[string]Cell {
+						cells := map[string]Cell{}
+
+						for i := 0; i < 1000; i++ {
+							cells[fmt.Sprintf("cell-%d", i)] = Cell{
+								Result:  statuspb.TestStatus_FAIL,
+								Message: "yo",
+							}
+						}
+						return cells
+					}(),
+				},
+				{
+					Column: &statepb.Column{
+						Name:  "four-name",
+						Build: "four-build",
+					},
+					Cells: map[string]Cell{
+						"cell": {
+							Result:  statuspb.TestStatus_FAIL,
+							Message: "yo",
+						},
+					},
+				},
+			},
+			ceiling: 1000,
+			want: func(tg *configpb.TestGroup, cols []InflatedColumn, issues map[string][]string) *statepb.Grid {
+				expect := []InflatedColumn{
+					{
+						Column: &statepb.Column{
+							Name:  "hi",
+							Build: "there",
+						},
+						Cells: map[string]Cell{
+							"cell": {
+								Result:  statuspb.TestStatus_FAIL,
+								Message: "yo",
+							},
+						},
+					},
+					{
+						Column: &statepb.Column{
+							Name:  "two-name",
+							Build: "two-build",
+						},
+						Cells: map[string]Cell{
+							"cell": {
+								Result:  statuspb.TestStatus_FAIL,
+								Message: "yo",
+							},
+						},
+					},
+				}
+				logger := logrus.New()
+				grid := constructGridFromGroupConfig(logger, tg, cols, issues)
+				buf, _ := gcs.MarshalGrid(grid)
+				orig := len(buf)
+
+				truncateLastColumn(expect, orig, 1000, "byte")
+
+				// Only the columns that are kept are clustered.
+				grid = constructGridFromGroupConfig(logger, tg, expect, issues)
+				ClusterGrid(nil, grid)
+				return grid
+			},
+		},
+		{
+			name: "drop clusters over the ceiling",
+			tg:   &configpb.TestGroup{ClusterFailures: true},
+			cols: distinctFailures(50),
+			// Fits without clusters.
+			ceiling: func() int {
+				grid := constructGridFromGroupConfig(logrus.New(), &configpb.TestGroup{}, distinctFailures(50), nil)
+				buf, _ := gcs.MarshalGrid(grid)
+				return len(buf) + 1
+			}(),
+			want: func(tg *configpb.TestGroup, cols []InflatedColumn, issues map[string][]string) *statepb.Grid {
+				return constructGridFromGroupConfig(logrus.New(), tg, cols, issues)
+			},
+		},
 		{
 			name: "truncate sparse column data",
 			tg:   &configpb.TestGroup{},
@@ -2662,7 +2825,7 @@ func Test_ShrinkGridInline(t *testing.T) {
 				tc.ctx = context.Background()
 			}
 			want := tc.want(tc.tg, tc.cols, tc.issues)
-			got, buf, err := shrinkGridInline(tc.ctx, logrus.WithField("name", tc.name), tc.tg, tc.cols, tc.issues, tc.ceiling)
+			got, buf, err := shrinkGridInline(tc.ctx, logrus.WithField("name", tc.name), tc.tg, tc.cols, tc.issues, tc.ceiling, nil)
 			switch {
 			case err != nil:
 				if !tc.err {
@@ -5361,3 +5524,57 @@ func TestTruncateLastColumn(t *testing.T) {
 		})
 	}
 }
+
+func TestGroupPaths(t *testing.T) {
+	cases := []struct {
+		name   string
+		prefix string
+		want   []gcs.Path
+		err    bool
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name:   "gcs",
+			prefix: "bucket/path/to/job",
+			want:   []gcs.Path{newPathOrDie("gs://bucket/path/to/job/")},
+		},
+		{
+			name:   "several",
+			prefix: "bucket/job, other-bucket/another-job/",
+			want: []gcs.Path{
+				newPathOrDie("gs://bucket/job/"),
+				newPathOrDie("gs://other-bucket/another-job/"),
+			},
+		},
+		{
+			name:   "s3",
+			prefix: "s3://bucket/path/to/job,bucket/job",
+			want: []gcs.Path{
+				newPathOrDie("s3://bucket/path/to/job/"),
+				newPathOrDie("gs://bucket/job/"),
+			},
+		},
+		{
+			name:   "reject unknown schemes",
+			prefix: "http://example.com/job",
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := groupPaths(&configpb.TestGroup{GcsPrefix: tc.prefix})
+			switch {
+			case err != nil && !tc.err:
+				t.Fatalf("groupPaths() got unexpected error: %v", err)
+			case err == nil && tc.err:
+				t.Fatal("groupPaths() failed to return an error")
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(gcs.Path{})); diff != "" {
+				t.Errorf("groupPaths() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -77,28 +77,38 @@ type ConditionalClient interface {
 
 type gcsClient struct {
 	gcs   *realGCSClient
+	s3    *s3Client
 	local *localClient
 }
 
-// NewClient returns a flexible (local or GCS) storage client.
+// NewClient returns a flexible (local, GCS or S3) storage client.
+//
+// Configures S3 from the standard AWS environment variables, see S3OptionsFromEnv.
 func NewClient(client *storage.Client) ConditionalClient {
 	return gcsClient{
 		gcs:   &realGCSClient{client, nil, nil},
+		s3:    newS3Client(S3OptionsFromEnv()),
 		local: &localClient{nil, nil},
 	}
 }
 
-// If returns a flexible (local or GCS) conditional client.
+// If returns a flexible (local, GCS or S3) conditional client.
 func (gc gcsClient) If(read, write *storage.Conditions) ConditionalClient {
+	s3 := *gc.s3
+	s3.readCond, s3.writeCond = read, write
 	return gcsClient{
 		gcs:   &realGCSClient{gc.gcs.client, read, write},
-		local: &localClient{nil, nil},
+		s3:    &s3,
+		local: &localClient{read, write},
 	}
 }
 
 func (gc gcsClient) clientFromPath(path Path) ConditionalClient {
-	if path.URL().Scheme == "gs" {
+	switch path.URL().Scheme {
+	case "gs":
 		return gc.gcs
+	case "s3":
+		return gc.s3
 	}
 	return gc.local
 }
@@ -38,9 +38,17 @@ type ConditionalClient struct {
 	Lock        *sync.RWMutex
 }
 
+// current returns the attributes of the path, preferring objects uploaded through the client.
+func (cc *ConditionalClient) current(ctx context.Context, path gcs.Path) (*storage.ObjectAttrs, error) {
+	if u, ok := cc.Uploader[path]; ok && u.Err == nil {
+		return u.Attrs(path), nil
+	}
+	return cc.UploadClient.Stat(ctx, path)
+}
+
 func (cc *ConditionalClient) check(ctx context.Context, from, to *gcs.Path) error {
 	if from != nil && cc.read != nil {
-		attrs, err := cc.UploadClient.Stat(ctx, *from)
+		attrs, err := cc.current(ctx, *from)
 		switch {
 		case err != nil:
 			return err
@@ -55,7 +63,7 @@ func (cc *ConditionalClient) check(ctx context.Context, from, to *gcs.Path) erro
 		}
 	}
 	if to != nil && cc.write != nil {
-		attrs, err := cc.UploadClient.Stat(ctx, *to)
+		attrs, err := cc.current(ctx, *to)
 		switch {
 		case err == storage.ErrObjectNotExist:
 			if cc.write.GenerationMatch != 0 {
@@ -65,6 +73,10 @@ func (cc *ConditionalClient) check(ctx context.Context, from, to *gcs.Path) erro
 			}
 		case err != nil:
 			return err
+		case cc.write.DoesNotExist:
+			return fmt.Errorf("object exists: %w", &googleapi.Error{
+				Code: http.StatusPreconditionFailed,
+			})
 		case cc.write.GenerationMatch != 0 && cc.write.GenerationMatch != attrs.Generation:
 			return fmt.Errorf("bad generation due to GenerationMatch: %w", &googleapi.Error{
 				Code: http.StatusPreconditionFailed,
@@ -139,6 +151,13 @@ func (cc *ConditionalClient) Open(ctx context.Context, path gcs.Path) (io.ReadCl
 	if err := cc.check(ctx, &path, nil); err != nil {
 		return nil, nil, err
 	}
+	if u, ok := cc.Uploader[path]; ok && u.Err == nil {
+		return &Reader{Buf: bytes.NewBuffer(u.Buf)}, &storage.ReaderObjectAttrs{
+			Size:         int64(len(u.Buf)),
+			CacheControl: u.CacheControl,
+			Generation:   u.Generation,
+		}, nil
+	}
 	return cc.UploadClient.Open(ctx, path)
 }
 
@@ -160,7 +179,7 @@ func (cc *ConditionalClient) Stat(ctx context.Context, path gcs.Path) (*storage.
 	if err := cc.check(ctx, &path, nil); err != nil {
 		return nil, err
 	}
-	return cc.UploadClient.Stat(ctx, path)
+	return cc.current(ctx, path)
 }
 
 // UploadClient is a fake upload client
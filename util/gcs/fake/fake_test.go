@@ -17,8 +17,11 @@ limitations under the License.
 package fake
 
 import (
+	"context"
+	"io/ioutil"
 	"testing"
 
+	"cloud.google.com/go/storage"
 	"github.com/GoogleCloudPlatform/testgrid/util/gcs"
 )
 
@@ -34,3 +37,61 @@ func TestInterfaces(t *testing.T) {
 		_ gcs.ConditionalClient = &UploadClient{}
 	)
 }
+
+func TestConditionalClient(t *testing.T) {
+	ctx := context.Background()
+	path, err := gcs.NewPath("gs://bucket/object")
+	if err != nil {
+		t.Fatalf("NewPath() got unexpected error: %v", err)
+	}
+	client := &ConditionalClient{
+		UploadClient: UploadClient{
+			Uploader: Uploader{},
+			Client: Client{
+				Opener: Opener{},
+			},
+		},
+	}
+	create := client.If(nil, &storage.Conditions{DoesNotExist: true})
+
+	attrs, err := create.Upload(ctx, *path, []byte("first"), false, "")
+	if err != nil {
+		t.Fatalf("Upload() got unexpected error: %v", err)
+	}
+	if attrs.Generation != 1 {
+		t.Errorf("Upload() got generation %d, want 1", attrs.Generation)
+	}
+	if _, err := create.Upload(ctx, *path, []byte("again"), false, ""); !gcs.IsPreconditionFailed(err) {
+		t.Errorf("Upload() of existing object got err %v, want precondition failed", err)
+	}
+
+	stale := client.If(nil, &storage.Conditions{GenerationMatch: 2})
+	if _, err := stale.Upload(ctx, *path, []byte("stale"), false, ""); !gcs.IsPreconditionFailed(err) {
+		t.Errorf("Upload() of stale generation got err %v, want precondition failed", err)
+	}
+	current := client.If(nil, &storage.Conditions{GenerationMatch: 1})
+	if _, err := current.Upload(ctx, *path, []byte("second"), false, ""); err != nil {
+		t.Fatalf("Upload() of current generation got unexpected error: %v", err)
+	}
+
+	if attrs, err := client.Stat(ctx, *path); err != nil {
+		t.Errorf("Stat() got unexpected error: %v", err)
+	} else if attrs.Generation != 2 {
+		t.Errorf("Stat() got generation %d, want 2", attrs.Generation)
+	}
+	r, rattrs, err := client.Open(ctx, *path)
+	if err != nil {
+		t.Fatalf("Open() got unexpected error: %v", err)
+	}
+	defer r.Close()
+	buf, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll() got unexpected error: %v", err)
+	}
+	if got, want := string(buf), "second"; got != want {
+		t.Errorf("Open() got %q, want %q", got, want)
+	}
+	if rattrs.Generation != 2 {
+		t.Errorf("Open() got generation %d, want 2", rattrs.Generation)
+	}
+}
@@ -54,13 +54,30 @@ func IsPreconditionFailed(err error) bool {
 	return e.Code == http.StatusPreconditionFailed
 }
 
+// AnonymousCreds requests clients without any credentials, such as when only
+// reading public buckets or using s3:// paths.
+const AnonymousCreds = "anonymous"
+
+// CredsOptions returns the options authenticating a client with the specified .json creds.
+//
+// Uses the default credentials when creds is empty, and none for AnonymousCreds.
+func CredsOptions(creds string) []option.ClientOption {
+	switch creds {
+	case "":
+		return nil
+	case AnonymousCreds:
+		return []option.ClientOption{option.WithoutAuthentication()}
+	}
+	return []option.ClientOption{option.WithCredentialsFile(creds)}
+}
+
 // ClientWithCreds returns a storage client, optionally authenticated with the specified .json creds
 func ClientWithCreds(ctx context.Context, creds ...string) (*storage.Client, error) {
 	var options []option.ClientOption
 	switch l := len(creds); l {
 	case 0: // Do nothing
 	case 1:
-		options = append(options, option.WithCredentialsFile(creds[0]))
+		options = CredsOptions(creds[0])
 	default:
 		return nil, fmt.Errorf("%d creds files unsupported (at most 1)", l)
 	}
@@ -106,8 +123,8 @@ func (g *Path) SetURL(u *url.URL) error {
 	switch {
 	case u == nil:
 		return errors.New("nil url")
-	case u.Scheme != "gs" && u.Scheme != "" && u.Scheme != "file":
-		return fmt.Errorf("must use a gs://, file://, or local filesystem url: %s", u)
+	case u.Scheme != "gs" && u.Scheme != "s3" && u.Scheme != "" && u.Scheme != "file":
+		return fmt.Errorf("must use a gs://, s3://, file://, or local filesystem url: %s", u)
 	case strings.Contains(u.Host, ":"):
 		return fmt.Errorf("gs://bucket may not contain a port: %s", u)
 	case u.Opaque != "":
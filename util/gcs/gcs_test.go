@@ -92,6 +92,12 @@ func Test_SetURL(t *testing.T) {
 			bucket: "first",
 			object: "second",
 		},
+		{
+			name:   "allow s3 urls",
+			url:    "s3://first/second",
+			bucket: "first",
+			object: "second",
+		},
 		{
 			name:   "allow files",
 			url:    "/path/to/my/bucket/foo",
@@ -18,15 +18,19 @@ package gcs
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"io/ioutil"
+	"net/http"
 	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
 	"google.golang.org/api/iterator"
 )
 
@@ -34,6 +38,13 @@ var (
 	_ Client = &localClient{} // Ensure this implements interface
 )
 
+const (
+	// localGenerationSuffix names the sidecar file holding the generation of .name
+	localGenerationSuffix = ".generation"
+	// localTempSuffix names the temporary files of uploads in progress.
+	localTempSuffix = ".tmp"
+)
+
 type localIterator struct {
 	files []os.FileInfo
 	dir   string
@@ -66,14 +77,255 @@ func (li *localIterator) Next() (*storage.ObjectAttrs, error) {
 		return nil, iterator.Done
 	}
 	info := li.files[li.index]
-	p, err := NewPath(filepath.Join(li.dir, info.Name()))
+	name := filepath.Join(li.dir, info.Name())
+	p, err := NewPath(name)
+	if err != nil {
+		return nil, err
+	}
+	gen, err := readGeneration(name, !info.IsDir())
+	if err != nil {
+		return nil, err
+	}
+	return objectAttrs(info, *p, gen), nil
+}
+
+// localTempRegex matches the temporary files of uploads in progress, capturing the object name.
+var localTempRegex = regexp.MustCompile(`^\.(.+)\.\d+` + regexp.QuoteMeta(localTempSuffix) + `$`)
+
+// withoutLocalMetadata removes the sidecar and temporary files of the local client from files.
+//
+// Only hides the sidecars of objects which exist or are being uploaded, and the
+// temporary files of uploads holding a sidecar, so other dotfiles remain visible.
+func withoutLocalMetadata(files []os.FileInfo) []os.FileInfo {
+	names := make(map[string]bool, len(files))
+	for _, f := range files {
+		names[f.Name()] = true
+	}
+	uploading := map[string]bool{}
+	for name := range names {
+		if mat := localTempRegex.FindStringSubmatch(name); mat != nil && names[generationPath(mat[1])] {
+			uploading[mat[1]] = true
+		}
+	}
+	isMetadata := func(name string) bool {
+		if mat := localTempRegex.FindStringSubmatch(name); mat != nil {
+			return uploading[mat[1]]
+		}
+		if !strings.HasPrefix(name, ".") || !strings.HasSuffix(name, localGenerationSuffix) {
+			return false
+		}
+		base := strings.TrimSuffix(name[1:], localGenerationSuffix)
+		return names[base] || uploading[base]
+	}
+	out := files[:0]
+	for _, f := range files {
+		if !isMetadata(f.Name()) {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// generationPath returns the sidecar file holding the generation of the object at name.
+func generationPath(name string) string {
+	dir, base := filepath.Split(name)
+	return filepath.Join(dir, "."+base+localGenerationSuffix)
+}
+
+// parseGeneration returns the generation in the sidecar contents.
+//
+// Objects without one (for example written by another program) are generation 1.
+func parseGeneration(buf []byte, exists bool) (int64, error) {
+	if !exists {
+		return 0, nil
+	}
+	s := strings.TrimSpace(string(buf))
+	if s == "" {
+		return 1, nil
+	}
+	gen, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
+		return 0, fmt.Errorf("parse generation: %w", err)
+	}
+	return gen, nil
+}
+
+// readGeneration returns the generation of the object at name, without locking it.
+func readGeneration(name string, exists bool) (int64, error) {
+	buf, err := ioutil.ReadFile(generationPath(name))
+	if err != nil && !os.IsNotExist(err) {
+		return 0, err
+	}
+	return parseGeneration(buf, exists)
+}
+
+// A localObject is a locked local file and its generation.
+type localObject struct {
+	name       string
+	info       os.FileInfo // nil when the object does not exist.
+	generation int64
+	meta       *os.File
+	exclusive  bool
+}
+
+// openGeneration opens and locks the sidecar holding the generation of the object at name.
+//
+// Creates the sidecar when exclusive. Retries when another client removes the
+// sidecar before it is locked, so that everyone locks the same file.
+func openGeneration(name string, exclusive bool) (*os.File, error) {
+	for {
+		var f *os.File
+		var err error
+		if exclusive {
+			f, err = os.OpenFile(generationPath(name), os.O_RDWR|os.O_CREATE, 0666)
+		} else {
+			f, err = os.Open(generationPath(name))
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := lockFile(f, exclusive); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("lock: %w", err)
+		}
+		locked, err := f.Stat()
+		if err != nil {
+			unlockFile(f)
+			f.Close()
+			return nil, err
+		}
+		current, err := os.Stat(generationPath(name))
+		if err == nil && os.SameFile(locked, current) {
+			return f, nil
+		}
+		unlockFile(f)
+		f.Close()
+		if err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+	}
+}
+
+// lockObject locks the object at name, for writing when exclusive.
+//
+// Locks the sidecar holding the object's generation, so that concurrent
+// clients, including those in other processes, observe and increment
+// generations one at a time.
+func lockObject(name string, exclusive bool) (*localObject, error) {
+	obj := localObject{
+		name:      name,
+		exclusive: exclusive,
+	}
+	if exclusive {
+		// swallow this error; dir may already exist, but it must exist to write the object
+		os.MkdirAll(filepath.Dir(name), os.ModePerm)
+	}
+	var err error
+	obj.meta, err = openGeneration(name, exclusive)
+	switch {
+	case err == nil:
+	case exclusive, !os.IsNotExist(err):
+		return nil, fmt.Errorf("open generation: %w", err)
+	}
+
+	info, err := os.Stat(name)
+	switch {
+	case err == nil:
+		obj.info = info
+	case !os.IsNotExist(err):
+		obj.unlock()
+		return nil, err
+	}
+
+	var buf []byte
+	if obj.meta != nil {
+		if buf, err = ioutil.ReadAll(obj.meta); err != nil {
+			obj.unlock()
+			return nil, fmt.Errorf("read generation: %w", err)
+		}
+	}
+	if obj.generation, err = parseGeneration(buf, obj.info != nil); err != nil {
+		obj.unlock()
 		return nil, err
 	}
-	return objectAttrs(info, *p), nil
+	return &obj, nil
+}
+
+// unlock the object, removing the sidecar of an object which was never written.
+func (obj *localObject) unlock() {
+	if obj.meta == nil {
+		return
+	}
+	if obj.exclusive {
+		if _, err := os.Stat(obj.name); os.IsNotExist(err) {
+			os.Remove(obj.meta.Name())
+		}
+	}
+	unlockFile(obj.meta)
+	obj.meta.Close()
+}
+
+// check returns a precondition failure when the object does not match the conditions.
+func (obj *localObject) check(path Path, cond *storage.Conditions) error {
+	if cond == nil {
+		return nil
+	}
+	exists := obj.info != nil
+	switch {
+	case cond.DoesNotExist && exists:
+		return preconditionFailed(path, "object exists at generation %d", obj.generation)
+	case cond.GenerationMatch != 0 && (!exists || cond.GenerationMatch != obj.generation):
+		return preconditionFailed(path, "generation %d does not match %d", obj.generation, cond.GenerationMatch)
+	case cond.GenerationNotMatch != 0 && exists && cond.GenerationNotMatch == obj.generation:
+		return preconditionFailed(path, "generation %d matches %d", obj.generation, cond.GenerationNotMatch)
+	}
+	return nil
+}
+
+// write replaces the contents of the exclusively locked object, incrementing its generation.
+func (obj *localObject) write(buf []byte) error {
+	dir, base := filepath.Split(obj.name)
+	tmp, err := ioutil.TempFile(dir, "."+base+".*"+localTempSuffix)
+	if err != nil {
+		return convertIsNotExistsErr(err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(buf); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0666); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), obj.name); err != nil {
+		return err
+	}
+
+	obj.generation++
+	if err := obj.meta.Truncate(0); err != nil {
+		return fmt.Errorf("truncate generation: %w", err)
+	}
+	if _, err := obj.meta.WriteAt([]byte(strconv.FormatInt(obj.generation, 10)), 0); err != nil {
+		return fmt.Errorf("write generation: %w", err)
+	}
+	obj.info, err = os.Stat(obj.name)
+	return err
+}
+
+func preconditionFailed(path Path, format string, args ...interface{}) error {
+	return fmt.Errorf("%s: %w", path, &googleapi.Error{
+		Code:    http.StatusPreconditionFailed,
+		Message: fmt.Sprintf(format, args...),
+	})
 }
 
 // NewLocalClient returns a GCSUploadClient for the storage.Client.
+//
+// Tracks the generation of each file in a sidecar file, so conditional
+// operations behave like they do on GCS.
 func NewLocalClient() ConditionalClient {
 	return localClient{nil, nil}
 }
@@ -83,21 +335,55 @@ type localClient struct {
 	writeCond *storage.Conditions
 }
 
-func (lc localClient) If(_, _ *storage.Conditions) ConditionalClient {
-	return NewLocalClient()
+func (lc localClient) If(read, write *storage.Conditions) ConditionalClient {
+	return localClient{read, write}
 }
 
 func (lc localClient) Copy(ctx context.Context, from, to Path) (*storage.ObjectAttrs, error) {
-	buf, err := ioutil.ReadFile(cleanFilepath(from))
+	obj, err := lc.read(from)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := ioutil.ReadFile(obj.name)
+	obj.unlock()
 	if err != nil {
 		return nil, convertIsNotExistsErr(err)
 	}
 	return lc.Upload(ctx, to, buf, false, "")
 }
 
+// read locks the object at path for reading, after ensuring it exists and matches the read conditions.
+func (lc localClient) read(path Path) (*localObject, error) {
+	obj, err := lockObject(cleanFilepath(path), false)
+	if err != nil {
+		return nil, err
+	}
+	if obj.info == nil {
+		obj.unlock()
+		return nil, storage.ErrObjectNotExist
+	}
+	if err := obj.check(path, lc.readCond); err != nil {
+		obj.unlock()
+		return nil, err
+	}
+	return obj, nil
+}
+
 func (lc localClient) Open(ctx context.Context, path Path) (io.ReadCloser, *storage.ReaderObjectAttrs, error) {
-	r, err := os.Open(cleanFilepath(path))
-	return r, &storage.ReaderObjectAttrs{}, convertIsNotExistsErr(err)
+	obj, err := lc.read(path)
+	if err != nil {
+		return nil, &storage.ReaderObjectAttrs{}, err
+	}
+	defer obj.unlock()
+	r, err := os.Open(obj.name)
+	if err != nil {
+		return nil, &storage.ReaderObjectAttrs{}, convertIsNotExistsErr(err)
+	}
+	return r, &storage.ReaderObjectAttrs{
+		Size:         obj.info.Size(),
+		LastModified: obj.info.ModTime(),
+		Generation:   obj.generation,
+	}, nil
 }
 
 func (lc localClient) Objects(ctx context.Context, path Path, delimiter, startOffset string) Iterator {
@@ -111,36 +397,40 @@ func (lc localClient) Objects(ctx context.Context, path Path, delimiter, startOf
 	}
 	return &localIterator{
 		dir:   filepath.Dir(p),
-		files: files,
+		files: withoutLocalMetadata(files),
 	}
 }
 
 func (lc localClient) Upload(ctx context.Context, path Path, buf []byte, _ bool, _ string) (*storage.ObjectAttrs, error) {
-	cleanPath := cleanFilepath(path)
-	dirName := filepath.Dir(cleanPath)
-	// swallow this error; dir may already exist, but it must exist to WriteFile for local storage only
-	os.MkdirAll(dirName, os.ModePerm)
-
-	err := ioutil.WriteFile(cleanPath, buf, 0666)
+	obj, err := lockObject(cleanFilepath(path), true)
 	if err != nil {
 		return nil, convertIsNotExistsErr(err)
 	}
-	return lc.Stat(ctx, path)
+	defer obj.unlock()
+	if err := obj.check(path, lc.writeCond); err != nil {
+		return nil, err
+	}
+	if err := obj.write(buf); err != nil {
+		return nil, convertIsNotExistsErr(err)
+	}
+	return objectAttrs(obj.info, path, obj.generation), nil
 }
 
 func (lc localClient) Stat(ctx context.Context, path Path) (*storage.ObjectAttrs, error) {
-	info, err := os.Stat(cleanFilepath(path))
+	obj, err := lc.read(path)
 	if err != nil {
-		return nil, convertIsNotExistsErr(err)
+		return nil, err
 	}
-	return objectAttrs(info, path), nil
+	defer obj.unlock()
+	return objectAttrs(obj.info, path, obj.generation), nil
 }
 
-func objectAttrs(info os.FileInfo, path Path) *storage.ObjectAttrs {
+func objectAttrs(info os.FileInfo, path Path, generation int64) *storage.ObjectAttrs {
 	return &storage.ObjectAttrs{
-		Bucket:  path.Bucket(),
-		Name:    path.Object(),
-		Size:    info.Size(),
-		Updated: info.ModTime(),
+		Bucket:     path.Bucket(),
+		Name:       path.Object(),
+		Size:       info.Size(),
+		Updated:    info.ModTime(),
+		Generation: generation,
 	}
 }
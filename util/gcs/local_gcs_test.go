@@ -18,9 +18,17 @@ package gcs
 
 import (
 	"context"
+	"errors"
+	"io/ioutil"
 	"os"
 	"path"
+	"sort"
+	"sync"
 	"testing"
+
+	"cloud.google.com/go/storage"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/api/iterator"
 )
 
 func TestCleanFilepath(t *testing.T) {
@@ -128,3 +136,213 @@ func TestUpload_UploadsToLocalStorage(t *testing.T) {
 		})
 	}
 }
+
+func TestLocalClientGenerations(t *testing.T) {
+	ctx := context.Background()
+	client := NewLocalClient()
+	p, err := NewPath(path.Join(t.TempDir(), "some/object"))
+	if err != nil {
+		t.Fatalf("NewPath() got unexpected error: %v", err)
+	}
+
+	if _, err := client.Stat(ctx, *p); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Fatalf("Stat() got err %v, want %v", err, storage.ErrObjectNotExist)
+	}
+	create := &storage.Conditions{DoesNotExist: true}
+	attrs, err := client.If(nil, create).Upload(ctx, *p, []byte("hello"), DefaultACL, NoCache)
+	if err != nil {
+		t.Fatalf("Upload() got unexpected error: %v", err)
+	}
+	if attrs.Generation != 1 {
+		t.Errorf("Upload() got generation %d, want 1", attrs.Generation)
+	}
+	if _, err := client.If(nil, create).Upload(ctx, *p, []byte("clobber"), DefaultACL, NoCache); !IsPreconditionFailed(err) {
+		t.Errorf("Upload() of existing object got err %v, want precondition failure", err)
+	}
+
+	match := &storage.Conditions{GenerationMatch: 1}
+	if attrs, err = client.If(nil, match).Upload(ctx, *p, []byte("world"), DefaultACL, NoCache); err != nil {
+		t.Fatalf("Upload() matching generation got unexpected error: %v", err)
+	}
+	if attrs.Generation != 2 {
+		t.Errorf("Upload() got generation %d, want 2", attrs.Generation)
+	}
+	if _, err := client.If(nil, match).Upload(ctx, *p, []byte("stale"), DefaultACL, NoCache); !IsPreconditionFailed(err) {
+		t.Errorf("Upload() of stale generation got err %v, want precondition failure", err)
+	}
+
+	r, rattrs, err := client.Open(ctx, *p)
+	if err != nil {
+		t.Fatalf("Open() got unexpected error: %v", err)
+	}
+	buf, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		t.Fatalf("read: %v", err)
+	}
+	if got := string(buf); got != "world" {
+		t.Errorf("Open() got %q, want world", got)
+	}
+	if rattrs.Generation != 2 || rattrs.Size != int64(len("world")) {
+		t.Errorf("Open() got generation %d and size %d, want 2 and %d", rattrs.Generation, rattrs.Size, len("world"))
+	}
+	if _, _, err := client.If(&storage.Conditions{GenerationMatch: 1}, nil).Open(ctx, *p); !IsPreconditionFailed(err) {
+		t.Errorf("Open() of stale generation got err %v, want precondition failure", err)
+	}
+
+	if _, err := Touch(ctx, client, *p, attrs.Generation, nil); err != nil {
+		t.Fatalf("Touch() got unexpected error: %v", err)
+	}
+	if _, err := Touch(ctx, client, *p, attrs.Generation, nil); !IsPreconditionFailed(err) {
+		t.Errorf("Touch() of stale generation got err %v, want precondition failure", err)
+	}
+	if attrs, err = client.Stat(ctx, *p); err != nil {
+		t.Fatalf("Stat() got unexpected error: %v", err)
+	}
+	if attrs.Generation != 3 {
+		t.Errorf("Stat() got generation %d, want 3", attrs.Generation)
+	}
+}
+
+func TestLocalClientExistingFiles(t *testing.T) {
+	ctx := context.Background()
+	client := NewLocalClient()
+	dir := t.TempDir()
+	if err := ioutil.WriteFile(path.Join(dir, "existing"), []byte("hi"), 0666); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+	p, err := NewPath(path.Join(dir, "existing"))
+	if err != nil {
+		t.Fatalf("NewPath() got unexpected error: %v", err)
+	}
+	attrs, err := client.Stat(ctx, *p)
+	if err != nil {
+		t.Fatalf("Stat() got unexpected error: %v", err)
+	}
+	if attrs.Generation != 1 {
+		t.Errorf("Stat() got generation %d, want 1", attrs.Generation)
+	}
+	if _, err := client.If(nil, &storage.Conditions{GenerationMatch: 1}).Upload(ctx, *p, []byte("bye"), DefaultACL, NoCache); err != nil {
+		t.Errorf("Upload() got unexpected error: %v", err)
+	}
+
+	var names []string
+	dirPath, err := NewPath(dir)
+	if err != nil {
+		t.Fatalf("NewPath() got unexpected error: %v", err)
+	}
+	it := client.Objects(ctx, *dirPath, "/", "")
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() got unexpected error: %v", err)
+		}
+		names = append(names, path.Base(attrs.Name))
+		if attrs.Generation != 2 {
+			t.Errorf("Next() got generation %d, want 2", attrs.Generation)
+		}
+	}
+	if len(names) != 1 || names[0] != "existing" {
+		t.Errorf("Objects() got %v, want [existing]", names)
+	}
+}
+
+func TestLocalClientConcurrentWriters(t *testing.T) {
+	const writers = 10
+	ctx := context.Background()
+	p, err := NewPath(path.Join(t.TempDir(), "contended"))
+	if err != nil {
+		t.Fatalf("NewPath() got unexpected error: %v", err)
+	}
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	var won int
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// Separate clients, like separate components sharing a directory.
+			client := NewLocalClient().If(nil, &storage.Conditions{DoesNotExist: true})
+			_, err := client.Upload(ctx, *p, []byte("mine"), DefaultACL, NoCache)
+			switch {
+			case err == nil:
+				lock.Lock()
+				won++
+				lock.Unlock()
+			case !IsPreconditionFailed(err):
+				t.Errorf("Upload() got unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if won != 1 {
+		t.Errorf("%d writers created the object, want 1", won)
+	}
+}
+
+func TestLocalClientFailedUploads(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	p, err := NewPath(path.Join(dir, "missing"))
+	if err != nil {
+		t.Fatalf("NewPath() got unexpected error: %v", err)
+	}
+	client := NewLocalClient().If(nil, &storage.Conditions{GenerationMatch: 1})
+	if _, err := client.Upload(ctx, *p, []byte("hi"), DefaultACL, NoCache); !IsPreconditionFailed(err) {
+		t.Fatalf("Upload() got err %v, want precondition failure", err)
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("ReadDir() got unexpected error: %v", err)
+	}
+	for _, f := range files {
+		t.Errorf("Upload() left %s behind", f.Name())
+	}
+	if _, err := NewLocalClient().Stat(ctx, *p); !errors.Is(err, storage.ErrObjectNotExist) {
+		t.Errorf("Stat() got err %v, want %v", err, storage.ErrObjectNotExist)
+	}
+}
+
+func TestLocalClientListsDotfiles(t *testing.T) {
+	ctx := context.Background()
+	client := NewLocalClient()
+	dir := t.TempDir()
+	for _, name := range []string{"object", ".backup.tmp", ".build.generation", ".object.1234.tmp"} {
+		if err := ioutil.WriteFile(path.Join(dir, name), []byte("hi"), 0666); err != nil {
+			t.Fatalf("write: %v", err)
+		}
+	}
+	p, err := NewPath(path.Join(dir, "object"))
+	if err != nil {
+		t.Fatalf("NewPath() got unexpected error: %v", err)
+	}
+	if _, err := client.Upload(ctx, *p, []byte("bye"), DefaultACL, NoCache); err != nil {
+		t.Fatalf("Upload() got unexpected error: %v", err)
+	}
+
+	dirPath, err := NewPath(dir)
+	if err != nil {
+		t.Fatalf("NewPath() got unexpected error: %v", err)
+	}
+	var names []string
+	it := client.Objects(ctx, *dirPath, "/", "")
+	for {
+		attrs, err := it.Next()
+		if err == iterator.Done {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Next() got unexpected error: %v", err)
+		}
+		names = append(names, path.Base(attrs.Name))
+	}
+	sort.Strings(names)
+	// Hides the sidecar of object and what looks like the temporary file of an upload.
+	want := []string{".backup.tmp", ".build.generation", "object"}
+	if diff := cmp.Diff(want, names); diff != "" {
+		t.Errorf("Objects() got unexpected names (-want +got):\n%s", diff)
+	}
+}
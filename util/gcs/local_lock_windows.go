@@ -0,0 +1,64 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// Windows lacks flock, so only lock files against other goroutines of this process.
+var (
+	fileLocksLock sync.Mutex
+	fileLocks     = map[string]*sync.Mutex{}
+	heldLocks     = map[*os.File]*sync.Mutex{}
+)
+
+// lockFile blocks until it holds a lock on the file.
+//
+// Shared locks are exclusive, as readers hold them briefly.
+func lockFile(f *os.File, _ bool) error {
+	name, err := filepath.Abs(f.Name())
+	if err != nil {
+		name = f.Name()
+	}
+	fileLocksLock.Lock()
+	lock, ok := fileLocks[name]
+	if !ok {
+		lock = &sync.Mutex{}
+		fileLocks[name] = lock
+	}
+	fileLocksLock.Unlock()
+
+	lock.Lock()
+	fileLocksLock.Lock()
+	heldLocks[f] = lock
+	fileLocksLock.Unlock()
+	return nil
+}
+
+func unlockFile(f *os.File) error {
+	fileLocksLock.Lock()
+	lock, ok := heldLocks[f]
+	delete(heldLocks, f)
+	fileLocksLock.Unlock()
+	if ok {
+		lock.Unlock()
+	}
+	return nil
+}
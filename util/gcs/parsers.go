@@ -0,0 +1,142 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/GoogleCloudPlatform/testgrid/metadata/gotest"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/junit"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/tap"
+	"github.com/GoogleCloudPlatform/testgrid/metadata/trx"
+)
+
+// ArtifactParser finds and reads test results in the artifacts of a build.
+type ArtifactParser interface {
+	// Metadata returns the metadata in the name of the artifact, or nil to ignore it.
+	Metadata(name string) map[string]string
+	// Parse returns the test results in the artifact.
+	//
+	// Returns nil suites when the artifact turns out not to hold test results.
+	Parse(r io.Reader) (*junit.Suites, error)
+}
+
+// Names of the built-in artifact parsers.
+const (
+	JUnitParser  = "junit"
+	GoTestParser = "go_test"
+	TAPParser    = "tap"
+	TRXParser    = "trx"
+)
+
+// DefaultArtifactParsers are the parsers used when a build does not specify any.
+//
+// Excludes go_test, which opens every *.json artifact, so groups opt into it.
+var DefaultArtifactParsers = []string{JUnitParser}
+
+var artifactParsers = map[string]ArtifactParser{
+	JUnitParser: artifactParser{
+		metadata: parseSuitesMeta,
+		parse:    junit.ParseStream,
+	},
+	GoTestParser: artifactParser{
+		metadata: extensionMeta(".json"),
+		parse: func(r io.Reader) (*junit.Suites, error) {
+			suites, err := gotest.ParseStream(r)
+			if errors.Is(err, gotest.ErrNotTestJSON) {
+				return nil, nil
+			}
+			return suites, err
+		},
+	},
+	TAPParser: artifactParser{
+		metadata: extensionMeta(".tap"),
+		parse:    tap.ParseStream,
+	},
+	TRXParser: artifactParser{
+		metadata: extensionMeta(".trx"),
+		parse:    trx.ParseStream,
+	},
+}
+
+// RegisterArtifactParser adds a parser to the registry, replacing any parser of the same name.
+//
+// Call it from an init function, before looking up any parsers.
+func RegisterArtifactParser(name string, parser ArtifactParser) {
+	artifactParsers[name] = parser
+}
+
+// ArtifactParsers returns the named parsers in order, or the default parsers when none are named.
+func ArtifactParsers(names ...string) ([]ArtifactParser, error) {
+	if len(names) == 0 {
+		names = DefaultArtifactParsers
+	}
+	parsers := make([]ArtifactParser, 0, len(names))
+	for _, name := range names {
+		p, ok := artifactParsers[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown artifact parser %q", name)
+		}
+		parsers = append(parsers, p)
+	}
+	return parsers, nil
+}
+
+// artifactParser implements ArtifactParser with functions.
+type artifactParser struct {
+	metadata func(string) map[string]string
+	parse    func(io.Reader) (*junit.Suites, error)
+}
+
+func (p artifactParser) Metadata(name string) map[string]string {
+	return p.metadata(name)
+}
+
+func (p artifactParser) Parse(r io.Reader) (*junit.Suites, error) {
+	return p.parse(r)
+}
+
+// metadataJSON holds the names of json files describing the build rather than tests.
+var metadataJSON = map[string]bool{
+	"clone-records.json": true,
+	"finished.json":      true,
+	"metadata.json":      true,
+	"podinfo.json":       true,
+	"prowjob.json":       true,
+	"started.json":       true,
+}
+
+// extensionMeta returns the metadata of files with the extension, using their base name as the Context.
+//
+// For example unit.tap results in {"Context": "unit"}
+func extensionMeta(ext string) func(string) map[string]string {
+	return func(name string) map[string]string {
+		base := path.Base(name)
+		if !strings.HasSuffix(base, ext) || base == ext || metadataJSON[base] || !strings.Contains(name, "/") {
+			return nil
+		}
+		return map[string]string{
+			"Context":   strings.TrimSuffix(base, ext),
+			"Timestamp": "",
+			"Thread":    "",
+		}
+	}
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestArtifactParsers(t *testing.T) {
+	cases := []struct {
+		name     string
+		parsers  []string
+		artifact string
+		want     map[string]string
+		err      bool
+	}{
+		{
+			name:     "defaults read junit",
+			artifact: "artifacts/junit_foo.xml",
+			want:     parseSuitesMeta("artifacts/junit_foo.xml"),
+		},
+		{
+			name:     "defaults ignore json",
+			artifact: "artifacts/unit-tests.json",
+		},
+		{
+			name:     "go test json",
+			parsers:  []string{JUnitParser, GoTestParser},
+			artifact: "artifacts/unit-tests.json",
+			want:     map[string]string{"Context": "unit-tests", "Timestamp": "", "Thread": ""},
+		},
+		{
+			name:     "ignore build metadata",
+			parsers:  []string{GoTestParser},
+			artifact: "logs/job/123/started.json",
+		},
+		{
+			name:     "ignore unknown formats",
+			artifact: "artifacts/results.tap",
+		},
+		{
+			name:     "tap",
+			parsers:  []string{TAPParser},
+			artifact: "artifacts/results.tap",
+			want:     map[string]string{"Context": "results", "Timestamp": "", "Thread": ""},
+		},
+		{
+			name:     "trx",
+			parsers:  []string{JUnitParser, TRXParser},
+			artifact: "artifacts/TestResults/run.trx",
+			want:     map[string]string{"Context": "run", "Timestamp": "", "Thread": ""},
+		},
+		{
+			name:     "only selected parsers",
+			parsers:  []string{TRXParser},
+			artifact: "artifacts/junit.xml",
+		},
+		{
+			name:    "reject unknown parsers",
+			parsers: []string{"nunit"},
+			err:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsers, err := ArtifactParsers(tc.parsers...)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Fatalf("ArtifactParsers() got unexpected error: %v", err)
+				}
+				return
+			case tc.err:
+				t.Fatal("ArtifactParsers() failed to return an error")
+			}
+			var got map[string]string
+			for _, p := range parsers {
+				if got = p.Metadata(tc.artifact); got != nil {
+					break
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Metadata() got unexpected diff (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestGoTestParserSkipsOtherJSON(t *testing.T) {
+	parsers, err := ArtifactParsers(GoTestParser)
+	if err != nil {
+		t.Fatalf("ArtifactParsers() got unexpected error: %v", err)
+	}
+	suites, err := parsers[0].Parse(strings.NewReader(`{"hello": "world"}`))
+	if err != nil {
+		t.Errorf("Parse() got unexpected error: %v", err)
+	}
+	if suites != nil {
+		t.Errorf("Parse() got %v, want nil", suites)
+	}
+}
@@ -128,6 +128,99 @@ func (pi PodInfo) Summarize() (bool, string) {
 	return true, ""
 }
 
+// FailureCategory classifies why a pod did not succeed.
+type FailureCategory string
+
+const (
+	// FailureCategoryKey is the cell property holding the FailureCategory of a build.
+	FailureCategoryKey = "failure-category"
+
+	// NoFailure means the pod succeeded, or its state is unknown.
+	NoFailure FailureCategory = ""
+	// OOMKilled means a container ran out of memory.
+	OOMKilled FailureCategory = "OOMKilled"
+	// Evicted means the node evicted the pod.
+	Evicted FailureCategory = "Evicted"
+	// ImagePullFailure means a container image could not be pulled.
+	ImagePullFailure FailureCategory = "ImagePullFailure"
+	// NodeLost means the node running the pod went away.
+	NodeLost FailureCategory = "NodeLost"
+	// Unschedulable means the pod never scheduled onto a node.
+	Unschedulable FailureCategory = "Unschedulable"
+	// Timeout means the pod or its test did not finish in time.
+	Timeout FailureCategory = "Timeout"
+	// TestFailure means the test itself failed.
+	TestFailure FailureCategory = "TestFailure"
+)
+
+// Infra returns true when the category is an infrastructure problem rather than a test failure.
+func (fc FailureCategory) Infra() bool {
+	switch fc {
+	case OOMKilled, Evicted, ImagePullFailure, NodeLost, Unschedulable:
+		return true
+	}
+	return false
+}
+
+var (
+	imagePullReasons = map[string]bool{
+		"ErrImagePull":      true,
+		"ImagePullBackOff":  true,
+		"ErrImageNeverPull": true,
+		"InvalidImageName":  true,
+	}
+	nodeLostReasons = map[string]bool{
+		"NodeLost":     true,
+		"NodeShutdown": true,
+		"Shutdown":     true,
+	}
+	timeoutRegex = regexp.MustCompile(`(?i)did not finish before|timed out`)
+)
+
+// Classify returns the category of the pod's failure.
+func (pi PodInfo) Classify() FailureCategory {
+	if pi.Pod == nil || pi.Pod.Status.Phase == core.PodSucceeded {
+		return NoFailure
+	}
+
+	switch reason := pi.Pod.Status.Reason; {
+	case reason == "Evicted":
+		return Evicted
+	case nodeLostReasons[reason]:
+		return NodeLost
+	case reason == "DeadlineExceeded":
+		return Timeout
+	}
+
+	for _, cond := range pi.Pod.Status.Conditions {
+		if cond.Type == core.PodScheduled && cond.Status != core.ConditionTrue {
+			return Unschedulable
+		}
+	}
+
+	statuses := append(append([]core.ContainerStatus{}, pi.Pod.Status.InitContainerStatuses...), pi.Pod.Status.ContainerStatuses...)
+	for _, status := range statuses {
+		if w := status.State.Waiting; w != nil && imagePullReasons[w.Reason] {
+			return ImagePullFailure
+		}
+	}
+	for _, status := range statuses {
+		if t := status.State.Terminated; t != nil && t.Reason == "OOMKilled" {
+			return OOMKilled
+		}
+	}
+	for _, status := range statuses {
+		if t := status.State.Terminated; t != nil && t.ExitCode != 0 && timeoutRegex.MatchString(t.Message) {
+			return Timeout
+		}
+	}
+
+	if pass, _ := pi.Summarize(); pass && pi.Pod.Status.Phase != core.PodFailed {
+		return NoFailure
+	}
+	return TestFailure
+}
+
 // Started holds started.json data.
 type Started struct {
 	metadata.Started
@@ -146,6 +239,12 @@ type Finished struct {
 type Build struct {
 	Path     Path
 	baseName string
+
+	// Parsers for the test results in the build's artifacts, or the defaults when empty.
+	Parsers []ArtifactParser
+
+	// ReadRefs requests the refs in prowjob.json and clone-records.json.
+	ReadRefs bool
 }
 
 func (build Build) object() string {
@@ -250,7 +349,11 @@ func ListBuilds(parent context.Context, lister Lister, gcsPath Path, after *Path
 			continue // not a symlink to a directory
 		}
 
-		loc := "gs://" + gcsPath.Bucket() + "/" + objAttrs.Prefix
+		scheme := gcsPath.URL().Scheme
+		if scheme == "" || scheme == "file" {
+			scheme = "gs"
+		}
+		loc := scheme + "://" + gcsPath.Bucket() + "/" + objAttrs.Prefix
 		gcsPath, err := NewPath(loc)
 		if err != nil {
 			return nil, fmt.Errorf("bad path %q: %w", loc, err)
@@ -348,6 +451,40 @@ func (build Build) PodInfo(ctx context.Context, opener Opener) (*PodInfo, error)
 	return &podInfo, nil
 }
 
+// ProwJob parses the build's prowjob.json, returning nil when it does not exist.
+func (build Build) ProwJob(ctx context.Context, opener Opener) (*metadata.ProwJob, error) {
+	path, err := build.Path.ResolveReference(&url.URL{Path: "prowjob.json"})
+	if err != nil {
+		return nil, fmt.Errorf("resolve: %w", err)
+	}
+	var prowJob metadata.ProwJob
+	err = readJSON(ctx, opener, *path, &prowJob)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	return &prowJob, nil
+}
+
+// CloneRecords parses the build's clone-records.json, returning nil when it does not exist.
+func (build Build) CloneRecords(ctx context.Context, opener Opener) ([]metadata.CloneRecord, error) {
+	path, err := build.Path.ResolveReference(&url.URL{Path: "clone-records.json"})
+	if err != nil {
+		return nil, fmt.Errorf("resolve: %w", err)
+	}
+	var records []metadata.CloneRecord
+	err = readJSON(ctx, opener, *path, &records)
+	if errors.Is(err, storage.ErrObjectNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	return records, nil
+}
+
 // Started parses the build's started metadata.
 func (build Build) Started(ctx context.Context, opener Opener) (*Started, error) {
 	path, err := build.Path.ResolveReference(&url.URL{Path: "started.json"})
@@ -416,7 +553,7 @@ const (
 	maxSize int64 = 100e6 // 100 million, coarce to int not float
 )
 
-func readSuites(ctx context.Context, opener Opener, p Path) (*junit.Suites, error) {
+func readSuites(ctx context.Context, opener Opener, p Path, parser ArtifactParser) (*junit.Suites, error) {
 	r, attrs, err := opener.Open(ctx, p)
 	if err != nil {
 		return nil, fmt.Errorf("open: %w", err)
@@ -425,17 +562,27 @@ func readSuites(ctx context.Context, opener Opener, p Path) (*junit.Suites, erro
 	if attrs != nil && attrs.Size > maxSize {
 		return nil, fmt.Errorf("too large: %d bytes > %d bytes max", attrs.Size, maxSize)
 	}
-	suitesMeta, err := junit.ParseStream(r)
+	suitesMeta, err := parser.Parse(r)
 	if err != nil {
 		return nil, fmt.Errorf("parse: %w", err)
 	}
 	return suitesMeta, nil
 }
 
-// Suites takes a channel of artifact names, parses those representing junit suites, sending the result to the suites channel.
+// Suites takes a channel of artifact names, parses those representing test results, sending the result to the suites channel.
+//
+// Each artifact is read by the first of the build's parsers to recognize its name,
+// and skipped if it turns out not to hold results.
 //
 // Truncates xml results when set to a positive number of max bytes.
 func (build Build) Suites(ctx context.Context, opener Opener, artifacts <-chan string, suites chan<- SuitesMeta, max int) error {
+	parsers := build.Parsers
+	if len(parsers) == 0 {
+		var err error
+		if parsers, err = ArtifactParsers(); err != nil {
+			return err
+		}
+	}
 	for {
 		var art string
 		var more bool
@@ -447,9 +594,16 @@ func (build Build) Suites(ctx context.Context, opener Opener, artifacts <-chan s
 				return nil
 			}
 		}
-		meta := parseSuitesMeta(art)
+		var parser ArtifactParser
+		var meta map[string]string
+		for _, p := range parsers {
+			if meta = p.Metadata(art); meta != nil {
+				parser = p
+				break
+			}
+		}
 		if meta == nil {
-			continue // not a junit file ignore it, ignore it
+			continue // not a test result file, ignore it
 		}
 		if art != "" && art[0] != '/' {
 			art = "/" + art
@@ -462,7 +616,10 @@ func (build Build) Suites(ctx context.Context, opener Opener, artifacts <-chan s
 			Metadata: meta,
 			Path:     path.String(),
 		}
-		out.Suites, err = readSuites(ctx, opener, *path)
+		out.Suites, err = readSuites(ctx, opener, *path, parser)
+		if err == nil && out.Suites == nil {
+			continue // not test results after all
+		}
 		if err != nil {
 			out.Err = err
 		} else {
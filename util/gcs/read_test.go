@@ -213,6 +213,161 @@ func TestPodInfoSummarize(t *testing.T) {
 	}
 }
 
+func TestPodInfoClassify(t *testing.T) {
+	decorated := func(phase core.PodPhase, containers ...core.ContainerStatus) PodInfo {
+		return PodInfo{
+			Pod: &core.Pod{
+				Status: core.PodStatus{
+					Phase: phase,
+					Conditions: []core.PodCondition{
+						podCondition(core.PodScheduled, core.ConditionTrue, ""),
+						podCondition(core.PodInitialized, core.ConditionTrue, ""),
+					},
+					ContainerStatuses: append([]core.ContainerStatus{
+						containerStatus("sidecar", false, true, 0),
+					}, containers...),
+				},
+			},
+		}
+	}
+	terminated := func(name, reason, msg string, exitCode int32) core.ContainerStatus {
+		status := containerStatus(name, false, true, exitCode)
+		status.State.Terminated.Reason = reason
+		status.State.Terminated.Message = msg
+		return status
+	}
+	waiting := func(name, reason string) core.ContainerStatus {
+		status := containerWaiting(name, "beep boop")
+		status.State.Waiting.Reason = reason
+		return status
+	}
+
+	cases := []struct {
+		name string
+		info PodInfo
+		want FailureCategory
+	}{
+		{
+			name: "basically works",
+		},
+		{
+			name: "passing pod",
+			info: PodInfo{
+				Pod: &core.Pod{
+					Status: core.PodStatus{Phase: core.PodSucceeded},
+				},
+			},
+		},
+		{
+			name: "test failure",
+			info: decorated(core.PodFailed, containerStatus("test", false, true, 1)),
+			want: TestFailure,
+		},
+		{
+			name: "oom killed",
+			info: decorated(core.PodFailed, terminated("test", "OOMKilled", "", 137)),
+			want: OOMKilled,
+		},
+		{
+			name: "image pull failure",
+			info: decorated(core.PodFailed, waiting("test", "ImagePullBackOff")),
+			want: ImagePullFailure,
+		},
+		{
+			name: "other waiting failure",
+			info: decorated(core.PodFailed, waiting("test", "CreateContainerConfigError")),
+			want: TestFailure,
+		},
+		{
+			name: "test timeout",
+			info: decorated(core.PodFailed, terminated("test", "Error", "Process did not finish before 2h0m0s timeout", 1)),
+			want: Timeout,
+		},
+		{
+			name: "deadline exceeded",
+			info: PodInfo{
+				Pod: &core.Pod{
+					Status: core.PodStatus{
+						Phase:  core.PodFailed,
+						Reason: "DeadlineExceeded",
+					},
+				},
+			},
+			want: Timeout,
+		},
+		{
+			name: "evicted",
+			info: PodInfo{
+				Pod: &core.Pod{
+					Status: core.PodStatus{
+						Phase:  core.PodFailed,
+						Reason: "Evicted",
+					},
+				},
+			},
+			want: Evicted,
+		},
+		{
+			name: "node lost",
+			info: PodInfo{
+				Pod: &core.Pod{
+					Status: core.PodStatus{
+						Phase:  core.PodFailed,
+						Reason: "NodeLost",
+					},
+				},
+			},
+			want: NodeLost,
+		},
+		{
+			name: "unschedulable",
+			info: PodInfo{
+				Pod: &core.Pod{
+					Status: core.PodStatus{
+						Phase: core.PodPending,
+						Conditions: []core.PodCondition{
+							podCondition(core.PodScheduled, core.ConditionFalse, "0/159 nodes available"),
+						},
+					},
+				},
+			},
+			want: Unschedulable,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.info.Classify(); got != tc.want {
+				t.Errorf("Classify() got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFailureCategoryInfra(t *testing.T) {
+	cases := []struct {
+		category FailureCategory
+		want     bool
+	}{
+		{category: NoFailure},
+		{category: TestFailure},
+		{category: Timeout},
+		{category: OOMKilled, want: true},
+		{category: Evicted, want: true},
+		{category: ImagePullFailure, want: true},
+		{category: NodeLost, want: true},
+		{category: Unschedulable, want: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.category), func(t *testing.T) {
+			if got := tc.category.Infra(); got != tc.want {
+				t.Errorf("Infra() got %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
 func subdir(prefix string) storage.ObjectAttrs {
 	return storage.ObjectAttrs{Prefix: prefix}
 }
@@ -1068,6 +1223,183 @@ func TestFinished(t *testing.T) {
 	}
 }
 
+func TestProwJob(t *testing.T) {
+	path := newPathOrDie("gs://bucket/path/")
+	prowJob := resolveOrDie(path, "prowjob.json")
+	cases := []struct {
+		name     string
+		object   *fakeObject
+		expected *metadata.ProwJob
+		err      bool
+	}{
+		{
+			name:     "basically works",
+			object:   &fakeObject{data: "{}"},
+			expected: &metadata.ProwJob{},
+		},
+		{
+			name: "refs parsed",
+			object: &fakeObject{
+				data: `{
+                    "spec": {
+                        "type": "presubmit",
+                        "job": "pull-foo",
+                        "refs": {
+                            "org": "org",
+                            "repo": "repo",
+                            "base_ref": "main",
+                            "base_sha": "deadbeef",
+                            "pulls": [{"number": 1, "author": "alice", "sha": "abc"}]
+                        }
+                    },
+                    "status": {"state": "failure", "build_id": "123"}
+                }`,
+			},
+			expected: &metadata.ProwJob{
+				Spec: metadata.ProwJobSpec{
+					Type: "presubmit",
+					Job:  "pull-foo",
+					Refs: &metadata.Refs{
+						Org:     "org",
+						Repo:    "repo",
+						BaseRef: "main",
+						BaseSHA: "deadbeef",
+						Pulls: []metadata.Pull{
+							{Number: 1, Author: "alice", SHA: "abc"},
+						},
+					},
+				},
+				Status: metadata.ProwJobStatus{
+					State:   "failure",
+					BuildID: "123",
+				},
+			},
+		},
+		{
+			name: "missing object returns nil",
+		},
+		{
+			name:   "read error returns an error",
+			object: &fakeObject{readErr: errors.New("injected read error")},
+			err:    true,
+		},
+		{
+			name:   "malformed object returns an error",
+			object: &fakeObject{data: "{"},
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fo := fakeOpener{}
+			if tc.object != nil {
+				fo[prowJob] = *tc.object
+			}
+			b := Build{Path: path}
+			actual, err := b.ProwJob(context.Background(), fo)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("ProwJob(): unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("ProwJob(): failed to receive an error")
+			default:
+				if !reflect.DeepEqual(actual, tc.expected) {
+					t.Errorf("ProwJob(): got %v, want %v", actual, tc.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestCloneRecords(t *testing.T) {
+	path := newPathOrDie("gs://bucket/path/")
+	records := resolveOrDie(path, "clone-records.json")
+	cases := []struct {
+		name     string
+		object   *fakeObject
+		expected []metadata.CloneRecord
+		err      bool
+	}{
+		{
+			name:     "basically works",
+			object:   &fakeObject{data: "[]"},
+			expected: []metadata.CloneRecord{},
+		},
+		{
+			name: "records parsed",
+			object: &fakeObject{
+				data: `[
+                    {
+                        "refs": {
+                            "org": "org",
+                            "repo": "repo",
+                            "base_ref": "main",
+                            "pulls": [{"number": 2, "author": "bob", "sha": "def"}]
+                        },
+                        "commands": [{"command": "git init"}],
+                        "final_sha": "fff"
+                    },
+                    {
+                        "refs": {"org": "org", "repo": "other"},
+                        "failed": true
+                    }
+                ]`,
+			},
+			expected: []metadata.CloneRecord{
+				{
+					Refs: metadata.Refs{
+						Org:     "org",
+						Repo:    "repo",
+						BaseRef: "main",
+						Pulls: []metadata.Pull{
+							{Number: 2, Author: "bob", SHA: "def"},
+						},
+					},
+					FinalSHA: "fff",
+				},
+				{
+					Refs:   metadata.Refs{Org: "org", Repo: "other"},
+					Failed: true,
+				},
+			},
+		},
+		{
+			name: "missing object returns nil",
+		},
+		{
+			name:   "read error returns an error",
+			object: &fakeObject{readErr: errors.New("injected read error")},
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fo := fakeOpener{}
+			if tc.object != nil {
+				fo[records] = *tc.object
+			}
+			b := Build{Path: path}
+			actual, err := b.CloneRecords(context.Background(), fo)
+			switch {
+			case err != nil:
+				if !tc.err {
+					t.Errorf("CloneRecords(): unexpected error: %v", err)
+				}
+			case tc.err:
+				t.Error("CloneRecords(): failed to receive an error")
+			default:
+				if !reflect.DeepEqual(actual, tc.expected) {
+					t.Errorf("CloneRecords(): got %v, want %v", actual, tc.expected)
+				}
+			}
+		})
+	}
+}
+
 func resolveOrDie(p Path, s string) Path {
 	out, err := p.ResolveReference(&url.URL{Path: s})
 	if err != nil {
@@ -1094,6 +1426,7 @@ func TestReadSuites(t *testing.T) {
 	cases := []struct {
 		name     string
 		ctx      context.Context
+		parser   string
 		opener   fakeOpener
 		expected *junit.Suites
 		checkErr error
@@ -1160,6 +1493,28 @@ func TestReadSuites(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:   "parse go test json",
+			parser: GoTestParser,
+			opener: fakeOpener{
+				path: {
+					data: `{"Action":"pass","Package":"foo","Test":"TestFoo"}`,
+				},
+			},
+			expected: &junit.Suites{
+				Suites: []junit.Suite{
+					{
+						Name:  "foo",
+						Tests: 1,
+						Results: []junit.Result{
+							{
+								Name: "TestFoo",
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "read error returns error",
 			opener: fakeOpener{
@@ -1172,7 +1527,14 @@ func TestReadSuites(t *testing.T) {
 
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
-			actual, err := readSuites(tc.ctx, tc.opener, path)
+			if tc.parser == "" {
+				tc.parser = JUnitParser
+			}
+			parsers, err := ArtifactParsers(tc.parser)
+			if err != nil {
+				t.Fatalf("ArtifactParsers(%q) got unexpected error: %v", tc.parser, err)
+			}
+			actual, err := readSuites(tc.ctx, tc.opener, path, parsers[0])
 			switch {
 			case err != nil:
 				if tc.expected != nil {
@@ -1286,6 +1648,7 @@ func TestSuites(t *testing.T) {
 		ctx       context.Context
 		path      Path
 		artifacts map[string]string
+		parsers   []string
 		max       int
 
 		expected []SuitesMeta
@@ -1409,6 +1772,60 @@ func TestSuites(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:    "support go test json",
+			path:    newPathOrDie("gs://where/whatever"),
+			parsers: []string{JUnitParser, GoTestParser},
+			artifacts: map[string]string{
+				"/something/unit.json": `{"Action":"pass","Package":"foo","Test":"TestFoo"}`,
+			},
+			expected: []SuitesMeta{
+				{
+					Suites: &junit.Suites{
+						Suites: []junit.Suite{
+							{
+								Name:  "foo",
+								Tests: 1,
+								Results: []junit.Result{
+									{
+										Name: "TestFoo",
+									},
+								},
+							},
+						},
+					},
+					Metadata: map[string]string{"Context": "unit", "Timestamp": "", "Thread": ""},
+					Path:     "gs://where/something/unit.json",
+				},
+			},
+		},
+		{
+			name:    "use build parsers",
+			path:    newPathOrDie("gs://where/whatever"),
+			parsers: []string{TAPParser},
+			artifacts: map[string]string{
+				"/something/junit.xml": `<testsuite><testcase name="ignored"/></testsuite>`,
+				"/something/unit.tap":  "ok 1 - foo\n",
+			},
+			expected: []SuitesMeta{
+				{
+					Suites: &junit.Suites{
+						Suites: []junit.Suite{
+							{
+								Tests: 1,
+								Results: []junit.Result{
+									{
+										Name: "foo",
+									},
+								},
+							},
+						},
+					},
+					Metadata: map[string]string{"Context": "unit", "Timestamp": "", "Thread": ""},
+					Path:     "gs://where/something/unit.tap",
+				},
+			},
+		},
 		{
 			name: "interrupted context returns error",
 			ctx: func() context.Context {
@@ -1428,6 +1845,12 @@ func TestSuites(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			fo := fakeOpener{}
 			b := Build{Path: tc.path}
+			if len(tc.parsers) > 0 {
+				var err error
+				if b.Parsers, err = ArtifactParsers(tc.parsers...); err != nil {
+					t.Fatalf("ArtifactParsers(%v) got unexpected error: %v", tc.parsers, err)
+				}
+			}
 			for s, data := range tc.artifacts {
 				fo[resolveOrDie(b.Path, s)] = fakeObject{data: data}
 			}
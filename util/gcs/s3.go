@@ -0,0 +1,451 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
+)
+
+var (
+	_ Client = &s3Client{} // Ensure this implements interface
+)
+
+// s3GenerationHeader holds the generation of objects written by the S3 client.
+//
+// S3 lacks generations, so the client increments this metadata on every write.
+const s3GenerationHeader = "x-amz-meta-generation"
+
+// S3Options configure the client of an S3-compatible object store, such as MinIO.
+type S3Options struct {
+	// Endpoint is the url of the store, defaulting to AWS in the region.
+	Endpoint string
+	// Region defaults to us-east-1.
+	Region string
+	// AccessKeyID and SecretAccessKey sign requests, which are otherwise anonymous.
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// Client defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// S3OptionsFromEnv returns options from the standard AWS environment variables.
+func S3OptionsFromEnv() S3Options {
+	env := func(keys ...string) string {
+		for _, key := range keys {
+			if val := os.Getenv(key); val != "" {
+				return val
+			}
+		}
+		return ""
+	}
+	return S3Options{
+		Endpoint:        env("AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL"),
+		Region:          env("AWS_REGION", "AWS_DEFAULT_REGION"),
+		AccessKeyID:     env("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: env("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    env("AWS_SESSION_TOKEN"),
+	}
+}
+
+// NewS3Client returns a client for s3://bucket/obj paths.
+//
+// Addresses buckets as part of the endpoint path, as MinIO expects.
+// Conditional writes read the current generation and then write with an
+// If-Match of its ETag, which S3 computes from the content. So concurrent
+// writers of identical content may all succeed, and copying an object to
+// itself under a GenerationMatch condition (as Touch does) fails instead.
+func NewS3Client(opts S3Options) ConditionalClient {
+	return newS3Client(opts)
+}
+
+func newS3Client(opts S3Options) *s3Client {
+	if opts.Region == "" {
+		opts.Region = "us-east-1"
+	}
+	if opts.Endpoint == "" {
+		opts.Endpoint = "https://s3." + opts.Region + ".amazonaws.com"
+	}
+	if opts.Client == nil {
+		opts.Client = http.DefaultClient
+	}
+	return &s3Client{
+		endpoint: strings.TrimSuffix(opts.Endpoint, "/"),
+		creds: s3Credentials{
+			accessKeyID:     opts.AccessKeyID,
+			secretAccessKey: opts.SecretAccessKey,
+			sessionToken:    opts.SessionToken,
+			region:          opts.Region,
+		},
+		client: opts.Client,
+		now:    time.Now,
+	}
+}
+
+type s3Client struct {
+	endpoint  string
+	creds     s3Credentials
+	client    *http.Client
+	now       func() time.Time
+	readCond  *storage.Conditions
+	writeCond *storage.Conditions
+}
+
+func (sc s3Client) If(read, write *storage.Conditions) ConditionalClient {
+	sc.readCond = read
+	sc.writeCond = write
+	return &sc
+}
+
+// do sends a signed request for the object in the bucket, returning an error for unsuccessful responses.
+func (sc s3Client) do(ctx context.Context, method, bucket, object string, query url.Values, header http.Header, body []byte) (*http.Response, error) {
+	rawURL := sc.endpoint + "/" + s3Escape(bucket, true)
+	if object != "" {
+		rawURL += "/" + s3Escape(object, false)
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("endpoint: %w", err)
+	}
+	u.RawQuery = query.Encode()
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), r)
+	if err != nil {
+		return nil, err
+	}
+	for key, vals := range header {
+		req.Header[key] = vals
+	}
+	payloadHash := s3EmptyPayload
+	if len(body) > 0 {
+		payloadHash = hashHex(body)
+	}
+	sc.creds.sign(req, payloadHash, sc.now())
+
+	resp, err := sc.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode/100 == 2 {
+		return resp, nil
+	}
+	defer resp.Body.Close()
+	buf, _ := ioutil.ReadAll(resp.Body)
+	var s3Err struct {
+		Code    string
+		Message string
+	}
+	xml.Unmarshal(buf, &s3Err) // HEAD responses have no body
+	e := googleapi.Error{
+		Code:    resp.StatusCode,
+		Message: strings.TrimSpace(s3Err.Code + " " + s3Err.Message),
+		Body:    string(buf),
+		Header:  resp.Header,
+	}
+	if e.Message == "" {
+		e.Message = resp.Status
+	}
+	if s3Err.Code == "ConditionalRequestConflict" {
+		// A concurrent write raced this one.
+		e.Code = http.StatusPreconditionFailed
+	}
+	return nil, fmt.Errorf("%s s3://%s/%s: %w", method, bucket, object, wrapGoogleAPIError(&e))
+}
+
+func s3Attrs(path Path, header http.Header) *storage.ObjectAttrs {
+	size, _ := strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+	updated, _ := http.ParseTime(header.Get("Last-Modified"))
+	return &storage.ObjectAttrs{
+		Bucket:       path.Bucket(),
+		Name:         path.Object(),
+		Size:         size,
+		CacheControl: header.Get("Cache-Control"),
+		Etag:         header.Get("ETag"),
+		Updated:      updated,
+		Generation:   s3Generation(header),
+	}
+}
+
+// s3Generation returns the generation of an existing object.
+//
+// Objects written by other programs are generation 1.
+func s3Generation(header http.Header) int64 {
+	gen, err := strconv.ParseInt(header.Get(s3GenerationHeader), 10, 64)
+	if err != nil || gen < 1 {
+		return 1
+	}
+	return gen
+}
+
+// checkS3Conditions returns a precondition failure when the object does not match the conditions.
+func checkS3Conditions(path Path, cond *storage.Conditions, attrs *storage.ObjectAttrs) error {
+	if cond == nil {
+		return nil
+	}
+	var gen int64
+	if attrs != nil {
+		gen = attrs.Generation
+	}
+	switch {
+	case cond.DoesNotExist && attrs != nil:
+		return preconditionFailed(path, "object exists at generation %d", gen)
+	case cond.GenerationMatch != 0 && cond.GenerationMatch != gen:
+		return preconditionFailed(path, "generation %d does not match %d", gen, cond.GenerationMatch)
+	case cond.GenerationNotMatch != 0 && cond.GenerationNotMatch == gen:
+		return preconditionFailed(path, "generation %d matches %d", gen, cond.GenerationNotMatch)
+	}
+	return nil
+}
+
+func (sc s3Client) Copy(ctx context.Context, from, to Path) (*storage.ObjectAttrs, error) {
+	if from == to && sc.writeCond != nil && sc.writeCond.GenerationMatch != 0 {
+		// The ETag stays the same, so every concurrent copy would match it.
+		return nil, fmt.Errorf("copy %s to itself: S3 cannot ensure only one conditional copy succeeds", from)
+	}
+	r, attrs, err := sc.Open(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	buf, err := ioutil.ReadAll(r)
+	r.Close()
+	if err != nil {
+		return nil, fmt.Errorf("read: %w", err)
+	}
+	return sc.Upload(ctx, to, buf, DefaultACL, attrs.CacheControl)
+}
+
+func (sc s3Client) Open(ctx context.Context, path Path) (io.ReadCloser, *storage.ReaderObjectAttrs, error) {
+	resp, err := sc.do(ctx, http.MethodGet, path.Bucket(), path.Object(), nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	attrs := s3Attrs(path, resp.Header)
+	if err := checkS3Conditions(path, sc.readCond, attrs); err != nil {
+		resp.Body.Close()
+		return nil, nil, err
+	}
+	return resp.Body, &storage.ReaderObjectAttrs{
+		Size:         attrs.Size,
+		ContentType:  resp.Header.Get("Content-Type"),
+		CacheControl: attrs.CacheControl,
+		LastModified: attrs.Updated,
+		Generation:   attrs.Generation,
+	}, nil
+}
+
+func (sc s3Client) Objects(ctx context.Context, path Path, delimiter, startOffset string) Iterator {
+	p := path.Object()
+	if p != "" && !strings.HasSuffix(p, "/") {
+		p += "/"
+	}
+	return &s3Iterator{
+		ctx:         ctx,
+		client:      sc,
+		bucket:      path.Bucket(),
+		prefix:      p,
+		delimiter:   delimiter,
+		startOffset: startOffset,
+	}
+}
+
+func (sc s3Client) Upload(ctx context.Context, path Path, buf []byte, worldReadable bool, cacheControl string) (*storage.ObjectAttrs, error) {
+	header := http.Header{}
+	gen := int64(1)
+	switch cond := sc.writeCond; {
+	case cond == nil || *cond == storage.Conditions{}:
+		if attrs, err := sc.head(ctx, path); err == nil {
+			gen = attrs.Generation + 1
+		}
+	case *cond == storage.Conditions{DoesNotExist: true}:
+		header.Set("If-None-Match", "*")
+	default:
+		attrs, err := sc.head(ctx, path)
+		switch {
+		case errors.Is(err, storage.ErrObjectNotExist):
+			attrs = nil
+		case err != nil:
+			return nil, err
+		}
+		if err := checkS3Conditions(path, cond, attrs); err != nil {
+			return nil, err
+		}
+		if attrs != nil {
+			header.Set("If-Match", attrs.Etag)
+			gen = attrs.Generation + 1
+		} else {
+			header.Set("If-None-Match", "*")
+		}
+	}
+	header.Set(s3GenerationHeader, strconv.FormatInt(gen, 10))
+	if cacheControl != "" {
+		header.Set("Cache-Control", cacheControl)
+	}
+	if worldReadable {
+		header.Set("x-amz-acl", "public-read")
+	}
+
+	resp, err := sc.do(ctx, http.MethodPut, path.Bucket(), path.Object(), nil, header, buf)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return &storage.ObjectAttrs{
+		Bucket:       path.Bucket(),
+		Name:         path.Object(),
+		Size:         int64(len(buf)),
+		CacheControl: cacheControl,
+		Etag:         resp.Header.Get("ETag"),
+		Updated:      sc.now(),
+		Generation:   gen,
+	}, nil
+}
+
+func (sc s3Client) Stat(ctx context.Context, path Path) (*storage.ObjectAttrs, error) {
+	attrs, err := sc.head(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkS3Conditions(path, sc.readCond, attrs); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+// head returns the attributes of the object, ignoring conditions.
+func (sc s3Client) head(ctx context.Context, path Path) (*storage.ObjectAttrs, error) {
+	resp, err := sc.do(ctx, http.MethodHead, path.Bucket(), path.Object(), nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+	return s3Attrs(path, resp.Header), nil
+}
+
+// s3Iterator lists objects a page at a time.
+type s3Iterator struct {
+	ctx         context.Context
+	client      s3Client
+	bucket      string
+	prefix      string
+	delimiter   string
+	startOffset string
+
+	attrs []*storage.ObjectAttrs
+	token string
+	done  bool
+}
+
+type s3ListResult struct {
+	Contents []struct {
+		Key          string
+		LastModified time.Time
+		ETag         string
+		Size         int64
+	}
+	CommonPrefixes []struct {
+		Prefix string
+	}
+	IsTruncated           bool
+	NextContinuationToken string
+}
+
+func (si *s3Iterator) Next() (*storage.ObjectAttrs, error) {
+	for len(si.attrs) == 0 {
+		if si.done {
+			return nil, iterator.Done
+		}
+		if err := si.fetch(); err != nil {
+			return nil, err
+		}
+	}
+	attrs := si.attrs[0]
+	si.attrs = si.attrs[1:]
+	return attrs, nil
+}
+
+// fetch lists the next page of objects.
+func (si *s3Iterator) fetch() error {
+	query := url.Values{
+		"list-type": {"2"},
+		"prefix":    {si.prefix},
+	}
+	if si.delimiter != "" {
+		query.Set("delimiter", si.delimiter)
+	}
+	if si.token != "" {
+		query.Set("continuation-token", si.token)
+	} else if n := len(si.startOffset); n > 0 {
+		// S3 lists names after start-after, whereas GCS includes the start offset.
+		// So start before it, and skip the names between.
+		query.Set("start-after", si.startOffset[:n-1])
+	}
+
+	resp, err := si.client.do(si.ctx, http.MethodGet, si.bucket, "", query, nil, nil)
+	if err != nil {
+		return fmt.Errorf("list: %w", err)
+	}
+	defer resp.Body.Close()
+	var result s3ListResult
+	if err := xml.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("decode: %w", err)
+	}
+
+	for _, obj := range result.Contents {
+		if obj.Key < si.startOffset {
+			continue
+		}
+		si.attrs = append(si.attrs, &storage.ObjectAttrs{
+			Bucket:  si.bucket,
+			Name:    obj.Key,
+			Size:    obj.Size,
+			Etag:    obj.ETag,
+			Updated: obj.LastModified,
+		})
+	}
+	for _, p := range result.CommonPrefixes {
+		if p.Prefix < si.startOffset {
+			continue
+		}
+		si.attrs = append(si.attrs, &storage.ObjectAttrs{Prefix: p.Prefix})
+	}
+	sort.SliceStable(si.attrs, func(i, j int) bool {
+		return si.attrs[i].Name+si.attrs[i].Prefix < si.attrs[j].Name+si.attrs[j].Prefix
+	})
+	si.token = result.NextContinuationToken
+	si.done = !result.IsTruncated || si.token == ""
+	return nil
+}
@@ -0,0 +1,156 @@
+/*
+Copyright 2023 The TestGrid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gcs
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3DateFormat   = "20060102T150405Z"
+	s3Algorithm    = "AWS4-HMAC-SHA256"
+	s3EmptyPayload = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// s3Credentials sign requests with AWS signature version 4.
+//
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+type s3Credentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	region          string
+}
+
+// sign adds the headers authenticating the request, whose body hashes to payloadHash.
+//
+// Signs the host and every header already set on the request.
+func (c s3Credentials) sign(req *http.Request, payloadHash string, when time.Time) {
+	req.Header.Set("x-amz-date", when.UTC().Format(s3DateFormat))
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	if c.sessionToken != "" {
+		req.Header.Set("x-amz-security-token", c.sessionToken)
+	}
+	if c.accessKeyID == "" {
+		return // Anonymous
+	}
+	signed := []string{"host"}
+	for key := range req.Header {
+		switch key := strings.ToLower(key); key {
+		case "authorization", "user-agent":
+		default:
+			signed = append(signed, key)
+		}
+	}
+	sort.Strings(signed)
+	scope, signature := c.signature(req, signed, payloadHash, when)
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", s3Algorithm, c.accessKeyID, scope, strings.Join(signed, ";"), signature))
+}
+
+// signature returns the credential scope and signature of the request.
+func (c s3Credentials) signature(req *http.Request, signedHeaders []string, payloadHash string, when time.Time) (string, string) {
+	when = when.UTC()
+	day := when.Format("20060102")
+	scope := strings.Join([]string{day, c.region, "s3", "aws4_request"}, "/")
+
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		when.Format(s3DateFormat),
+		scope,
+		hashHex([]byte(canonicalS3Request(req, signedHeaders, payloadHash))),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+c.secretAccessKey), day)
+	key = hmacSHA256(key, c.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	return scope, hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func canonicalS3Request(req *http.Request, signedHeaders []string, payloadHash string) string {
+	var headers strings.Builder
+	for _, key := range signedHeaders {
+		var val string
+		if key == "host" {
+			val = req.Host
+			if val == "" {
+				val = req.URL.Host
+			}
+		} else {
+			val = strings.Join(req.Header.Values(key), ",")
+		}
+		fmt.Fprintf(&headers, "%s:%s\n", key, strings.Join(strings.Fields(val), " "))
+	}
+
+	query := req.URL.Query()
+	params := make([]string, 0, len(query))
+	for key, vals := range query {
+		for _, val := range vals {
+			params = append(params, s3Escape(key, true)+"="+s3Escape(val, true))
+		}
+	}
+	sort.Strings(params)
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	return strings.Join([]string{
+		req.Method,
+		path,
+		strings.Join(params, "&"),
+		headers.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+}
+
+// s3Escape percent-encodes everything except unreserved characters, and optionally slashes.
+func s3Escape(s string, slash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9', c == '-', c == '_', c == '.', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !slash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
+
+func hashHex(buf []byte) string {
+	sum := sha256.Sum256(buf)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
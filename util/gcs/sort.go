@@ -124,6 +124,8 @@ func LeastRecentlyUpdated(ctx context.Context, log logrus.FieldLogger, client St
 //
 // Cloud copies the current object to itself when the object already exists.
 // Otherwise uploads genZero bytes.
+//
+// S3 clients only support creating the object, failing when generation is set.
 func Touch(ctx context.Context, client ConditionalClient, path Path, generation int64, genZero []byte) (*storage.ObjectAttrs, error) {
 	var cond storage.Conditions
 	if generation != 0 {
@@ -205,7 +205,10 @@ func (q *Queue) rouse() {
 }
 
 func (q *Queue) sleep(d time.Duration) {
-	log := q.log.WithFields(logrus.Fields{
+	q.lock.RLock()
+	log := q.log
+	q.lock.RUnlock()
+	log = log.WithFields(logrus.Fields{
 		"seconds": d.Round(100 * time.Millisecond).Seconds(),
 	})
 	if d > 5*time.Second {